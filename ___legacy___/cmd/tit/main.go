@@ -1,13 +1,21 @@
 package main
 
 import (
+	"os"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/jrengmusic/tit/internal/app"
 	"github.com/jrengmusic/tit/internal/config"
+	"github.com/jrengmusic/tit/internal/git"
 	"github.com/jrengmusic/tit/internal/ui"
 )
 
 func main() {
+	// Re-executed by ssh-keygen/ssh-add as SSH_ASKPASS: print the passphrase and exit
+	if git.ServeAskpass() {
+		return
+	}
+
 	// Create default theme files (always succeeds or panics)
 	ui.CreateDefaultThemeIfMissing()
 
@@ -25,7 +33,17 @@ func main() {
 
 	// Start with default terminal size (will be updated by WindowSizeMsg)
 	sizing := ui.CalculateDynamicSizing(80, 40)
-	application := app.NewApplication(sizing, theme, cfg)
+	var application *app.Application
+	if len(os.Args) > 1 && os.Args[1] == "dash" {
+		// `tit dash [dir...]`: dashboard over the given repositories or directories of repositories
+		dirs := os.Args[2:]
+		if len(dirs) == 0 {
+			dirs = []string{"."}
+		}
+		application = app.NewDashboardApplication(sizing, theme, cfg, git.NewExecBackend(), git.FindRepos(dirs))
+	} else {
+		application = app.NewApplication(sizing, theme, cfg, git.NewExecBackend())
+	}
 
 	opts := []tea.ProgramOption{tea.WithAltScreen()}
 
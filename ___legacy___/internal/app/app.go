@@ -43,10 +43,14 @@ type Application struct {
 	// Feature-specific state (standalone)
 	timeTravelState  TimeTravelState
 	environmentState EnvironmentState
+	dashboard        *ui.DashboardState // Set when started as `tit dash`; ESC from the menu returns to it
 
 	// Infrastructure (standalone)
 	cacheManager  *CacheManager
 	appConfig     *config.Config
+	policy        config.PolicyConfig  // Global [policy] merged with the repository's .tit.toml
+	bookmarks     []git.Bookmark       // Named commits from .git/TIT_BOOKMARKS
+	repoCommands  []config.RepoCommand // [[command]] entries from .tit.toml, run while time traveling
 	activityState ActivityState
 }
 
@@ -190,6 +194,10 @@ func (a *Application) deleteAtCursor() {
 // updateInputValidation updates validation message for current input
 func (a *Application) updateInputValidation() {
 	inputState := a.OperationState.InputState()
+	if a.NavigationState.mode == ModeProjectSwitcher {
+		a.pickerState.Projects.SelectedIdx = 0 // Query changed: best match first
+		return
+	}
 	if inputState.Action == InputActionCloneURL {
 		currentValue := inputState.Value
 		if a.NavigationState.mode != ModeInitializeBranches {
@@ -217,18 +225,46 @@ func (a *Application) handleInputSubmit(app *Application) (tea.Model, tea.Cmd) {
 		return app.handleInitBranchNameSubmit()
 	case "new_branch_name":
 		return app.handleNewBranchNameSubmit()
+	case "rename_branch":
+		return app.handleRenameBranchSubmit(app)
+	case "branch_upstream":
+		return app.handleBranchUpstreamSubmit(app)
 	case "init_subdir_name":
 		return app.handleInputSubmitSubdirName(app)
 	case "add_remote_url":
 		return app.handleAddRemoteSubmit(app)
 	case "commit_message":
 		return app.handleCommitSubmit(app)
+	case "commit_staged_message":
+		return app.handleCommitStagedSubmit(app)
 	case "commit_push_message":
 		return app.handleCommitPushSubmit(app)
+	case "lfs_track_pattern":
+		return app.handleLFSTrackPatternSubmit(app)
+	case "clone_depth":
+		return app.handleCloneDepthSubmit(app)
+	case "clone_branch":
+		return app.handleCloneBranchSubmit(app)
+	case "clone_sparse":
+		return app.handleCloneSparseSubmit(app)
 	case "config_switch_remote_url":
 		return app.handleConfigSwitchRemoteURLSubmit(app)
 	case "config_add_remote_url":
 		return app.handleConfigAddRemoteURLSubmit(app)
+	case "credential_helper":
+		return app.handleCredentialHelperSubmit(app)
+	case "workflow_canon":
+		return app.handleWorkflowCanonSubmit(app)
+	case "workflow_working":
+		return app.handleWorkflowWorkingSubmit(app)
+	case "policy_confirm":
+		return app.handlePolicyConfirmSubmit(app)
+	case "init_working_branch":
+		return app.handleInitWorkingBranchSubmit()
+	case "bookmark_name":
+		return app.handleBookmarkNameSubmit(app)
+	case "bisect_run":
+		return app.handleBisectRunSubmit(app)
 	default:
 		return app, nil
 	}
@@ -246,7 +282,13 @@ func (a *Application) handleHistoryRewind(app *Application) (tea.Model, tea.Cmd)
 	}
 
 	selectedCommit := pickerState.History.Commits[pickerState.History.SelectedIdx]
+	if app.rewindExceedsPolicy(selectedCommit.Hash) {
+		return app, nil
+	}
 	app.OperationState.WorkflowState().PendingRewindCommit = selectedCommit.Hash
+	if app.guardPolicy(config.ActionRewind) {
+		return app, nil
+	}
 
 	return app, app.showRewindConfirmation(selectedCommit.Hash)
 }
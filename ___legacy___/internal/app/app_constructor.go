@@ -10,8 +10,9 @@ import (
 )
 
 // newSetupWizardApp creates a minimal Application for the setup wizard
-// This bypasses all git state detection since git environment is not ready
-func newSetupWizardApp(sizing ui.DynamicSizing, theme ui.Theme, gitEnv git.GitEnvironment) *Application {
+// This bypasses all git state detection since git environment is not ready.
+// A machine that only lacks a git identity starts at the identity step.
+func newSetupWizardApp(sizing ui.DynamicSizing, theme ui.Theme, cfg *config.Config, gitEnv git.GitEnvironment) *Application {
 	envState := NewEnvironmentState()
 	envState.GitEnvironment = gitEnv
 	app := &Application{
@@ -24,7 +25,7 @@ func newSetupWizardApp(sizing ui.DynamicSizing, theme ui.Theme, gitEnv git.GitEn
 		environmentState: envState,
 		// Infrastructure (standalone)
 		cacheManager:  NewCacheManager(),
-		appConfig:     nil,
+		appConfig:     cfg,
 		activityState: NewActivityState(),
 	}
 	// Initialize embedded cluster fields
@@ -36,17 +37,67 @@ func newSetupWizardApp(sizing ui.DynamicSizing, theme ui.Theme, gitEnv git.GitEn
 	app.OperationState.consoleState = &newConsoleState
 	app.OperationState.PermitExit(true)
 	app.keyHandlers = app.buildKeyHandlers()
+	if gitEnv == git.NeedsIdentity {
+		app.enterSetupIdentity()
+	}
+	return app
+}
+
+// NewDashboardApplication creates the Application for `tit dash`: the dashboard over repos,
+// no repository opened until one is chosen. Falls back to the setup wizard like NewApplication.
+func NewDashboardApplication(sizing ui.DynamicSizing, theme ui.Theme, cfg *config.Config, backend git.Backend, repos []string) *Application {
+	git.SetBackend(backend)
+
+	gitEnv := git.DetectGitEnvironment()
+	InitGitLogger()
+	if gitEnv != git.Ready {
+		return newSetupWizardApp(sizing, theme, cfg, gitEnv)
+	}
+
+	workingTreeInfo, timelineInfo, operationInfo := BuildStateInfo(theme)
+	app := &Application{
+		// Embedded state clusters
+		UIState:         &UIState{},
+		NavigationState: &NavigationState{},
+		OperationState:  &OperationState{},
+		DialogManager:   &DialogManager{},
+		// Core business logic (standalone)
+		gitState:        &git.State{Operation: git.NotRepo},
+		workingTreeInfo: workingTreeInfo,
+		timelineInfo:    timelineInfo,
+		operationInfo:   operationInfo,
+		// Feature-specific state (standalone)
+		environmentState: NewEnvironmentState(),
+		dashboard:        &ui.DashboardState{Repos: repos},
+		// Infrastructure (standalone)
+		cacheManager:  NewCacheManager(),
+		appConfig:     cfg,
+		activityState: NewActivityState(),
+	}
+	app.UIState.Resize(sizing.ContentInnerWidth, sizing.ContentHeight)
+	app.UIState.theme = theme
+	app.NavigationState.mode = ModeDashboard
+	newConsoleState := NewConsoleState()
+	app.OperationState.consoleState = &newConsoleState
+	app.OperationState.PermitExit(true)
+	app.keyHandlers = app.buildKeyHandlers()
+
+	// First table before the first frame; repositories are detected concurrently
+	app.handleDashboardStates(DashboardStatesMsg{States: git.DetectStates(repos)})
 	return app
 }
 
 // NewApplication creates a new application instance
-func NewApplication(sizing ui.DynamicSizing, theme ui.Theme, cfg *config.Config) *Application {
+// backend executes every git command issued by the app and the git package
+func NewApplication(sizing ui.DynamicSizing, theme ui.Theme, cfg *config.Config, backend git.Backend) *Application {
+	git.SetBackend(backend)
+
 	// PRIORITY 0: Check git environment BEFORE anything else
-	// If git/ssh not available or SSH key missing, show setup wizard
+	// If git/ssh not available, SSH key or identity missing, show setup wizard
 	gitEnv := git.DetectGitEnvironment()
 	InitGitLogger()
 	if gitEnv != git.Ready {
-		return newSetupWizardApp(sizing, theme, gitEnv)
+		return newSetupWizardApp(sizing, theme, cfg, gitEnv)
 	}
 
 	// Try to find and cd into git repository
@@ -64,6 +115,7 @@ func NewApplication(sizing ui.DynamicSizing, theme ui.Theme, cfg *config.Config)
 			panic(fmt.Sprintf("cannot cd into repository at %s: %v", repoPath, err))
 		}
 		git.CleanStaleLocks()
+		recordRecentRepo(repoPath)
 		state, err := git.DetectState()
 		if err != nil {
 			// In a repo but state detection failed - this should not happen
@@ -128,6 +180,12 @@ func NewApplication(sizing ui.DynamicSizing, theme ui.Theme, cfg *config.Config)
 	// Build and cache key handler registry for initial mode
 	app.NavigationState.keyHandlers = app.buildKeyHandlers()
 
+	// Identity profile for the opened repository (header shows the active identity)
+	app.applyIdentityProfile()
+	app.loadPolicy()
+	app.loadBookmarks()
+	app.loadRepoCommands()
+
 	// Check for incomplete time travel restoration (Phase 0)
 	// If we're in TimeTraveling mode, TIT marker should exist
 	// If marker exists but we're not in TimeTraveling mode, restoration was interrupted
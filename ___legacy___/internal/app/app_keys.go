@@ -11,6 +11,7 @@ func (a *Application) globalHandlers() map[string]KeyHandler {
 		"q":      a.handleKeyCtrlC,
 		"esc":    a.handleKeyESC,
 		"/":      a.handleKeySlash,
+		"ctrl+o": a.handleKeyCtrlO,
 		"ctrl+v": a.handleKeyPaste,
 		"cmd+v":  a.handleKeyPaste,
 		"meta+v": a.handleKeyPaste,
@@ -66,6 +67,10 @@ func (a *Application) buildKeyHandlers() map[AppMode]map[string]KeyHandler {
 			On("1", a.handleCloneLocationChoice1).
 			On("2", a.handleCloneLocationChoice2).
 			Build(),
+		ModeCloneOptions: NewModeHandlers().
+			WithMenuNav(a).
+			On("enter", a.handleMenuEnter).
+			Build(),
 		ModeConfirmation: NewModeHandlers().
 			On("left", a.handleConfirmationLeft).
 			On("right", a.handleConfirmationRight).
@@ -85,6 +90,10 @@ func (a *Application) buildKeyHandlers() map[AppMode]map[string]KeyHandler {
 			On("y", a.handleHistoryCopyHashEnter).
 			On("Y", a.handleHistoryCopyHashFullEnter).
 			On("ctrl+r", a.handleHistoryRewind).
+			On("b", a.handleHistoryBookmark).
+			On("c", a.handleHistoryCompare).
+			On("G", a.handleHistoryBisectGood).
+			On("B", a.handleHistoryBisectBad).
 			On("esc", a.handleHistoryCopyHashEsc).
 			Build(),
 		ModeFileHistory: NewModeHandlers().
@@ -95,8 +104,20 @@ func (a *Application) buildKeyHandlers() map[AppMode]map[string]KeyHandler {
 			On("tab", a.handleFileHistoryTab).
 			On("y", a.handleFileHistoryCopy).
 			On("v", a.handleFileHistoryVisualMode).
+			On("s", a.handleDiffLayoutToggle).
 			On("esc", a.handleFileHistoryEsc).
 			Build(),
+		ModeCompare: NewModeHandlers().
+			On("up", a.handleCompareUp).
+			On("k", a.handleCompareUp).
+			On("down", a.handleCompareDown).
+			On("j", a.handleCompareDown).
+			On("tab", a.handleCompareTab).
+			On("m", a.handleCompareToggleRange).
+			On("y", a.handleCompareCopy).
+			On("v", a.handleCompareVisualMode).
+			On("s", a.handleDiffLayoutToggle).
+			Build(),
 		ModeConflictResolve: NewModeHandlers().
 			On("up", a.handleConflictUp).
 			On("k", a.handleConflictUp).
@@ -105,6 +126,7 @@ func (a *Application) buildKeyHandlers() map[AppMode]map[string]KeyHandler {
 			On("tab", a.handleConflictTab).
 			On(" ", a.handleConflictSpace). // Space character, not "space"
 			On("enter", a.handleConflictEnter).
+			On("s", a.handleDiffLayoutToggle).
 			Build(),
 		ModeClone: NewModeHandlers().
 			On("up", a.handleConsoleUp).
@@ -119,6 +141,8 @@ func (a *Application) buildKeyHandlers() map[AppMode]map[string]KeyHandler {
 			On("enter", a.handleSelectBranchEnter).
 			Build(),
 		ModeSetupWizard: NewModeHandlers().
+			On("up", a.handleSetupWizardUp).
+			On("down", a.handleSetupWizardDown).
 			On("enter", a.handleSetupWizardEnter).
 			Build(),
 		ModeConfig: NewModeHandlers().
@@ -134,6 +158,61 @@ func (a *Application) buildKeyHandlers() map[AppMode]map[string]KeyHandler {
 			On("a", a.handleBranchPickerAdd).
 			On("m", a.handleBranchPickerMerge).
 			On("x", a.handleBranchPickerDelete).
+			On("r", a.handleBranchPickerRename).
+			On("u", a.handleBranchPickerUpstream).
+			On("X", a.handleBranchPickerPrune).
+			On("c", a.handleBranchPickerCompare).
+			Build(),
+		ModeUntrackedTriage: NewModeHandlers().
+			On("up", a.handleUntrackedUp).
+			On("k", a.handleUntrackedUp).
+			On("down", a.handleUntrackedDown).
+			On("j", a.handleUntrackedDown).
+			On("a", a.handleUntrackedAdd).
+			On("i", a.handleUntrackedIgnore).
+			On("e", a.handleUntrackedIgnoreExtension).
+			On("d", a.handleUntrackedIgnoreDirectory).
+			On("x", a.handleUntrackedExclude).
+			Build(),
+		ModeLFS: NewModeHandlers().
+			On("up", a.handleLFSUp).
+			On("k", a.handleLFSUp).
+			On("down", a.handleLFSDown).
+			On("j", a.handleLFSDown).
+			On("a", a.handleLFSAdd).
+			On("x", a.handleLFSUntrack).
+			On("l", a.handleLFSLock).
+			On("u", a.handleLFSUnlock).
+			On("m", a.handleLFSMigrate).
+			Build(),
+		ModeSparseCheckout: NewModeHandlers().
+			On("up", a.handleSparseUp).
+			On("k", a.handleSparseUp).
+			On("down", a.handleSparseDown).
+			On("j", a.handleSparseDown).
+			On("right", a.handleSparseExpand).
+			On("l", a.handleSparseExpand).
+			On("left", a.handleSparseCollapse).
+			On("h", a.handleSparseCollapse).
+			On(" ", a.handleSparseToggle).
+			On("enter", a.handleSparseApply).
+			On("F", a.handleSparseDisable).
+			Build(),
+		ModeDashboard: NewModeHandlers().
+			On("up", a.handleDashboardUp).
+			On("k", a.handleDashboardUp).
+			On("down", a.handleDashboardDown).
+			On("j", a.handleDashboardDown).
+			On("enter", a.handleDashboardOpen).
+			On("f", a.handleDashboardFetch).
+			On("p", a.handleDashboardPull).
+			On("r", a.handleDashboardRefresh).
+			Build(),
+		ModeProjectSwitcher: NewModeHandlers().
+			WithCursorNav(genericInputNav).
+			On("up", a.handleProjectSwitcherUp).
+			On("down", a.handleProjectSwitcherDown).
+			On("enter", a.handleProjectSwitcherOpen).
 			Build(),
 		ModePreferences: NewModeHandlers().
 			WithMenuNav(a).
@@ -155,11 +234,16 @@ func (a *Application) buildKeyHandlers() map[AppMode]map[string]KeyHandler {
 		ModeCloneURL: true,
 	}
 
+	// Filter modes type every character into a query, including "q"
+	filterModes := map[AppMode]bool{
+		ModeProjectSwitcher: true,
+	}
+
 	// Merge global handlers into each mode (global takes priority)
 	// Exception: skip character key "/" in input modes so it types normally
 	for mode := range modeHandlers {
 		for key, handler := range globalHandlers {
-			if inputModes[mode] && key == "/" {
+			if (inputModes[mode] && key == "/") || (filterModes[mode] && len(key) == 1) {
 				continue
 			}
 			modeHandlers[mode][key] = handler
@@ -192,6 +276,12 @@ func (a *Application) rebuildMenuShortcuts(mode AppMode) {
 			On("enter", a.handleConfigMenuEnter).
 			On(" ", a.handleConfigMenuEnter). // Space as enter alias
 			Build()
+	} else if mode == ModeCloneOptions {
+		baseHandlers = NewModeHandlers().
+			WithMenuNav(a).
+			On("enter", a.handleMenuEnter).
+			On(" ", a.handleMenuEnter). // Space as enter alias (toggles)
+			Build()
 	} else if mode == ModePreferences {
 		baseHandlers = NewModeHandlers().
 			WithMenuNav(a).
@@ -193,6 +193,30 @@ func (a *Application) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return a, nil
 
+	case BranchPruneMsg:
+		return a.handleBranchPrune(msg)
+
+	case LFSLocksMsg:
+		return a.handleLFSLocks(msg)
+
+	case LFSLockMsg:
+		return a.handleLFSLockResult(msg)
+
+	case DashboardStatesMsg:
+		return a.handleDashboardStates(msg)
+
+	case DashboardBatchStatesMsg:
+		return a.handleDashboardBatchStates(msg)
+
+	case DashboardBatchMsg:
+		return a.handleDashboardBatch(msg)
+
+	case SetupVerifyMsg:
+		// ssh -T finished - show the greeting or the diagnosis
+		a.environmentState.SetupVerify = &msg.Result
+		a.environmentState.SetupSelectedIdx = 0
+		return a, nil
+
 	case SetupErrorMsg:
 		// Error occurred during setup - show error to user
 		a.environmentState.SetupWizardError = msg.Error
@@ -3,6 +3,7 @@ package app
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/jrengmusic/tit/internal/git"
 	"github.com/jrengmusic/tit/internal/ui"
@@ -33,6 +34,9 @@ func (a *Application) RenderStateHeader() string {
 
 	wtInfo := a.workingTreeInfo[state.WorkingTree]
 	wtDesc := []string{wtInfo.Description(state.CommitsAhead, state.CommitsBehind)}
+	if breakdown := workingTreeBreakdown(state.Changes); state.WorkingTree == git.Dirty && breakdown != "" {
+		wtDesc = []string{breakdown}
+	}
 
 	// OMP-style: append modified count to status label
 	workingTreeLabel := wtInfo.Label
@@ -55,24 +59,27 @@ func (a *Application) RenderStateHeader() string {
 			timelineLabel = "DETACHED @ " + shortHash
 			timelineColor = a.theme.OutputWarningColor
 			timelineDesc = []string{"Viewing commit from " + a.timeTravelState.info.CurrentCommit.Time.Format("Jan 2, 2006")}
+			if len(a.timeTravelState.info.Hops) >= 2 {
+				// Multi-hop session: breadcrumb of visited commits
+				timelineDesc = []string{a.timeTravelBreadcrumb(a.timeTravelState.info) + " · " + a.timeTravelState.info.CurrentCommit.Time.Format("Jan 2, 2006")}
+			}
 		}
+	} else if state.Operation == git.Bisecting && state.Bisect != nil {
+		timelineEmoji = "🔍"
+		timelineLabel = "BISECT @ " + state.CurrentHash
+		timelineColor = a.theme.OutputWarningColor
+		timelineDesc = []string{a.bisectDescription(state.Bisect)}
 	} else if state.Timeline != "" {
 		tlInfo := a.timelineInfo[state.Timeline]
 		timelineEmoji = tlInfo.Emoji
-		timelineLabel = tlInfo.Label
+		timelineLabel = a.timelineLabel(state)
 		timelineColor = tlInfo.Color
 		timelineDesc = []string{tlInfo.Description(state.CommitsAhead, state.CommitsBehind)}
+	}
 
-		// OMP-style: append ahead/behind arrows with count
-		if state.Timeline == git.Ahead && state.CommitsAhead > 0 {
-			timelineLabel = tlInfo.Label + " ⬆ " + fmt.Sprintf("%d", state.CommitsAhead)
-		} else if state.Timeline == git.Behind && state.CommitsBehind > 0 {
-			timelineLabel = tlInfo.Label + " ⬇ " + fmt.Sprintf("%d", state.CommitsBehind)
-		} else if state.Timeline == git.Diverged {
-			if state.CommitsAhead > 0 || state.CommitsBehind > 0 {
-				timelineLabel = tlInfo.Label + " ⬆ " + fmt.Sprintf("%d", state.CommitsAhead) + " ⬇ " + fmt.Sprintf("%d", state.CommitsBehind)
-			}
-		}
+	// Canon/working relationship rides on the timeline description
+	if desc := a.workflowDescription(); desc != "" {
+		timelineDesc[0] += " · " + desc
 	}
 
 	// Operation status (right column top)
@@ -89,7 +96,7 @@ func (a *Application) RenderStateHeader() string {
 	branchColor := a.theme.AccentTextColor
 
 	// Manual detached HEAD (not TIT time travel): show DETACHED ops, hash in branch column
-	if state.Detached && !state.IsTitTimeTravel {
+	if state.Detached && !state.IsTitTimeTravel && state.Operation != git.Bisecting {
 		opInfo = StateInfo{
 			Label: "DETACHED",
 			Emoji: "",
@@ -102,8 +109,8 @@ func (a *Application) RenderStateHeader() string {
 		branchEmoji = ""
 		branchColor = a.theme.AccentTextColor
 		branchName = state.CurrentHash
-	} else if state.Detached && state.IsTitTimeTravel {
-		// TIT time travel: use normal opInfo, show original branch
+	} else if state.Detached {
+		// TIT time travel or bisect: use normal opInfo, show original branch
 		branchEmoji = ""
 		branchColor = a.theme.OutputWarningColor
 	}
@@ -123,6 +130,12 @@ func (a *Application) RenderStateHeader() string {
 		}
 	}
 
+	// Sparse checkout indicator (only part of the tree is checked out)
+	sparseLabel := ""
+	if state.Sparse {
+		sparseLabel = "SPARSE"
+	}
+
 	headerState := ui.HeaderState{
 		CurrentDirectory: cwd,
 		RemoteURL:        remoteURL,
@@ -145,6 +158,9 @@ func (a *Application) RenderStateHeader() string {
 		SyncFrame:        a.activityState.autoUpdateFrame,
 		LFSLabel:         lfsLabel,
 		LFSColor:         lfsColor,
+		SparseLabel:      sparseLabel,
+		SparseColor:      a.theme.AccentTextColor,
+		IdentityLabel:    a.identityLabel(),
 	}
 
 	info := ui.RenderHeaderInfo(a.sizing, a.theme, headerState)
@@ -152,12 +168,50 @@ func (a *Application) RenderStateHeader() string {
 	return ui.RenderHeader(a.sizing, a.theme, info)
 }
 
+// timelineLabel is the timeline label with ahead/behind arrows and counts (OMP-style)
+func (a *Application) timelineLabel(state *git.State) string {
+	label := a.timelineInfo[state.Timeline].Label
+	switch {
+	case state.Timeline == git.Ahead && state.CommitsAhead > 0:
+		return label + " ⬆ " + fmt.Sprintf("%d", state.CommitsAhead)
+	case state.Timeline == git.Behind && state.CommitsBehind > 0:
+		return label + " ⬇ " + fmt.Sprintf("%d", state.CommitsBehind)
+	case state.Timeline == git.Diverged && (state.CommitsAhead > 0 || state.CommitsBehind > 0):
+		return label + " ⬆ " + fmt.Sprintf("%d", state.CommitsAhead) + " ⬇ " + fmt.Sprintf("%d", state.CommitsBehind)
+	}
+	return label
+}
+
+// workingTreeBreakdown formats non-zero change counts, e.g. "2 staged · 3 unstaged · 1 untracked"
+func workingTreeBreakdown(c git.ChangeCounts) string {
+	categories := []struct {
+		key   string
+		count int
+	}{
+		{"working_tree_staged", c.Staged},
+		{"working_tree_unstaged", c.Unstaged},
+		{"working_tree_untracked", c.Untracked},
+		{"working_tree_renamed", c.Renamed},
+		{"working_tree_deleted", c.Deleted},
+		{"working_tree_conflicted", c.Conflicted},
+	}
+
+	var parts []string
+	for _, category := range categories {
+		if category.count > 0 {
+			parts = append(parts, fmt.Sprintf(StateDescriptions[category.key], category.count))
+		}
+	}
+	return strings.Join(parts, " · ")
+}
+
 // isInputMode checks if current mode accepts text input
 
 func (a *Application) isInputMode() bool {
 	return a.mode == ModeInput ||
 		a.mode == ModeCloneURL ||
-		(a.mode == ModeSetupWizard && a.environmentState.SetupWizardStep == SetupStepEmail)
+		a.mode == ModeProjectSwitcher ||
+		(a.mode == ModeSetupWizard && setupInputSteps[a.environmentState.SetupWizardStep])
 }
 
 // buildKeyHandlers builds the complete handler registry for all modes
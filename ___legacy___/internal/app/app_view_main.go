@@ -85,6 +85,8 @@ func (a *Application) View() string {
 		)
 	case ModeCloneLocation:
 		contentText = ui.RenderMenuWithHeight(a.menuCloneLocation(), a.selectedIndex, a.theme, a.sizing.ContentHeight, a.sizing.ContentInnerWidth)
+	case ModeCloneOptions:
+		contentText = ui.RenderMenuWithHeight(a.menuItems, a.selectedIndex, a.theme, a.sizing.ContentHeight, a.sizing.ContentInnerWidth)
 	case ModeInitializeLocation:
 		contentText = ui.RenderMenuWithHeight(a.menuInitializeLocation(), a.selectedIndex, a.theme, a.sizing.ContentHeight, a.sizing.ContentInnerWidth)
 
@@ -112,6 +114,18 @@ func (a *Application) View() string {
 				a.sizing.TerminalHeight,
 			)
 		}
+	case ModeCompare:
+		// Render compare split-pane view (footer handled by GetFooterContent)
+		if a.pickerState.Compare == nil {
+			contentText = "Compare state not initialized"
+		} else {
+			contentText = ui.RenderCompareSplitPane(
+				a.pickerState.Compare,
+				a.theme,
+				a.sizing.TerminalWidth,
+				a.sizing.TerminalHeight,
+			)
+		}
 	case ModeConflictResolve:
 		// Render conflict resolution UI using generic N-column view (footer handled by GetFooterContent)
 		if a.conflictResolveState == nil {
@@ -128,12 +142,14 @@ func (a *Application) View() string {
 				a.width,
 				a.height,
 				a.theme,
+				a.conflictResolveState.SplitDiff,
+				a.conflictResolveState.SyntaxHighlight,
 			)
 		}
 	case ModeSetupWizard:
-		// Email step uses same full-screen input as ModeInput
-		if a.environmentState.SetupWizardStep == SetupStepEmail {
-			return a.renderSetupEmail()
+		// Input steps use same full-screen input as ModeInput
+		if setupInputSteps[a.environmentState.SetupWizardStep] {
+			return a.renderSetupInput()
 		}
 		// Other setup wizard steps
 		contentText = a.renderSetupWizard()
@@ -156,6 +172,21 @@ func (a *Application) View() string {
 		// Render using SSOT (ListPane + TextPane) matching history pattern
 		contentText = ui.RenderBranchPickerSplitPane(a.pickerState.BranchPicker, a.theme, a.sizing.TerminalWidth, a.sizing.TerminalHeight)
 
+	case ModeUntrackedTriage:
+		contentText = ui.RenderUntrackedTriageSplitPane(a.pickerState.Untracked, a.theme, a.sizing.TerminalWidth, a.sizing.TerminalHeight)
+
+	case ModeLFS:
+		contentText = ui.RenderLFSSplitPane(a.pickerState.LFS, a.theme, a.sizing.TerminalWidth, a.sizing.TerminalHeight)
+
+	case ModeSparseCheckout:
+		contentText = ui.RenderSparseCheckoutSplitPane(a.pickerState.Sparse, a.theme, a.sizing.TerminalWidth, a.sizing.TerminalHeight)
+
+	case ModeDashboard:
+		contentText = ui.RenderDashboard(a.dashboard, a.theme, a.sizing.TerminalWidth, a.sizing.TerminalHeight)
+
+	case ModeProjectSwitcher:
+		contentText = ui.RenderProjectSwitcher(a.pickerState.Projects, a.inputState.Value, a.inputState.CursorPosition, a.theme, a.sizing.TerminalWidth, a.sizing.TerminalHeight)
+
 	case ModePreferences:
 		// All menus work the same SSOT way - generate items when needed
 		if len(a.menuItems) == 0 {
@@ -177,7 +208,7 @@ func (a *Application) View() string {
 	}
 
 	// Full-screen modes: skip header, show footer only
-	if a.mode == ModeConsole || a.mode == ModeClone || a.mode == ModeFileHistory || a.mode == ModeHistory || a.mode == ModeConflictResolve || a.mode == ModeBranchPicker {
+	if a.mode == ModeConsole || a.mode == ModeClone || a.mode == ModeFileHistory || a.mode == ModeCompare || a.mode == ModeHistory || a.mode == ModeConflictResolve || a.mode == ModeBranchPicker || a.mode == ModeUntrackedTriage || a.mode == ModeLFS || a.mode == ModeSparseCheckout || a.mode == ModeDashboard || a.mode == ModeProjectSwitcher {
 		footer := a.GetFooterContent()
 		return contentText + "\n" + footer
 	}
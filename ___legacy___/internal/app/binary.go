@@ -0,0 +1,25 @@
+package app
+
+import (
+	"github.com/jrengmusic/tit/internal"
+	"github.com/jrengmusic/tit/internal/git"
+	"github.com/jrengmusic/tit/internal/ui"
+)
+
+// binarySide describes one blob of a binary file. Only small images are read,
+// to decode their dimensions and thumbnail; other contents are never loaded.
+func binarySide(path string, blob git.Blob) ui.BinarySide {
+	side := ui.BinarySide{Blob: blob}
+	if !blob.Exists() || blob.Size > internal.ImagePreviewMaxBytes || !ui.IsImagePath(path) {
+		return side
+	}
+	if data, err := git.ReadBlob(blob); err == nil {
+		side.Image = ui.NewImagePreview(data)
+	}
+	return side
+}
+
+// binaryDiffContent renders a binary change for a diff pane
+func binaryDiffContent(diff git.BinaryDiff) string {
+	return ui.BinaryDiffContent(binarySide(diff.Path, diff.Old), binarySide(diff.Path, diff.New))
+}
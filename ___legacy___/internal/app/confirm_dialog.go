@@ -26,6 +26,13 @@ const (
 	ConfirmBranchSwitchDirty     ConfirmationType = "branch_switch_dirty"
 	ConfirmMergeBranch           ConfirmationType = "merge_branch"
 	ConfirmMergeBranchDirty      ConfirmationType = "merge_branch_dirty"
+	ConfirmLFSMigrate            ConfirmationType = "lfs_migrate"
+	ConfirmLFSLargeBinaries      ConfirmationType = "lfs_large_binaries"
+	ConfirmAuthRemediation       ConfirmationType = "auth_remediation"
+	ConfirmBranchPrune           ConfirmationType = "branch_prune"
+	ConfirmWorkflowDeliver       ConfirmationType = "workflow_deliver"
+	ConfirmCanonCommit           ConfirmationType = "canon_commit"
+	ConfirmCanonForcePush        ConfirmationType = "canon_force_push"
 )
 
 // ConfirmationAction is a function that handles a confirmed action
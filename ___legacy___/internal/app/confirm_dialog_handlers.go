@@ -107,6 +107,34 @@ var confirmationHandlers = map[string]ConfirmationActionPair{
 		Confirm: (*Application).executeConfirmBranchDelete,
 		Reject:  (*Application).executeRejectBranchDelete,
 	},
+	string(ConfirmLFSMigrate): {
+		Confirm: (*Application).executeConfirmLFSMigrate,
+		Reject:  (*Application).executeRejectLFSMigrate,
+	},
+	string(ConfirmAuthRemediation): {
+		Confirm: (*Application).executeConfirmAuthRemediation,
+		Reject:  (*Application).executeRejectAuthRemediation,
+	},
+	string(ConfirmLFSLargeBinaries): {
+		Confirm: (*Application).executeConfirmLargeBinaries,
+		Reject:  (*Application).executeRejectLargeBinaries,
+	},
+	string(ConfirmBranchPrune): {
+		Confirm: (*Application).executeConfirmBranchPrune,
+		Reject:  (*Application).executeRejectBranchPrune,
+	},
+	string(ConfirmWorkflowDeliver): {
+		Confirm: (*Application).executeConfirmWorkflowDeliver,
+		Reject:  (*Application).executeRejectWorkflowDeliver,
+	},
+	string(ConfirmCanonCommit): {
+		Confirm: (*Application).executeConfirmCanonCommit,
+		Reject:  (*Application).executeRejectCanonCommit,
+	},
+	string(ConfirmCanonForcePush): {
+		Confirm: (*Application).executeConfirmCanonForcePush,
+		Reject:  (*Application).executeRejectCanonForcePush,
+	},
 }
 
 // handleConfirmationResponse routes confirmation YES/NO responses to appropriate handlers
@@ -186,10 +214,11 @@ func (a *Application) executeRejectNestedRepoInit() (tea.Model, tea.Cmd) {
 // executeConfirmForcePush handles YES response to force push confirmation
 func (a *Application) executeConfirmForcePush() (tea.Model, tea.Cmd) {
 	// User confirmed force push
-	// Initiate async push --force operation
+	// Initiate async push --force operation with the lease the dialog previewed
+	target := forcePushTargetFromContext(a.dialogState.context)
 	a.dialogState.Hide()
 	a.prepareAsyncOperation(GetFooterMessageText(MessageOperationInProgress))
-	return a, a.cmdForcePush()
+	return a, a.cmdForcePush(target)
 }
 
 // executeRejectForcePush handles NO response to force push confirmation
@@ -32,6 +32,12 @@ func (a *Application) executeConfirmTimeTravel() (tea.Model, tea.Cmd) {
 	wasAlreadyTimeTraveling := a.gitState.Operation == git.TimeTraveling
 
 	if wasAlreadyTimeTraveling {
+		// Hopping with changes would stash over the departure stash: refuse
+		if a.gitState.WorkingTree == git.Dirty {
+			model, cmd := a.returnToMenu()
+			a.footerHint = ErrorMessages["time_travel_hop_dirty"]
+			return model, cmd
+		}
 		// Already time traveling - read original branch from marker file
 		existingBranch, _, err := git.GetTimeTravelInfo()
 		if err != nil {
@@ -81,11 +87,13 @@ func (a *Application) executeConfirmTimeTravel() (tea.Model, tea.Cmd) {
 
 // executeTimeTravelClean handles time travel from clean working tree
 func (a *Application) executeTimeTravelClean(originalBranch, commitHash string) (tea.Model, tea.Cmd) {
-	// Write time travel info (no stash ID for clean tree)
-	err := git.WriteTimeTravelInfo(originalBranch, "")
-	if err != nil {
-		// Use standardized fatal error logging (PATTERN: Invariant violation)
-		LogErrorFatal("Failed to write time travel info", err)
+	// Write time travel info (no stash ID for clean tree) unless hopping within a session:
+	// the checkout appends to the existing trail
+	if existing, _, err := git.GetTimeTravelInfo(); err != nil || existing != originalBranch {
+		if err := git.WriteTimeTravelInfo(originalBranch, ""); err != nil {
+			// Use standardized fatal error logging (PATTERN: Invariant violation)
+			LogErrorFatal("Failed to write time travel info", err)
+		}
 	}
 
 	// Build TimeTravelInfo directly (fail fast if git calls fail)
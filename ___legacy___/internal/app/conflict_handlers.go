@@ -140,6 +140,15 @@ func (a *Application) handleConflictEnter(app *Application) (tea.Model, tea.Cmd)
 			continue // Skip invalid choice
 		}
 
+		// Binary files: git checks out the chosen stage (column N = stage N+1)
+		if file.Binary {
+			if err := git.CheckoutConflictStage(file.Path, file.Chosen+1); err != nil {
+				app.footerHint = fmt.Sprintf(ConsoleMessages["error_staging_file"], file.Path, err)
+				return app, nil
+			}
+			continue
+		}
+
 		// Write chosen version to file
 		chosenContent := file.Versions[file.Chosen]
 		if err := os.WriteFile(file.Path, []byte(chosenContent), 0644); err != nil {
@@ -19,4 +19,6 @@ type ConflictResolveState struct {
 	ColumnLabels      []string // Labels for each column (e.g., ["LOCAL", "REMOTE"])
 	ScrollOffsets     []int    // Scroll position for each bottom pane (length = NumColumns)
 	LineCursors       []int    // Line cursor for each bottom pane (length = NumColumns)
+	SplitDiff         bool     // True = mark what each version changes (split diff layout)
+	SyntaxHighlight   bool     // True = syntax colors in content panes (preference)
 }
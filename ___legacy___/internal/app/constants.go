@@ -16,9 +16,26 @@ const (
 	// Scroll
 	PageScrollLines = 10 // Lines per page scroll in console view
 
+	// Force push preview: remote commits listed before "…and N more"
+	ForcePushPreviewLimit = 10
+
+	// Time travel: hops shown in the header breadcrumb, bookmark quick-travel item IDs
+	BreadcrumbMaxHops    = 4
+	BookmarkActionPrefix = "bookmark:"
+
+	// Repository command run items carry the command name after this prefix
+	RepoCommandActionPrefix = "command:"
+
+	// Menu labels are cut to this many cells
+	MenuLabelMaxWidth = 21
+
 	// Input action identifiers
 	InputActionCloneURL = "clone_url"
 
 	// Branch picker purpose identifiers
-	BranchPickerPurposeMerge = "merge"
+	BranchPickerPurposeMerge   = "merge"
+	BranchPickerPurposeCompare = "compare"
+
+	// Compare view: commits listed per side
+	CompareCommitLimit = 500
 )
@@ -75,32 +75,17 @@ func (a *Application) dispatchConfigNewBranch(app *Application) tea.Cmd {
 
 // dispatchConfigSwitchBranch enters branch picker mode
 func (a *Application) dispatchConfigSwitchBranch(app *Application) tea.Cmd {
-	// Load branches into the branch picker state
-	branches, err := git.ListBranchesWithDetails()
+	// Load local then remote branches into the branch picker state
+	uiBranches, canon, err := loadSwitchPickerBranches()
 	if err != nil {
 		app.footerHint = fmt.Sprintf("Failed to load branches: %v", err)
 		return nil
 	}
 
-	// Convert git.BranchDetails to ui.BranchInfo
-	uiBranches := make([]ui.BranchInfo, len(branches))
-	for i, b := range branches {
-		uiBranches[i] = ui.BranchInfo{
-			Name:           b.Name,
-			IsCurrent:      b.IsCurrent,
-			LastCommitTime: b.LastCommitTime,
-			LastCommitHash: b.LastCommitHash,
-			LastCommitSubj: b.LastCommitSubj,
-			Author:         b.Author,
-			TrackingRemote: b.TrackingRemote,
-			Ahead:          b.Ahead,
-			Behind:         b.Behind,
-		}
-	}
-
 	// Initialize branch picker state (mirrors history state pattern: list + details pane)
 	app.pickerState.BranchPicker = &ui.BranchPickerState{
 		Branches:          uiBranches,
+		Canon:             canon,
 		SelectedIdx:       0,
 		PaneFocused:       true, // Start with list pane focused
 		ListScrollOffset:  0,
@@ -115,6 +100,32 @@ func (a *Application) dispatchConfigSwitchBranch(app *Application) tea.Cmd {
 	return nil
 }
 
+// dispatchConfigCompare enters the branch picker with branches and tags to pick the two sides of a compare
+func (a *Application) dispatchConfigCompare(app *Application) tea.Cmd {
+	uiBranches, canon, err := loadComparePickerBranches()
+	if err != nil {
+		app.footerHint = fmt.Sprintf("Failed to load branches: %v", err)
+		return nil
+	}
+
+	app.pickerState.BranchPicker = &ui.BranchPickerState{
+		Branches:          uiBranches,
+		Canon:             canon,
+		SelectedIdx:       0,
+		PaneFocused:       true,
+		ListScrollOffset:  0,
+		DetailsLineCursor: 0,
+		DetailsScrollOff:  0,
+	}
+
+	app.clearCompareMark()
+	app.workflowState.PreviousMode = app.mode
+	app.workflowState.BranchPickerPurpose = BranchPickerPurposeCompare
+	app.mode = ModeBranchPicker
+	app.footerHint = ConsoleMessages["compare_picker_title"]
+	return nil
+}
+
 // dispatchConfigMergeBranch enters branch picker for merge source selection
 func (a *Application) dispatchConfigMergeBranch(app *Application) tea.Cmd {
 	branches, err := git.ListBranchesWithDetails()
@@ -176,6 +187,33 @@ func (a *Application) dispatchConfigPreferences(app *Application) tea.Cmd {
 	return nil
 }
 
+// dispatchConfigLFS enters the LFS manager
+func (a *Application) dispatchConfigLFS(app *Application) tea.Cmd {
+	app.pickerState.LFS = &ui.LFSState{
+		SelectedIdx: 0,
+		PaneFocused: true, // Start with list pane focused
+	}
+	cmd := app.refreshLFS()
+
+	app.workflowState.PreviousMode = app.mode // Track previous mode (Config)
+	app.mode = ModeLFS
+	return cmd
+}
+
+// dispatchConfigSparse enters the sparse-checkout editor
+func (a *Application) dispatchConfigSparse(app *Application) tea.Cmd {
+	app.pickerState.Sparse = &ui.SparseCheckoutState{
+		Expanded:    make(map[string]bool),
+		SelectedIdx: 0,
+		PaneFocused: true, // Start with list pane focused
+	}
+	app.refreshSparse()
+
+	app.workflowState.PreviousMode = app.mode // Track previous mode (Config)
+	app.mode = ModeSparseCheckout
+	return nil
+}
+
 // ========================================
 // Preferences Menu Dispatchers
 // ========================================
@@ -193,6 +231,25 @@ func (a *Application) dispatchPreferencesToggleAutoUpdate(app *Application) tea.
 	return nil
 }
 
+// dispatchPreferencesToggleSyntax toggles syntax colors ON/OFF, including open diff views
+func (a *Application) dispatchPreferencesToggleSyntax(app *Application) tea.Cmd {
+	if app.appConfig != nil {
+		enabled := !app.appConfig.Appearance.SyntaxHighlighting
+		app.appConfig.SetSyntaxHighlighting(enabled)
+
+		if app.pickerState.FileHistory != nil {
+			app.pickerState.FileHistory.SyntaxHighlight = enabled
+		}
+		if app.pickerState.Compare != nil {
+			app.pickerState.Compare.SyntaxHighlight = enabled
+		}
+		if app.conflictResolveState != nil {
+			app.conflictResolveState.SyntaxHighlight = enabled
+		}
+	}
+	return nil
+}
+
 // dispatchPreferencesInterval is a no-op (interval adjusted via +/- keys)
 func (a *Application) dispatchPreferencesInterval(app *Application) tea.Cmd {
 	// Interval is adjusted via +/- keys, not enter
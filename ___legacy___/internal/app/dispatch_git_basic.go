@@ -1,6 +1,10 @@
 package app
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/jrengmusic/tit/internal/config"
 	"github.com/jrengmusic/tit/internal/git"
 	"github.com/jrengmusic/tit/internal/ui"
 
@@ -53,24 +57,96 @@ func (a *Application) dispatchPushAutoSync(app *Application) tea.Cmd {
 
 // dispatchForcePush shows confirmation dialog for force push
 func (a *Application) dispatchForcePush(app *Application) tea.Cmd {
-	app.workflowState.PreviousMode = app.mode // Track previous mode (Menu)
-	app.mode = ModeConfirmation
-	app.dialogState.context = map[string]string{}
+	app.workflowState.PreviousMode = app.mode // Track previous mode (Menu) for every path below
+	if app.guardPolicy(config.ActionForcePush) {
+		return nil // Typing the branch name comes first, then continueForcePush
+	}
+	app.continueForcePush()
+	return nil
+}
+
+// continueForcePush shows the canon gate when on canon, then the regular force push
+// confirmation. Runs directly or after a protected branch's typed confirmation.
+func (a *Application) continueForcePush() {
+	if a.onCanon() {
+		a.showCanonGate(ConfirmCanonForcePush, nil) // Extra gate before the regular confirmation
+		return
+	}
+	a.showForcePushConfirmation()
+}
+
+// showForcePushConfirmation shows the force push confirmation dialog,
+// listing the remote commits the push would destroy. The preview and the
+// lease use the same resolved remote-tracking tip, kept in the dialog context;
+// without one the dialog never claims nothing is lost.
+func (a *Application) showForcePushConfirmation() {
+	a.mode = ModeConfirmation
+	target := git.ResolveForcePushTarget(a.gitState.CurrentBranch)
+	label := target.Label()
+
 	msg := ConfirmationMessages["force_push"]
+	explanation := fmt.Sprintf(msg.Explanation, label)
+	if target.Expected != "" {
+		if lost, err := git.LostRemoteCommits(target.Expected); err == nil && len(lost) == 0 {
+			msg = ConfirmationMessages["force_push_safe"]
+			explanation = fmt.Sprintf(msg.Explanation, label)
+		} else if err == nil {
+			msg = ConfirmationMessages["force_push_lost"]
+			explanation = fmt.Sprintf(msg.Explanation, label, len(lost), formatLostCommits(lost))
+		}
+	}
 	config := ui.ConfirmationConfig{
 		Title:       msg.Title,
-		Explanation: msg.Explanation,
+		Explanation: explanation,
 		YesLabel:    msg.YesLabel,
 		NoLabel:     msg.NoLabel,
 		ActionID:    "force_push",
 	}
-	dialog := ui.NewConfirmationDialog(config, a.sizing.ContentInnerWidth, &app.theme)
-	app.dialogState.Show(dialog, nil)
-	return nil
+	dialog := ui.NewConfirmationDialog(config, a.sizing.ContentInnerWidth, &a.theme)
+	a.dialogState.Show(dialog, forcePushContext(target))
+}
+
+// forcePushContext stores a force push target in dialog context
+func forcePushContext(target git.ForcePushTarget) map[string]string {
+	ctx := map[string]string{
+		"remote":        target.Remote,
+		"remote_branch": target.RemoteBranch,
+		"expected":      target.Expected,
+	}
+	if target.SetUpstream {
+		ctx["set_upstream"] = "true"
+	}
+	return ctx
+}
+
+// forcePushTargetFromContext reads the target stored by forcePushContext
+func forcePushTargetFromContext(ctx map[string]string) git.ForcePushTarget {
+	return git.ForcePushTarget{
+		Remote:       ctx["remote"],
+		RemoteBranch: ctx["remote_branch"],
+		Expected:     ctx["expected"],
+		SetUpstream:  ctx["set_upstream"] == "true",
+	}
+}
+
+// formatLostCommits renders remote commits as "hash  author  subject" lines, capped at ForcePushPreviewLimit
+func formatLostCommits(commits []git.RemoteCommit) string {
+	var lines []string
+	for i, c := range commits {
+		if i == ForcePushPreviewLimit {
+			lines = append(lines, fmt.Sprintf(ConsoleMessages["force_push_more"], len(commits)-i))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("  %s  %s  %s", c.Hash, c.Author, c.Subject))
+	}
+	return strings.Join(lines, "\n")
 }
 
 // dispatchReplaceLocal shows confirmation dialog for destructive action
 func (a *Application) dispatchReplaceLocal(app *Application) tea.Cmd {
+	if app.guardPolicy(config.ActionReplaceLocal) {
+		return nil
+	}
 	app.workflowState.PreviousMode = app.mode
 	app.mode = ModeConfirmation
 	app.dialogState.context = map[string]string{}
@@ -94,8 +170,9 @@ func (a *Application) dispatchResetDiscardChanges(app *Application) tea.Cmd {
 	app.mode = ModeConfirmation
 	app.dialogState.context = map[string]string{}
 
+	// "Reset to remote" is a replace local: not offered where the policy protects it
 	var confirmType string
-	if app.gitState.Remote == git.HasRemote {
+	if app.gitState.Remote == git.HasRemote && app.protection(config.ActionReplaceLocal) == config.ProtectNone {
 		confirmType = "confirm_discard_changes_remote_choice"
 	} else {
 		confirmType = "confirm_discard_changes_local"
@@ -2,6 +2,7 @@ package app
 
 import (
 	"sort"
+	"github.com/jrengmusic/tit/internal/git"
 	"github.com/jrengmusic/tit/internal/ui"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -32,6 +33,8 @@ func (a *Application) dispatchHistory(app *Application) tea.Cmd {
 		PaneFocused:       true,
 		DetailsLineCursor: 0,
 		DetailsScrollOff:  0,
+		ShallowBoundary:   git.ShallowBoundaries(),
+		Runs:              app.runRecordsByHash(),
 	}
 	return nil
 }
@@ -81,6 +84,8 @@ func (a *Application) dispatchFileHistory(app *Application) tea.Cmd {
 		DiffContent:       "",
 		VisualModeActive:  false,
 		VisualModeStart:   0,
+		SplitDiff:         app.splitDiff(),
+		SyntaxHighlight:   app.syntaxHighlight(),
 	}
 	a.updateFileHistoryDiff()
 	return nil
@@ -88,6 +93,8 @@ func (a *Application) dispatchFileHistory(app *Application) tea.Cmd {
 
 // dispatchTimeTravelHistory handles the "Browse History" action during time travel
 func (a *Application) dispatchTimeTravelHistory(app *Application) tea.Cmd {
+	app.workflowState.PreviousMode = app.mode               // Track previous mode (Menu)
+	app.workflowState.PreviousMenuIndex = app.selectedIndex // Track previous selection
 	app.mode = ModeHistory
 
 	var commits []ui.CommitInfo
@@ -109,6 +116,8 @@ func (a *Application) dispatchTimeTravelHistory(app *Application) tea.Cmd {
 		PaneFocused:       true,
 		DetailsLineCursor: 0,
 		DetailsScrollOff:  0,
+		ShallowBoundary:   git.ShallowBoundaries(),
+		Runs:              app.runRecordsByHash(),
 	}
 	return nil
 }
@@ -1,6 +1,10 @@
 package app
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/jrengmusic/tit/internal/ui"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -23,7 +27,6 @@ func (a *Application) dispatchClone(app *Application) tea.Cmd {
 			ResetFields: []string{"clone"},
 		})
 	} else {
-		app.workflowState.CloneMode = "subdir"
 		app.transitionTo(ModeTransition{
 			Mode:        ModeCloneURL,
 			InputPrompt: InputMessages[InputActionCloneURL].Prompt,
@@ -31,10 +34,65 @@ func (a *Application) dispatchClone(app *Application) tea.Cmd {
 			FooterHint:  InputMessages[InputActionCloneURL].Hint,
 			ResetFields: []string{"clone"},
 		})
+		app.workflowState.CloneMode = "subdir" // After reset: ResetClone defaults to "here"
+	}
+	return nil
+}
+
+// dispatchCloneOptDepth asks for the clone depth (empty = full history)
+func (a *Application) dispatchCloneOptDepth(app *Application) tea.Cmd {
+	value := ""
+	if depth := app.workflowState.CloneOptions.Depth; depth > 0 {
+		value = strconv.Itoa(depth)
 	}
+	app.enterCloneOptionInput("clone_depth", value)
+	return nil
+}
+
+// dispatchCloneOptSingleBranch toggles fetching only the checked-out branch
+func (a *Application) dispatchCloneOptSingleBranch(app *Application) tea.Cmd {
+	app.workflowState.CloneOptions.SingleBranch = !app.workflowState.CloneOptions.SingleBranch
+	app.showCloneOptions()
+	return nil
+}
+
+// dispatchCloneOptBranch asks for the branch to check out (empty = remote default)
+func (a *Application) dispatchCloneOptBranch(app *Application) tea.Cmd {
+	app.enterCloneOptionInput("clone_branch", app.workflowState.CloneOptions.Branch)
 	return nil
 }
 
+// dispatchCloneOptBlobFilter toggles the blob:none partial clone filter
+func (a *Application) dispatchCloneOptBlobFilter(app *Application) tea.Cmd {
+	app.workflowState.CloneOptions.BlobFilter = !app.workflowState.CloneOptions.BlobFilter
+	app.showCloneOptions()
+	return nil
+}
+
+// dispatchCloneOptSparse asks for sparse-checkout cone directories (empty = everything)
+func (a *Application) dispatchCloneOptSparse(app *Application) tea.Cmd {
+	app.enterCloneOptionInput("clone_sparse", strings.Join(app.workflowState.CloneOptions.SparsePatterns, " "))
+	return nil
+}
+
+// dispatchCloneStart starts the clone with the chosen options
+func (a *Application) dispatchCloneStart(app *Application) tea.Cmd {
+	_, cmd := app.startCloneOperation()
+	return cmd
+}
+
+// dispatchDeepenHistory fetches deepenCommits more commits past the shallow boundary
+func (a *Application) dispatchDeepenHistory(app *Application) tea.Cmd {
+	app.prepareAsyncOperation(GetFooterMessageText(MessageOperationInProgress))
+	return app.cmdDeepenHistory()
+}
+
+// dispatchUnshallow fetches the remaining history of a shallow clone
+func (a *Application) dispatchUnshallow(app *Application) tea.Cmd {
+	app.prepareAsyncOperation(GetFooterMessageText(MessageOperationInProgress))
+	return app.cmdUnshallow()
+}
+
 // dispatchAddRemote starts the add remote workflow
 func (a *Application) dispatchAddRemote(app *Application) tea.Cmd {
 	app.transitionTo(ModeTransition{
@@ -48,26 +106,80 @@ func (a *Application) dispatchAddRemote(app *Application) tea.Cmd {
 }
 
 // dispatchCommit starts the commit workflow
+// Large binaries outside LFS get a confirmation first (see warnLargeBinaries)
 func (a *Application) dispatchCommit(app *Application) tea.Cmd {
-	app.transitionTo(ModeTransition{
+	if app.guardCanonCommit("commit") {
+		return nil
+	}
+	return app.continueCommit("commit")
+}
+
+// enterCommitInput opens the commit message input
+func (a *Application) enterCommitInput() tea.Cmd {
+	a.transitionTo(ModeTransition{
 		Mode:        ModeInput,
 		InputPrompt: InputMessages["commit_message"].Prompt,
 		InputAction: "commit_message",
 		FooterHint:  InputMessages["commit_message"].Hint,
-		InputHeight: app.sizing.TerminalHeight - ui.FooterHeight,
+		InputHeight: a.sizing.TerminalHeight - ui.FooterHeight,
 		ResetFields: []string{},
 	})
 	return nil
 }
 
+// dispatchCommitStaged starts the commit workflow for staged changes only
+func (a *Application) dispatchCommitStaged(app *Application) tea.Cmd {
+	if app.guardCanonCommit("commit_staged") {
+		return nil
+	}
+	return app.continueCommit("commit_staged")
+}
+
+// enterCommitStagedInput opens the commit message input for a staged-only commit
+func (a *Application) enterCommitStagedInput() tea.Cmd {
+	a.transitionTo(ModeTransition{
+		Mode:        ModeInput,
+		InputPrompt: InputMessages["commit_staged_message"].Prompt,
+		InputAction: "commit_staged_message",
+		FooterHint:  fmt.Sprintf(InputMessages["commit_staged_message"].Hint, a.gitState.Changes.Staged),
+		InputHeight: a.sizing.TerminalHeight - ui.FooterHeight,
+		ResetFields: []string{},
+	})
+	return nil
+}
+
+// dispatchUntrackedTriage enters the untracked-file triage view
+func (a *Application) dispatchUntrackedTriage(app *Application) tea.Cmd {
+	app.pickerState.Untracked = &ui.UntrackedTriageState{
+		SelectedIdx: 0,
+		PaneFocused: true, // Start with list pane focused
+	}
+	if err := app.refreshUntrackedTriage(); err != nil {
+		app.pickerState.Untracked.Status = fmt.Sprintf("Failed to list untracked files: %v", err)
+	}
+
+	app.workflowState.PreviousMode = app.mode               // Track previous mode (Menu)
+	app.workflowState.PreviousMenuIndex = app.selectedIndex // Track previous selection
+	app.mode = ModeUntrackedTriage
+	return nil
+}
+
 // dispatchCommitPush starts commit+push workflow
 func (a *Application) dispatchCommitPush(app *Application) tea.Cmd {
-	app.transitionTo(ModeTransition{
+	if app.guardCanonCommit("commit_push") {
+		return nil
+	}
+	return app.continueCommit("commit_push")
+}
+
+// enterCommitPushInput opens the commit message input for commit+push
+func (a *Application) enterCommitPushInput() tea.Cmd {
+	a.transitionTo(ModeTransition{
 		Mode:        ModeInput,
 		InputPrompt: InputMessages["commit_message"].Prompt,
 		InputAction: "commit_push_message",
 		FooterHint:  "Enter commit message (will commit and push)",
-		InputHeight: app.sizing.TerminalHeight - ui.FooterHeight,
+		InputHeight: a.sizing.TerminalHeight - ui.FooterHeight,
 		ResetFields: []string{},
 	})
 	return nil
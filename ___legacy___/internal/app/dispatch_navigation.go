@@ -36,6 +36,7 @@ func (a *Application) dispatchReturnToBranchPicker(app *Application, hasDirtyTre
 	// Store dirty tree state for after branch selection
 	app.workflowState.ReturnToBranchDirtyTree = hasDirtyTree
 	app.workflowState.IsReturnToBranch = true // Mark this as return-from-detached
+	app.workflowState.BranchPickerPurpose = ""
 
 	// Initialize branch picker state
 	app.pickerState.BranchPicker = &ui.BranchPickerState{
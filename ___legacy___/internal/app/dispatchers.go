@@ -1,6 +1,8 @@
 package app
 
 import (
+	"strings"
+
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -9,9 +11,17 @@ func (a *Application) dispatchAction(actionID string) tea.Cmd {
 	actionDispatchers := map[string]ActionHandler{
 		"init":                      a.dispatchInit,
 		"clone":                     a.dispatchClone,
+		"clone_opt_depth":           a.dispatchCloneOptDepth,
+		"clone_opt_single_branch":   a.dispatchCloneOptSingleBranch,
+		"clone_opt_branch":          a.dispatchCloneOptBranch,
+		"clone_opt_blob_filter":     a.dispatchCloneOptBlobFilter,
+		"clone_opt_sparse":          a.dispatchCloneOptSparse,
+		"clone_start":               a.dispatchCloneStart,
 		"add_remote":                a.dispatchAddRemote,
 		"commit":                    a.dispatchCommit,
 		"commit_push":               a.dispatchCommitPush,
+		"commit_staged":             a.dispatchCommitStaged,
+		"untracked_triage":          a.dispatchUntrackedTriage,
 		"push":                      a.dispatchPush,
 		"push_auto_sync":            a.dispatchPushAutoSync,
 		"force_push":                a.dispatchForcePush,
@@ -22,10 +32,21 @@ func (a *Application) dispatchAction(actionID string) tea.Cmd {
 		"reset_discard_changes":     a.dispatchResetDiscardChanges,
 		"history":                   a.dispatchHistory,
 		"file_history":              a.dispatchFileHistory,
+		"deepen_history":            a.dispatchDeepenHistory,
+		"unshallow":                 a.dispatchUnshallow,
 		"time_travel_history":       a.dispatchTimeTravelHistory,
 		"time_travel_files_history": a.dispatchFileHistory,
 		"time_travel_merge":         a.dispatchTimeTravelMerge,
 		"time_travel_return":        a.dispatchTimeTravelReturn,
+		"time_travel_back":          a.dispatchTimeTravelBack,
+		"time_travel_bookmark":      a.dispatchTimeTravelBookmark,
+		"bisect_good":               a.dispatchBisectGood,
+		"bisect_bad":                a.dispatchBisectBad,
+		"bisect_skip":               a.dispatchBisectSkip,
+		"bisect_run":                a.dispatchBisectRun,
+		"bisect_stop":               a.dispatchBisectStop,
+		"workflow_update":           a.dispatchWorkflowUpdate,
+		"workflow_deliver":          a.dispatchWorkflowDeliver,
 		// Mid-operation recovery menu actions
 		"finalize_merge":  a.dispatchFinalizeMerge,
 		"abort_merge":     a.dispatchAbortMerge,
@@ -38,11 +59,26 @@ func (a *Application) dispatchAction(actionID string) tea.Cmd {
 		"config_remove_remote":      a.dispatchConfigRemoveRemote,
 		"config_toggle_auto_update": a.dispatchConfigToggleAutoUpdate,
 		"config_branch":             a.dispatchConfigSwitchBranch,
+		"config_compare":            a.dispatchConfigCompare,
 		"config_preferences":        a.dispatchConfigPreferences,
+		"config_lfs":                a.dispatchConfigLFS,
+		"config_sparse":             a.dispatchConfigSparse,
+		"config_projects":           a.dispatchConfigProjects,
+		"config_workflow":           a.dispatchConfigWorkflow,
 		// Preferences menu actions
 		"preferences_auto_update": a.dispatchPreferencesToggleAutoUpdate,
 		"preferences_interval":    a.dispatchPreferencesInterval,
 		"preferences_theme":       a.dispatchPreferencesCycleTheme,
+		"preferences_syntax":      a.dispatchPreferencesToggleSyntax,
+	}
+
+	// Per-bookmark quick travel items carry the bookmark name in their ID
+	if name, found := strings.CutPrefix(actionID, BookmarkActionPrefix); found {
+		return a.dispatchBookmarkTravel(name)
+	}
+
+	if name, found := strings.CutPrefix(actionID, RepoCommandActionPrefix); found {
+		return a.dispatchRepoCommand(name)
 	}
 
 	if handler, exists := actionDispatchers[actionID]; exists {
@@ -3,13 +3,28 @@ package app
 import "github.com/jrengmusic/tit/internal/git"
 
 // EnvironmentState manages git environment detection and setup wizard state.
-// This is only relevant before main application loop starts.
+// Setup fields are only relevant before main application loop starts.
 type EnvironmentState struct {
-	GitEnvironment   git.GitEnvironment // Ready, NeedsSetup, MissingGit, MissingSSH
+	GitEnvironment   git.GitEnvironment // Ready, NeedsSetup, NeedsIdentity, MissingGit, MissingSSH
 	SetupWizardStep  SetupWizardStep    // Current step in wizard
 	SetupWizardError string             // Error message for SetupStepError
 	SetupEmail       string             // Email for SSH key generation
-	SetupKeyCopied bool // Public key copied to clipboard
+	SetupKeyCopied   bool               // Public key copied to clipboard
+
+	SetupSelectedIdx int                  // Cursor in choice steps (provider, key, key type, verify retry)
+	SetupProvider    int                  // Index into git.SSHProviders; len(git.SSHProviders) = other host
+	SetupAlias       string               // ~/.ssh/config Host alias used in remote URLs
+	SetupHostName    string               // Real host name behind the alias
+	SetupKeys        []git.SSHKey         // Existing keys offered for reuse
+	SetupKeyPath     string               // Chosen existing key, or path of the key to generate
+	SetupNewKey      bool                 // Generate SetupKeyPath instead of reusing it
+	SetupKeyType     git.SSHKeyType       // Algorithm of the new key
+	SetupPassphrase  string               // Passphrase for the new or encrypted key; cleared once used
+	SetupVerify      *git.SSHVerifyResult // ssh -T result, nil while running
+	SetupIdentity    git.Identity         // user.name / user.email being entered
+
+	Identity        git.Identity // Identity commits in the current repository are authored with
+	IdentityProfile string       // Name of the config.toml profile applied to it, "" for none
 }
 
 // NewEnvironmentState creates a new EnvironmentState with defaults.
@@ -77,6 +77,9 @@ func (a *Application) getFooterHintKey() string {
 	case ModeFileHistory:
 		return a.getFileHistoryHintKey()
 
+	case ModeCompare:
+		return a.getCompareHintKey()
+
 	case ModeConsole, ModeClone:
 		if a.IsAsyncActive() {
 			return "console_running"
@@ -86,7 +89,16 @@ func (a *Application) getFooterHintKey() string {
 	case ModeConflictResolve:
 		return a.getConflictHintKey()
 
-	case ModeInput, ModeCloneURL, ModeSetupWizard:
+	case ModeSetupWizard:
+		if a.setupChoices() != nil {
+			return "setup_choice"
+		}
+		if a.inputState.Value == "" {
+			return "input_empty"
+		}
+		return "input_filled"
+
+	case ModeInput, ModeCloneURL:
 		if a.inputState.Value == "" {
 			return "input_empty"
 		}
@@ -96,18 +108,45 @@ func (a *Application) getFooterHintKey() string {
 		return "confirmation"
 
 	case ModeBranchPicker:
+		if a.workflowState.BranchPickerPurpose == BranchPickerPurposeCompare {
+			return "branch_picker_compare"
+		}
 		if a.pickerState.BranchPicker != nil && a.pickerState.BranchPicker.SelectedIdx >= 0 && a.pickerState.BranchPicker.SelectedIdx < len(a.pickerState.BranchPicker.Branches) {
 			if a.pickerState.BranchPicker.Branches[a.pickerState.BranchPicker.SelectedIdx].IsCurrent {
 				return "branch_picker_current"
 			}
+			if a.pickerState.BranchPicker.Branches[a.pickerState.BranchPicker.SelectedIdx].IsRemote {
+				return "branch_picker_remote"
+			}
 		}
 		return "branch_picker_other"
 
+	case ModeUntrackedTriage:
+		if row, ok := a.pickerState.Untracked.Selected(); ok && row.Ignored {
+			return "untracked_triage_ignored"
+		}
+		return "untracked_triage"
+
+	case ModeLFS:
+		if row, ok := a.pickerState.LFS.Selected(); ok && row.Kind == ui.LFSRowFile {
+			return "lfs_file"
+		}
+		return "lfs_pattern"
+
+	case ModeSparseCheckout:
+		return "sparse_checkout"
+
+	case ModeDashboard:
+		return "dashboard"
+
+	case ModeProjectSwitcher:
+		return "project_switcher"
+
 	case ModePreferences:
 		return "preferences"
 
-	case ModeConfig:
-		return "menu" // Config menu uses same footer as menu
+	case ModeConfig, ModeCloneOptions:
+		return "menu" // Config and clone options menus use same footer as menu
 
 	default:
 		return ""
@@ -135,6 +174,25 @@ func (a *Application) getFileHistoryHintKey() string {
 	}
 }
 
+// getCompareHintKey returns the footer hint key for compare mode
+func (a *Application) getCompareHintKey() string {
+	if a.pickerState.Compare == nil {
+		return "compare_files"
+	}
+
+	switch a.pickerState.Compare.FocusedPane {
+	case ui.ComparePaneLeft, ui.ComparePaneRight:
+		return "compare_commits"
+	case ui.ComparePaneDiff:
+		if a.pickerState.Compare.VisualModeActive {
+			return "compare_visual"
+		}
+		return "compare_diff"
+	default:
+		return "compare_files"
+	}
+}
+
 // getConflictHintKey returns the footer hint key for conflict resolver mode
 func (a *Application) getConflictHintKey() string {
 	if a.conflictResolveState == nil {
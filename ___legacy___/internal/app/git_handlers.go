@@ -43,6 +43,16 @@ func (a *Application) handleGitOperation(msg GitOperationMsg) (tea.Model, tea.Cm
 		return a.setupConflictResolverForBranchMerge(msg)
 	}
 
+	// A bisect that never started is undone at once (session, stash)
+	if !msg.Success && msg.Step == OpBisectStart {
+		return a.handleBisectStartFailure(msg)
+	}
+
+	// A failing repository command is a recorded result, not a failed operation
+	if msg.Step == OpRepoCommand {
+		return a.handleRepoCommandResult(msg)
+	}
+
 	// Handle other failures
 	if !msg.Success {
 		return a.handleGitOperationFailure(msg, buffer)
@@ -64,6 +74,9 @@ func (a *Application) handleGitOperation(msg GitOperationMsg) (tea.Model, tea.Cm
 	case OpFetchRemote:
 		return a.handleFetchRemote(msg)
 
+	case OpDeepenHistory, OpUnshallow:
+		return a.handleShallowFetch(msg)
+
 	case OpPull:
 		return a.handlePull(msg)
 
@@ -82,7 +95,7 @@ func (a *Application) handleGitOperation(msg GitOperationMsg) (tea.Model, tea.Cm
 	case OpBranchCreate:
 		return a.handleBranchSwitch(msg)
 
-	case OpMergeBranch:
+	case OpMergeBranch, OpDeliver:
 		return a.handleMergeBranchResult(msg)
 
 	case OpFinalizeBranchMerge:
@@ -94,6 +107,15 @@ func (a *Application) handleGitOperation(msg GitOperationMsg) (tea.Model, tea.Cm
 	case OpForcePush:
 		return a.handleForcePush(msg)
 
+	case OpLFSMigrate:
+		return a.handleLFSMigrateResult(msg)
+
+	case OpSparseCheckout:
+		return a.handleSparseCheckoutResult(msg)
+
+	case OpBisect:
+		return a.handleBisectStep(msg)
+
 	case OpPushSyncNeeded:
 		return a, a.cmdPushSyncMerge()
 
@@ -0,0 +1,169 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jrengmusic/tit/internal/git"
+	"github.com/jrengmusic/tit/internal/ui"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// authDiagnosedSteps lists the operations that talk to a remote (clone, fetch, pull, push).
+// Their failures are checked for auth problems before falling back to the generic failure.
+var authDiagnosedSteps = map[string]bool{
+	OpClone:            true,
+	OpPush:             true,
+	OpForcePush:        true,
+	OpPushSyncMerge:    true,
+	OpFinalizePushSync: true,
+	OpPull:             true,
+	OpPullMerge:        true,
+	OpPullRebase:       true,
+	OpFetchRemote:      true,
+	OpDeepenHistory:    true,
+	OpUnshallow:        true,
+	OpDirtyPullMerge:   true,
+}
+
+// authRemediationActions maps each auth problem to what the dialog's YES button opens
+var authRemediationActions = map[git.AuthProblem]string{
+	git.AuthHostKeyUnknown:     "ssh_setup",
+	git.AuthHostKeyChanged:     "ssh_setup",
+	git.AuthPublicKeyDenied:    "ssh_setup",
+	git.AuthCredentialsMissing: "credential_helper",
+	git.AuthTokenRequired:      "credential_helper",
+	git.AuthAccessDenied:       "credential_helper", // SSH remotes are switched to ssh_setup in showAuthRemediation
+	git.AuthRepoNotFound:       "change_url",
+}
+
+// consoleAuthProblem diagnoses the git stderr of the current operation.
+// Streamed commands leave their stderr in the console buffer only, so the lines since
+// the last operation outcome are joined with extra (usually msg.Error) and classified.
+func consoleAuthProblem(extra string) (git.AuthProblem, string) {
+	return git.DiagnoseAuth(consoleStderr() + "\n" + extra)
+}
+
+// consoleStderr joins the stderr lines the current operation streamed to the console
+func consoleStderr() string {
+	failed := GetFooterMessageText(MessageOperationFailed)
+	complete := GetFooterMessageText(MessageOperationComplete)
+
+	var stderr []string
+	for _, line := range ui.GetBuffer().GetAllLines() {
+		switch {
+		case line.Type == ui.TypeInfo && (line.Text == failed || line.Text == complete):
+			stderr = stderr[:0] // Output of an earlier operation
+		case line.Type == ui.TypeStderr:
+			stderr = append(stderr, line.Text)
+		}
+	}
+	return strings.Join(stderr, "\n")
+}
+
+// authRemoteURL returns the URL the failed operation talked to: the clone URL, else origin
+func (a *Application) authRemoteURL(step string) string {
+	if step == OpClone {
+		return a.workflowState.CloneURL
+	}
+	result := git.Execute("remote", "get-url", "origin")
+	if !result.Success {
+		return ""
+	}
+	return result.Stdout
+}
+
+// showAuthRemediation replaces the generic failure with a dialog explaining the auth problem.
+// The console log stays one keypress away (NO returns to it).
+func (a *Application) showAuthRemediation(problem git.AuthProblem, line, step string) {
+	url := a.authRemoteURL(step)
+	target := url
+	action := authRemediationActions[problem]
+	if parsed, err := git.ParseRemoteURL(url); err == nil {
+		if parsed.Host != "" {
+			target = parsed.Host
+		}
+		if problem == git.AuthAccessDenied && parsed.Kind == git.RemoteSSH {
+			action = "ssh_setup"
+		}
+	}
+	if target == "" {
+		target = "the remote"
+	}
+
+	msg := ConfirmationMessages["auth_"+string(problem)]
+	a.mode = ModeConfirmation
+	dialog := ui.NewConfirmationDialog(
+		ui.ConfirmationConfig{
+			Title:       msg.Title,
+			Explanation: fmt.Sprintf(msg.Explanation, target, line),
+			YesLabel:    msg.YesLabel,
+			NoLabel:     msg.NoLabel,
+			ActionID:    string(ConfirmAuthRemediation),
+		},
+		a.sizing.ContentInnerWidth,
+		&a.theme,
+	)
+	a.dialogState.Show(dialog, map[string]string{"action": action, "step": step})
+}
+
+// executeConfirmAuthRemediation handles YES response: open the SSH setup wizard,
+// the credential.helper input, or the URL input for the failed remote
+func (a *Application) executeConfirmAuthRemediation() (tea.Model, tea.Cmd) {
+	action := a.dialogState.context["action"]
+	step := a.dialogState.context["step"]
+	a.dialogState.Hide()
+
+	switch action {
+	case "ssh_setup":
+		a.inputState.Value = ""
+		a.environmentState.SetupWizardError = ""
+		a.environmentState.SetupWizardStep = SetupStepWelcome
+		a.workflowState.PreviousMode = ModeMenu
+		a.mode = ModeSetupWizard
+		return a, nil
+	case "credential_helper":
+		helper := git.CredentialHelper()
+		if helper == "" {
+			helper = git.DefaultCredentialHelper()
+		}
+		a.transitionTo(ModeTransition{
+			Mode:        ModeInput,
+			InputPrompt: InputMessages["credential_helper"].Prompt,
+			InputAction: "credential_helper",
+			FooterHint:  InputMessages["credential_helper"].Hint,
+		})
+		a.inputState.ReplaceValue(helper)
+		return a, nil
+	case "change_url":
+		if step == OpClone {
+			return a, a.dispatchClone(a)
+		}
+		return a, a.dispatchConfigSwitchRemote(a)
+	}
+	return a.returnToMenu()
+}
+
+// executeRejectAuthRemediation handles NO response: back to the console log of the failure
+func (a *Application) executeRejectAuthRemediation() (tea.Model, tea.Cmd) {
+	a.dialogState.Hide()
+	a.mode = ModeConsole
+	return a, nil
+}
+
+// handleCredentialHelperSubmit saves credential.helper to the global git config
+func (a *Application) handleCredentialHelperSubmit(app *Application) (tea.Model, tea.Cmd) {
+	helper := strings.TrimSpace(app.inputState.Value)
+	if helper == "" {
+		app.footerHint = ErrorMessages["credential_helper_empty"]
+		return app, nil
+	}
+	if err := git.SetCredentialHelper(helper); err != nil {
+		app.footerHint = fmt.Sprintf(ErrorMessages["credential_helper_failed"], err)
+		return app, nil
+	}
+	model, cmd := app.returnToMenu()
+	app.footerHint = fmt.Sprintf(ConsoleMessages["credential_helper_set"], helper)
+	return model, cmd
+}
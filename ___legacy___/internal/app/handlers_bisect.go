@@ -0,0 +1,164 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jrengmusic/tit/internal/git"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ========================================
+// Bisect: ends marked in history, verdicts from the menu
+// ========================================
+
+// handleHistoryBisectGood handles "G" in the history browser: marks the selected commit good
+func (a *Application) handleHistoryBisectGood(app *Application) (tea.Model, tea.Cmd) {
+	return app.markBisectEnd(git.BisectGood)
+}
+
+// handleHistoryBisectBad handles "B" in the history browser: marks the selected commit bad
+func (a *Application) handleHistoryBisectBad(app *Application) (tea.Model, tea.Cmd) {
+	return app.markBisectEnd(git.BisectBad)
+}
+
+// markBisectEnd records the selected commit as one end of the range; once both
+// ends are marked and ordered, asks for the optional test command
+func (a *Application) markBisectEnd(verdict string) (tea.Model, tea.Cmd) {
+	history := a.pickerState.History
+	if history == nil || history.SelectedIdx < 0 || history.SelectedIdx >= len(history.Commits) {
+		return a, nil
+	}
+	if a.gitState.Operation != git.Normal {
+		a.footerHint = ErrorMessages["bisect_not_normal"]
+		return a, nil
+	}
+
+	// Re-marking a commit moves it to the other end
+	hash := history.Commits[history.SelectedIdx].Hash
+	if verdict == git.BisectGood {
+		a.workflowState.BisectGood = hash
+		if a.workflowState.BisectBad == hash {
+			a.workflowState.BisectBad = ""
+		}
+	} else {
+		a.workflowState.BisectBad = hash
+		if a.workflowState.BisectGood == hash {
+			a.workflowState.BisectGood = ""
+		}
+	}
+	good, bad := a.workflowState.BisectGood, a.workflowState.BisectBad
+
+	switch {
+	case good == "" || bad == "":
+		other, key := git.BisectBad, "B"
+		if bad != "" {
+			other, key = git.BisectGood, "G"
+		}
+		a.footerHint = fmt.Sprintf(ConsoleMessages["bisect_marked"], git.ShortenHash(hash), verdict, other, key)
+		return a, nil
+	case !git.Execute("merge-base", "--is-ancestor", good, bad).Success:
+		a.footerHint = fmt.Sprintf(ErrorMessages["bisect_range_invalid"], git.ShortenHash(good), git.ShortenHash(bad))
+		return a, nil
+	}
+
+	a.promptBisectRun(good, bad)
+	return a, nil
+}
+
+// promptBisectRun opens the test command input for the good..bad range
+func (a *Application) promptBisectRun(good, bad string) {
+	msg := InputMessages["bisect_run"]
+	a.transitionTo(ModeTransition{
+		Mode:        ModeInput,
+		InputPrompt: fmt.Sprintf(msg.Prompt, git.ShortenHash(good), git.ShortenHash(bad)),
+		InputAction: "bisect_run",
+		FooterHint:  msg.Hint,
+	})
+}
+
+// handleBisectRunSubmit starts bisecting the marked range, or hands the running
+// session to the test command; an empty command leaves testing to the user
+func (a *Application) handleBisectRunSubmit(app *Application) (tea.Model, tea.Cmd) {
+	testCmd := strings.TrimSpace(app.inputState.Value)
+	app.inputState.Reset()
+
+	if app.gitState.Operation == git.Bisecting {
+		if testCmd == "" {
+			return app.returnToMenu()
+		}
+		app.prepareAsyncOperation(ConsoleMessages["bisect_run_status"])
+		return app, app.cmdBisectRun(testCmd)
+	}
+
+	good, bad := app.workflowState.BisectGood, app.workflowState.BisectBad
+	app.workflowState.BisectGood, app.workflowState.BisectBad = "", ""
+	if good == "" || bad == "" || app.gitState.Operation != git.Normal {
+		return app.returnToMenu()
+	}
+	app.prepareAsyncOperation(ConsoleMessages["bisect_start_status"])
+	return app, app.cmdBisectStart(good, bad, testCmd)
+}
+
+// menuBisecting returns the verdict menu; once the culprit is known only "stop" remains
+func (a *Application) menuBisecting() []MenuItem {
+	var items []MenuItem
+	if progress := a.gitState.Bisect; progress == nil || progress.Culprit == "" {
+		items = append(items,
+			GetMenuItem("bisect_good"),
+			GetMenuItem("bisect_bad"),
+			GetMenuItem("bisect_skip"),
+			GetMenuItem("bisect_run"),
+		)
+	}
+	return append(items, GetMenuItem("bisect_stop"))
+}
+
+// bisectDescription is the header's timeline description while bisecting
+func (a *Application) bisectDescription(progress *git.BisectProgress) string {
+	switch {
+	case progress.Culprit != "":
+		return fmt.Sprintf(StateDescriptions["bisect_found"], git.ShortenHash(progress.Culprit))
+	case progress.Good == "" || progress.Bad == "":
+		return StateDescriptions["bisect_awaiting"]
+	}
+	return fmt.Sprintf(StateDescriptions["bisect_range"],
+		git.ShortenHash(progress.Good), git.ShortenHash(progress.Bad), progress.Remaining, progress.Steps)
+}
+
+// dispatchBisectGood marks the checked-out commit good
+func (a *Application) dispatchBisectGood(app *Application) tea.Cmd {
+	return app.dispatchBisectVerdict(git.BisectGood)
+}
+
+// dispatchBisectBad marks the checked-out commit bad
+func (a *Application) dispatchBisectBad(app *Application) tea.Cmd {
+	return app.dispatchBisectVerdict(git.BisectBad)
+}
+
+// dispatchBisectSkip leaves the checked-out commit out of the search
+func (a *Application) dispatchBisectSkip(app *Application) tea.Cmd {
+	return app.dispatchBisectVerdict(git.BisectSkip)
+}
+
+// dispatchBisectVerdict records verdict for HEAD; git checks out the next commit to test
+func (a *Application) dispatchBisectVerdict(verdict string) tea.Cmd {
+	a.prepareAsyncOperation(fmt.Sprintf(ConsoleMessages["bisect_mark_status"], verdict))
+	return a.cmdBisectMark(verdict)
+}
+
+// dispatchBisectRun asks for the test command to finish the session with
+func (a *Application) dispatchBisectRun(app *Application) tea.Cmd {
+	progress := app.gitState.Bisect
+	if progress == nil {
+		return nil
+	}
+	app.promptBisectRun(progress.Good, progress.Bad)
+	return nil
+}
+
+// dispatchBisectStop ends the session and restores the original branch and changes
+func (a *Application) dispatchBisectStop(app *Application) tea.Cmd {
+	return app.finishBisect()
+}
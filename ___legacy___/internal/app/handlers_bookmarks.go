@@ -0,0 +1,211 @@
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jrengmusic/tit/internal/git"
+	"github.com/jrengmusic/tit/internal/ui"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ========================================
+// Time travel trail (multi-hop sessions) and commit bookmarks
+// ========================================
+
+// loadBookmarks reads the repository's bookmarks; an unreadable file is reported and ignored
+func (a *Application) loadBookmarks() {
+	a.bookmarks = nil
+	if a.gitState == nil || a.gitState.Operation == git.NotRepo {
+		return
+	}
+	bookmarks, err := git.LoadBookmarks()
+	if err != nil {
+		git.Warn(fmt.Sprintf(ErrorMessages["bookmark_load_failed"], err))
+		return
+	}
+	a.bookmarks = bookmarks
+}
+
+// hoppingBlocked reports whether moving to another commit must wait: changes made
+// while time traveling would otherwise be carried along or lost
+func (a *Application) hoppingBlocked() bool {
+	return a.gitState.Operation == git.TimeTraveling && a.gitState.WorkingTree == git.Dirty
+}
+
+// currentTravelHash returns the checked-out commit while time traveling, or ""
+func (a *Application) currentTravelHash() string {
+	if a.gitState.Operation != git.TimeTraveling || a.timeTravelState.info == nil {
+		return ""
+	}
+	return a.timeTravelState.info.CurrentCommit.Hash
+}
+
+// bookmarkMenuItems returns one quick-travel item per bookmark, the first nine
+// reachable with 1-9. The commit already checked out is left out.
+func (a *Application) bookmarkMenuItems() []MenuItem {
+	current := a.currentTravelHash()
+	var items []MenuItem
+	for _, b := range a.bookmarks {
+		if b.Hash == current {
+			continue
+		}
+		shortcut := ""
+		if len(items) < 9 {
+			shortcut = strconv.Itoa(len(items) + 1)
+		}
+		hint := fmt.Sprintf(ConsoleMessages["bookmark_travel_hint"], git.ShortenHash(b.Hash))
+		if a.hoppingBlocked() {
+			hint = ErrorMessages["time_travel_hop_dirty"]
+		}
+		items = append(items, Item(BookmarkActionPrefix+b.Name).
+			Shortcut(shortcut).
+			Emoji("🔖").
+			Label(ui.TruncateLabel(b.Name, MenuLabelMaxWidth)).
+			Hint(hint).
+			When(!a.hoppingBlocked()).
+			Build())
+	}
+	return items
+}
+
+// timeTravelTrailItems returns "back one hop" (once there is a previous hop) and "bookmark this commit"
+func (a *Application) timeTravelTrailItems() []MenuItem {
+	var items []MenuItem
+	if info := a.timeTravelState.info; info != nil && len(info.Hops) >= 2 {
+		back := GetMenuItem("time_travel_back")
+		if a.hoppingBlocked() {
+			back.Enabled = false
+			back.Hint = ErrorMessages["time_travel_hop_dirty"]
+		}
+		items = append(items, back)
+	}
+	return append(items, GetMenuItem("time_travel_bookmark"))
+}
+
+// timeTravelBreadcrumb renders the session trail, e.g. "main ▸ 3f2a9c1 ▸ last good build".
+// Bookmarked hops show their name; long trails keep the most recent hops.
+func (a *Application) timeTravelBreadcrumb(info *git.TimeTravelInfo) string {
+	parts := []string{info.OriginalBranch}
+	hops := info.Hops
+	if len(hops) > BreadcrumbMaxHops {
+		parts = append(parts, "…")
+		hops = hops[len(hops)-BreadcrumbMaxHops:]
+	}
+	for _, hop := range hops {
+		label := git.ShortenHash(hop)
+		if name := git.BookmarkName(a.bookmarks, hop); name != "" {
+			label = ui.TruncateLabel(name, MenuLabelMaxWidth)
+		}
+		parts = append(parts, label)
+	}
+	return strings.Join(parts, " ▸ ")
+}
+
+// dispatchBookmarkTravel asks to time travel to the bookmark called name
+func (a *Application) dispatchBookmarkTravel(name string) tea.Cmd {
+	if a.hoppingBlocked() {
+		a.footerHint = ErrorMessages["time_travel_hop_dirty"]
+		return nil
+	}
+	for _, b := range a.bookmarks {
+		if b.Name != name {
+			continue
+		}
+		result := git.Execute("log", "-1", "--format=%s", b.Hash)
+		if !result.Success {
+			a.footerHint = fmt.Sprintf(ErrorMessages["bookmark_missing"], name)
+			return nil
+		}
+		a.workflowState.PreviousMode = a.mode
+		a.showTimeTravelConfirmation(b.Hash, strings.TrimSpace(result.Stdout))
+		return nil
+	}
+	return nil
+}
+
+// dispatchTimeTravelBack checks out the commit visited before the current one
+func (a *Application) dispatchTimeTravelBack(app *Application) tea.Cmd {
+	if app.hoppingBlocked() {
+		app.footerHint = ErrorMessages["time_travel_hop_dirty"]
+		return nil
+	}
+	app.prepareAsyncOperation(ConsoleMessages["time_travel_back_status"])
+	app.timeTravelState.MarkRestoreInitiated()
+	return git.ExecuteTimeTravelBack()
+}
+
+// dispatchTimeTravelBookmark names the commit checked out while time traveling
+func (a *Application) dispatchTimeTravelBookmark(app *Application) tea.Cmd {
+	hash := app.currentTravelHash()
+	if hash == "" {
+		return nil
+	}
+	app.promptBookmarkName(hash, false)
+	return nil
+}
+
+// handleHistoryBookmark handles "b" in the history browser: names the selected commit
+func (a *Application) handleHistoryBookmark(app *Application) (tea.Model, tea.Cmd) {
+	history := app.pickerState.History
+	if history == nil || history.SelectedIdx < 0 || history.SelectedIdx >= len(history.Commits) {
+		return app, nil
+	}
+	app.promptBookmarkName(history.Commits[history.SelectedIdx].Hash, true)
+	return app, nil
+}
+
+// promptBookmarkName opens the bookmark name input for hash, prefilled with its current name
+func (a *Application) promptBookmarkName(hash string, fromHistory bool) {
+	a.workflowState.BookmarkHash = hash
+	a.workflowState.BookmarkReturnToHistory = fromHistory
+	msg := InputMessages["bookmark_name"]
+	a.transitionTo(ModeTransition{
+		Mode:        ModeInput,
+		InputPrompt: fmt.Sprintf(msg.Prompt, git.ShortenHash(hash)),
+		InputAction: "bookmark_name",
+		FooterHint:  msg.Hint,
+	})
+	a.inputState.ReplaceValue(git.BookmarkName(a.bookmarks, hash))
+}
+
+// handleBookmarkNameSubmit saves the bookmark; an empty name removes the commit's bookmark.
+// Returns to the history browser when the bookmark was added from there.
+func (a *Application) handleBookmarkNameSubmit(app *Application) (tea.Model, tea.Cmd) {
+	hash := app.workflowState.BookmarkHash
+	name := strings.TrimSpace(app.inputState.Value)
+
+	var hint string
+	if name == "" {
+		existing := git.BookmarkName(app.bookmarks, hash)
+		if existing != "" {
+			if err := git.DeleteBookmark(existing); err != nil {
+				app.footerHint = fmt.Sprintf(ErrorMessages["bookmark_save_failed"], err)
+				return app, nil
+			}
+			hint = fmt.Sprintf(ConsoleMessages["bookmark_removed"], existing)
+		}
+	} else {
+		if err := git.SaveBookmark(name, hash); err != nil {
+			app.footerHint = fmt.Sprintf(ErrorMessages["bookmark_save_failed"], err)
+			return app, nil
+		}
+		hint = fmt.Sprintf(ConsoleMessages["bookmark_saved"], git.ShortenHash(hash), name)
+	}
+	fromHistory := app.workflowState.BookmarkReturnToHistory
+	app.workflowState.BookmarkHash = ""
+	app.workflowState.BookmarkReturnToHistory = false
+	app.loadBookmarks()
+
+	if fromHistory && app.pickerState.History != nil {
+		app.inputState.Reset()
+		app.mode = ModeHistory
+		app.footerHint = hint
+		return app, nil
+	}
+	model, cmd := app.returnToMenu()
+	app.footerHint = hint
+	return model, cmd
+}
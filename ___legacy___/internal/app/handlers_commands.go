@@ -0,0 +1,190 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jrengmusic/tit/internal/config"
+	"github.com/jrengmusic/tit/internal/git"
+	"github.com/jrengmusic/tit/internal/ui"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ========================================
+// Repository commands ([[command]] in .tit.toml) run at time travel commits
+// ========================================
+
+// reservedShortcutKeys are menu keys a command shortcut must not take over (navigation, quit, search)
+const reservedShortcutKeys = "jkq/"
+
+// loadRepoCommands reads the repository's commands. While time traveling the
+// checked-out commit may predate .tit.toml, so the departure branch's committed
+// file is read instead. A broken file is reported and ignored.
+func (a *Application) loadRepoCommands() {
+	a.repoCommands = nil
+	if a.gitState == nil || a.gitState.Operation == git.NotRepo {
+		return
+	}
+
+	var commands []config.RepoCommand
+	var err error
+	marker, markerErr := git.ReadTimeTravelMarker()
+	if a.gitState.Operation == git.TimeTraveling && markerErr == nil {
+		result := git.Execute("show", marker.OriginalBranch+":"+config.RepoConfigFile)
+		if !result.Success {
+			return // No .tit.toml on the branch
+		}
+		commands, err = config.ParseRepoCommands([]byte(result.Stdout))
+	} else {
+		commands, err = config.LoadRepoCommands(".")
+	}
+	if err != nil {
+		git.Warn(fmt.Sprintf(ErrorMessages["repo_commands_load_failed"], err))
+		return
+	}
+	a.repoCommands = commands
+}
+
+// runRecordsByHash loads the recorded command results for the history browser
+func (a *Application) runRecordsByHash() map[string][]git.RunRecord {
+	records, err := git.LoadRunRecords()
+	if err != nil {
+		git.Warn(fmt.Sprintf(ErrorMessages["repo_command_results_failed"], err))
+		return nil
+	}
+	return git.RunRecordsByHash(records)
+}
+
+// repoCommandMenuItems returns one run item per repository command. Each takes
+// the first letter of its name not already used by the other items.
+func (a *Application) repoCommandMenuItems(others []MenuItem) []MenuItem {
+	taken := reservedShortcutKeys
+	for _, item := range others {
+		taken += item.Shortcut
+	}
+
+	hash := git.ShortenHash(a.currentTravelHash())
+	var items []MenuItem
+	for _, cmd := range a.repoCommands {
+		shortcut := ""
+		for _, r := range strings.ToLower(cmd.Name) {
+			if r >= 'a' && r <= 'z' && !strings.ContainsRune(taken, r) {
+				shortcut = string(r)
+				taken += shortcut
+				break
+			}
+		}
+		items = append(items, Item(RepoCommandActionPrefix+cmd.Name).
+			Shortcut(shortcut).
+			Emoji("🧪").
+			Label(ui.TruncateLabel("Run "+cmd.Name, MenuLabelMaxWidth)).
+			Hint(fmt.Sprintf(ConsoleMessages["repo_command_hint"], hash)+" · "+cmd.Run).
+			Build())
+	}
+	return items
+}
+
+// dispatchRepoCommand runs the command called name at the checked-out commit
+func (a *Application) dispatchRepoCommand(name string) tea.Cmd {
+	hash := a.currentTravelHash()
+	if hash == "" {
+		return nil
+	}
+	for _, cmd := range a.repoCommands {
+		if cmd.Name != name {
+			continue
+		}
+		a.prepareAsyncOperation(fmt.Sprintf(ConsoleMessages["repo_command_status"], name, git.ShortenHash(hash)))
+		return a.cmdRepoCommand(cmd, hash)
+	}
+	return nil
+}
+
+// cmdRepoCommand streams the command's output to the console and records
+// pass/fail and duration against hash. An aborted run is not recorded.
+func (a *Application) cmdRepoCommand(cmd config.RepoCommand, hash string) tea.Cmd {
+	ctx, cancel := context.WithCancel(context.Background())
+	a.OperationState.cancelContext = cancel
+	return func() tea.Msg {
+		git.Log(fmt.Sprintf(ConsoleMessages["repo_command_start"], cmd.Run))
+		untrackedBefore, _ := git.ListUntracked()
+		start := time.Now()
+		result := git.StreamShell(ctx, cmd.Run)
+		if ctx.Err() != nil {
+			return GitOperationMsg{Step: OpRepoCommand, Success: false, Error: fmt.Sprintf(ErrorMessages["repo_command_aborted"], cmd.Name)}
+		}
+		warnRunOutput(untrackedBefore)
+
+		record := git.RunRecord{
+			Hash:     hash,
+			Command:  cmd.Name,
+			Passed:   result.Success,
+			Duration: time.Since(start),
+			At:       time.Now(),
+		}
+		msg := GitOperationMsg{Step: OpRepoCommand, Success: record.Passed}
+		if record.Passed {
+			msg.Output = fmt.Sprintf(ConsoleMessages["repo_command_passed"], cmd.Name, ui.FormatRunDuration(record.Duration))
+		} else {
+			msg.Output = fmt.Sprintf(ConsoleMessages["repo_command_failed"], cmd.Name, result.ExitCode, ui.FormatRunDuration(record.Duration))
+		}
+		if err := git.SaveRunRecord(record); err != nil {
+			msg.Error = fmt.Sprintf(ErrorMessages["repo_command_record_failed"], err)
+		}
+		return msg
+	}
+}
+
+// warnRunOutput warns when a run left untracked, not ignored files behind: they
+// make the tree dirty, which blocks time travel hops and makes Return offer to
+// carry them back to the branch. Ignored output (e.g. build/ in .gitignore) is
+// not counted by git status and never does.
+func warnRunOutput(untrackedBefore []string) {
+	untrackedAfter, err := git.ListUntracked()
+	if err != nil {
+		return
+	}
+	existed := make(map[string]bool, len(untrackedBefore))
+	for _, path := range untrackedBefore {
+		existed[path] = true
+	}
+	left := 0
+	for _, path := range untrackedAfter {
+		if !existed[path] {
+			left++
+		}
+	}
+	if left > 0 {
+		git.Warn(fmt.Sprintf(ConsoleMessages["repo_command_left_files"], left))
+	}
+}
+
+// handleRepoCommandResult handles OpRepoCommand: reports the recorded result and
+// stays in the console; ESC returns to the time travel menu
+func (a *Application) handleRepoCommandResult(msg GitOperationMsg) (tea.Model, tea.Cmd) {
+	buffer := ui.GetBuffer()
+	if msg.Output == "" {
+		// Aborted before finishing: nothing was recorded
+		return a.handleGitOperationFailure(msg, buffer)
+	}
+
+	if msg.Error != "" {
+		buffer.Append(msg.Error, ui.TypeStderr)
+	}
+	outputType := ui.TypeStatus
+	if !msg.Success {
+		outputType = ui.TypeStderr
+	}
+	buffer.Append(msg.Output, outputType)
+	a.footerHint = msg.Output
+	a.EndAsyncOp()
+
+	// Builds may leave files behind: refresh the working tree status
+	if err := a.reloadGitState(); err != nil {
+		buffer.Append(fmt.Sprintf(ErrorMessages["failed_detect_state"], err), ui.TypeStderr)
+	}
+	return a, nil
+}
@@ -0,0 +1,289 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jrengmusic/tit/internal/git"
+	"github.com/jrengmusic/tit/internal/ui"
+
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ========================================
+// Compare Mode: two commits, branches or tags side by side
+// ========================================
+
+// handleHistoryCompare handles "c" in the history browser: marks the selected commit for compare
+func (a *Application) handleHistoryCompare(app *Application) (tea.Model, tea.Cmd) {
+	history := app.pickerState.History
+	if history == nil || history.SelectedIdx < 0 || history.SelectedIdx >= len(history.Commits) {
+		return app, nil
+	}
+	hash := history.Commits[history.SelectedIdx].Hash
+	return app.markCompareRef(hash, git.ShortenHash(hash))
+}
+
+// handleBranchPickerCompare handles "c" in the branch picker: marks the selected branch or tag for compare
+func (a *Application) handleBranchPickerCompare(app *Application) (tea.Model, tea.Cmd) {
+	sel := app.selectedPickerBranch()
+	if sel == nil {
+		return app, nil
+	}
+	return app.markCompareRef(compareRefName(*sel), sel.Name)
+}
+
+// compareRefName returns the full ref of a picker row, so a tag and a branch
+// sharing a name stay apart
+func compareRefName(b ui.BranchInfo) string {
+	switch {
+	case b.IsTag:
+		return "refs/tags/" + b.Name
+	case b.IsRemote:
+		return "refs/remotes/" + b.Name
+	default:
+		return "refs/heads/" + b.Name
+	}
+}
+
+// markCompareRef marks ref as one side of a compare. The first mark is kept;
+// marking a second ref opens the compare view (first mark on the left).
+// Marking the same ref again clears the mark.
+func (a *Application) markCompareRef(ref, label string) (tea.Model, tea.Cmd) {
+	first, firstLabel := a.workflowState.CompareRef, a.workflowState.CompareLabel
+	switch {
+	case first == "":
+		a.workflowState.CompareRef = ref
+		a.workflowState.CompareLabel = label
+		a.footerHint = fmt.Sprintf(ConsoleMessages["compare_marked"], label)
+		return a, nil
+	case first == ref:
+		a.clearCompareMark()
+		a.footerHint = fmt.Sprintf(ConsoleMessages["compare_unmarked"], label)
+		return a, nil
+	}
+
+	a.clearCompareMark()
+	a.openCompare(first, firstLabel, ref, label)
+	return a, nil
+}
+
+// clearCompareMark forgets the first marked side
+func (a *Application) clearCompareMark() {
+	a.workflowState.CompareRef = ""
+	a.workflowState.CompareLabel = ""
+}
+
+// openCompare loads both sides and enters compare mode, three-dot by default.
+// ESC returns to the mode compare was opened from.
+func (a *Application) openCompare(left, leftLabel, right, rightLabel string) {
+	leftOnly, rightOnly, err := git.CompareCommits(left, right, CompareCommitLimit)
+	if err != nil {
+		a.footerHint = fmt.Sprintf(ErrorMessages["compare_failed"], leftLabel, rightLabel, err)
+		return
+	}
+	files, err := git.CompareFiles(left, right, git.CompareThreeDot)
+	if err != nil {
+		a.footerHint = fmt.Sprintf(ErrorMessages["compare_failed"], leftLabel, rightLabel, err)
+		return
+	}
+
+	a.pickerState.Compare = &ui.CompareState{
+		Left:            left,
+		Right:           right,
+		LeftLabel:       leftLabel,
+		RightLabel:      rightLabel,
+		Mode:            git.CompareThreeDot,
+		LeftOnly:        leftOnly,
+		RightOnly:       rightOnly,
+		Files:           files,
+		FocusedPane:     ui.ComparePaneFiles,
+		SplitDiff:       a.splitDiff(),
+		SyntaxHighlight: a.syntaxHighlight(),
+	}
+	a.updateCompareDiff()
+
+	a.workflowState.CompareReturnMode = a.mode
+	a.mode = ModeCompare
+	a.footerHint = ""
+}
+
+// updateCompareDiff loads the selected file's diff and resets the diff cursor.
+// Called whenever the file selection or range form changes.
+func (a *Application) updateCompareDiff() {
+	state := a.pickerState.Compare
+	if state == nil {
+		return
+	}
+	state.DiffContent = ""
+	state.DiffLineCursor = 0
+	state.DiffScrollOff = 0
+	state.VisualModeActive = false
+	if state.SelectedFileIdx < 0 || state.SelectedFileIdx >= len(state.Files) {
+		return
+	}
+
+	file := state.Files[state.SelectedFileIdx]
+	if file.Binary {
+		binary, err := git.GetCompareBinaryDiff(state.Left, state.Right, file.Path, state.Mode)
+		if err != nil {
+			a.footerHint = fmt.Sprintf(ErrorMessages["compare_diff_failed"], err)
+			return
+		}
+		state.DiffContent = binaryDiffContent(binary)
+		return
+	}
+
+	diff, err := git.GetCompareDiff(state.Left, state.Right, file.Path, state.Mode)
+	if err != nil {
+		a.footerHint = fmt.Sprintf(ErrorMessages["compare_diff_failed"], err)
+		return
+	}
+	state.DiffContent = diff
+}
+
+// handleCompareUp navigates up in the focused compare pane
+func (a *Application) handleCompareUp(app *Application) (tea.Model, tea.Cmd) {
+	state := app.pickerState.Compare
+	if state == nil {
+		return app, nil
+	}
+
+	switch state.FocusedPane {
+	case ui.ComparePaneLeft:
+		if state.LeftIdx > 0 {
+			state.LeftIdx--
+		}
+	case ui.ComparePaneRight:
+		if state.RightIdx > 0 {
+			state.RightIdx--
+		}
+	case ui.ComparePaneFiles:
+		if state.SelectedFileIdx > 0 {
+			state.SelectedFileIdx--
+			app.updateCompareDiff()
+		}
+	case ui.ComparePaneDiff:
+		if state.DiffLineCursor > 0 {
+			state.DiffLineCursor--
+		}
+	}
+	return app, nil
+}
+
+// handleCompareDown navigates down in the focused compare pane
+func (a *Application) handleCompareDown(app *Application) (tea.Model, tea.Cmd) {
+	state := app.pickerState.Compare
+	if state == nil {
+		return app, nil
+	}
+
+	switch state.FocusedPane {
+	case ui.ComparePaneLeft:
+		if state.LeftIdx < len(state.LeftOnly)-1 {
+			state.LeftIdx++
+		}
+	case ui.ComparePaneRight:
+		if state.RightIdx < len(state.RightOnly)-1 {
+			state.RightIdx++
+		}
+	case ui.ComparePaneFiles:
+		if state.SelectedFileIdx < len(state.Files)-1 {
+			state.SelectedFileIdx++
+			app.updateCompareDiff()
+		}
+	case ui.ComparePaneDiff:
+		state.DiffLineCursor++
+	}
+	return app, nil
+}
+
+// handleCompareTab cycles focus: left commits → right commits → files → diff
+func (a *Application) handleCompareTab(app *Application) (tea.Model, tea.Cmd) {
+	if app.pickerState.Compare == nil {
+		return app, nil
+	}
+	app.pickerState.Compare.NextPane()
+	return app, nil
+}
+
+// handleCompareToggleRange handles "m": switches between three-dot (merge base
+// vs right tip) and two-dot (left tip vs right tip) and reloads the files
+func (a *Application) handleCompareToggleRange(app *Application) (tea.Model, tea.Cmd) {
+	state := app.pickerState.Compare
+	if state == nil {
+		return app, nil
+	}
+
+	mode := git.CompareTwoDot
+	if state.Mode == git.CompareTwoDot {
+		mode = git.CompareThreeDot
+	}
+	files, err := git.CompareFiles(state.Left, state.Right, mode)
+	if err != nil {
+		app.footerHint = fmt.Sprintf(ErrorMessages["compare_failed"], state.LeftLabel, state.RightLabel, err)
+		return app, nil
+	}
+
+	state.Mode = mode
+	state.Files = files
+	state.SelectedFileIdx = 0
+	app.updateCompareDiff()
+	app.footerHint = fmt.Sprintf(ConsoleMessages["compare_range"], state.LeftLabel, mode, state.RightLabel)
+	return app, nil
+}
+
+// handleCompareCopy copies the diff line under the cursor (or the visual selection) to the clipboard
+func (a *Application) handleCompareCopy(app *Application) (tea.Model, tea.Cmd) {
+	state := app.pickerState.Compare
+	if state == nil || state.FocusedPane != ui.ComparePaneDiff {
+		return app, nil
+	}
+
+	start := state.DiffLineCursor
+	if state.VisualModeActive {
+		start = state.VisualModeStart
+		state.VisualModeActive = false
+	}
+	linesToCopy := selectedDiffLines(state.DiffContent, state.SplitDiff, start, state.DiffLineCursor)
+	if len(linesToCopy) > 0 {
+		if err := clipboard.WriteAll(strings.Join(linesToCopy, "\n")); err == nil {
+			app.footerHint = ConsoleMessages["copy_success"]
+		} else {
+			app.footerHint = ConsoleMessages["copy_failed"]
+		}
+	}
+	return app, nil
+}
+
+// handleCompareVisualMode toggles visual selection in the diff pane
+func (a *Application) handleCompareVisualMode(app *Application) (tea.Model, tea.Cmd) {
+	state := app.pickerState.Compare
+	if state == nil || state.FocusedPane != ui.ComparePaneDiff {
+		return app, nil
+	}
+
+	if state.VisualModeActive {
+		state.VisualModeActive = false
+		app.footerHint = ""
+	} else {
+		state.VisualModeActive = true
+		state.VisualModeStart = state.DiffLineCursor
+		app.footerHint = ConsoleMessages["visual_mode_active"]
+	}
+	return app, nil
+}
+
+// handleCompareEsc leaves visual mode, otherwise returns to where compare was opened
+func (a *Application) handleCompareEsc() (tea.Model, tea.Cmd) {
+	if a.pickerState.Compare != nil && a.pickerState.Compare.VisualModeActive {
+		a.pickerState.Compare.VisualModeActive = false
+		a.footerHint = ""
+		return a, nil
+	}
+	a.pickerState.ResetCompare()
+	a.mode = a.workflowState.CompareReturnMode
+	a.footerHint = ""
+	return a, nil
+}
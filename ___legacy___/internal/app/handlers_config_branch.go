@@ -68,10 +68,16 @@ func (a *Application) handleBranchPickerEnter(app *Application) (tea.Model, tea.
 			app.workflowState.ReturnToBranchName = selectedBranch.Name
 			cmd = a.cmdSwitchBranch(selectedBranch.Name)
 
+		case app.workflowState.BranchPickerPurpose == BranchPickerPurposeCompare:
+			model, cmd = app.markCompareRef(compareRefName(selectedBranch), selectedBranch.Name)
+
 		case app.workflowState.BranchPickerPurpose == BranchPickerPurposeMerge:
 			app.workflowState.BranchPickerPurpose = ""
 			model, cmd = app.handleMergeBranchSelection(selectedBranch.Name)
 
+		case selectedBranch.IsRemote:
+			app.trackRemoteBranch(selectedBranch)
+
 		case selectedBranch.IsCurrent:
 			app.workflowState.PreviousMode = ModeMenu
 			app.mode = ModeConfig
@@ -150,7 +156,7 @@ func (a *Application) handleBranchPickerDelete(app *Application) (tea.Model, tea
 
 	if valid {
 		sel := picker.Branches[picker.SelectedIdx]
-		if !sel.IsCurrent {
+		if !app.refuseReadOnlyBranch(&sel) && !sel.IsCurrent {
 			app.workflowState.PreviousMode = ModeBranchPicker
 			app.mode = ModeConfirmation
 			dialogContext := map[string]string{"targetBranch": sel.Name}
@@ -176,25 +182,15 @@ func (a *Application) handleBranchPickerDelete(app *Application) (tea.Model, tea
 // refreshBranchPicker reloads branch list and updates picker state.
 // If selectName is non-empty, the matching branch is focused; otherwise the old index is clamped.
 func (a *Application) refreshBranchPicker(selectName string) error {
-	branches, err := git.ListBranchesWithDetails()
+	load := loadSwitchPickerBranches
+	if a.workflowState.BranchPickerPurpose == BranchPickerPurposeCompare {
+		load = loadComparePickerBranches
+	}
+	uiBranches, canon, err := load()
 
 	if err == nil {
-		uiBranches := make([]ui.BranchInfo, len(branches))
-		for i, b := range branches {
-			uiBranches[i] = ui.BranchInfo{
-				Name:           b.Name,
-				IsCurrent:      b.IsCurrent,
-				LastCommitTime: b.LastCommitTime,
-				LastCommitHash: b.LastCommitHash,
-				LastCommitSubj: b.LastCommitSubj,
-				Author:         b.Author,
-				TrackingRemote: b.TrackingRemote,
-				Ahead:          b.Ahead,
-				Behind:         b.Behind,
-			}
-		}
-
 		a.pickerState.BranchPicker.Branches = uiBranches
+		a.pickerState.BranchPicker.Canon = canon
 
 		newIdx := a.pickerState.BranchPicker.SelectedIdx
 		if selectName != "" {
@@ -218,3 +214,311 @@ func (a *Application) refreshBranchPicker(selectName string) error {
 
 	return err
 }
+
+// toUIBranches converts git branch details to picker rows
+func toUIBranches(branches []git.BranchDetails) []ui.BranchInfo {
+	uiBranches := make([]ui.BranchInfo, len(branches))
+	for i, b := range branches {
+		uiBranches[i] = ui.BranchInfo{
+			Name:           b.Name,
+			IsCurrent:      b.IsCurrent,
+			LastCommitTime: b.LastCommitTime,
+			LastCommitHash: b.LastCommitHash,
+			LastCommitSubj: b.LastCommitSubj,
+			Author:         b.Author,
+			TrackingRemote: b.TrackingRemote,
+			Ahead:          b.Ahead,
+			Behind:         b.Behind,
+			Gone:           b.Gone,
+			Merged:         b.Merged,
+			IsRemote:       b.IsRemote,
+			HasLocal:       b.HasLocal,
+			IsTag:          b.IsTag,
+		}
+	}
+	return uiBranches
+}
+
+// loadSwitchPickerBranches lists local then remote branches, marked merged relative to canon
+func loadSwitchPickerBranches() ([]ui.BranchInfo, string, error) {
+	locals, err := git.ListBranchesWithDetails()
+	if err != nil {
+		return nil, "", err
+	}
+	remotes, err := git.ListRemoteBranchesWithDetails(locals)
+	if err != nil {
+		return nil, "", err
+	}
+
+	branches := append(locals, remotes...)
+	canon := git.CanonBranch()
+	git.MarkMerged(branches, canon)
+	return toUIBranches(branches), canon, nil
+}
+
+// loadComparePickerBranches lists the switch picker's branches followed by tags
+func loadComparePickerBranches() ([]ui.BranchInfo, string, error) {
+	branches, canon, err := loadSwitchPickerBranches()
+	if err != nil {
+		return nil, "", err
+	}
+	tags, err := git.ListTagsWithDetails()
+	if err != nil {
+		return nil, "", err
+	}
+	return append(branches, toUIBranches(tags)...), canon, nil
+}
+
+// refuseReadOnlyBranch explains why a remote branch or tag row cannot be changed
+// from the picker; returns false for local branches
+func (a *Application) refuseReadOnlyBranch(sel *ui.BranchInfo) bool {
+	switch {
+	case sel.IsTag:
+		a.footerHint = ErrorMessages["branch_tag_read_only"]
+	case sel.IsRemote:
+		a.footerHint = ErrorMessages["branch_remote_read_only"]
+	default:
+		return false
+	}
+	return true
+}
+
+// selectedPickerBranch returns the branch under the picker cursor, nil if none
+func (a *Application) selectedPickerBranch() *ui.BranchInfo {
+	picker := a.pickerState.BranchPicker
+	if picker == nil || picker.SelectedIdx < 0 || picker.SelectedIdx >= len(picker.Branches) {
+		return nil
+	}
+	return &picker.Branches[picker.SelectedIdx]
+}
+
+// trackRemoteBranch creates a local branch tracking the selected remote branch (ENTER on a remote row).
+// When a local branch already tracks it, the cursor moves to that branch instead.
+func (a *Application) trackRemoteBranch(remote ui.BranchInfo) {
+	if remote.HasLocal {
+		for i, b := range a.pickerState.BranchPicker.Branches {
+			if !b.IsRemote && b.TrackingRemote == remote.Name {
+				a.pickerState.BranchPicker.SelectedIdx = i
+				a.footerHint = fmt.Sprintf(ConsoleMessages["branch_already_tracked"], remote.Name, b.Name)
+				return
+			}
+		}
+	}
+
+	local, err := git.TrackRemoteBranch(remote.Name)
+	if err != nil {
+		a.footerHint = err.Error()
+		return
+	}
+	if err := a.refreshBranchPicker(local); err != nil {
+		a.footerHint = fmt.Sprintf("Failed to refresh branches: %v", err)
+		return
+	}
+	a.footerHint = fmt.Sprintf(ConsoleMessages["branch_tracking_created"], local, remote.Name)
+}
+
+// handleBranchPickerRename handles "r" key — input pre-filled with the selected local branch name
+func (a *Application) handleBranchPickerRename(app *Application) (tea.Model, tea.Cmd) {
+	sel := app.selectedPickerBranch()
+	if sel == nil || app.refuseReadOnlyBranch(sel) {
+		return app, nil
+	}
+
+	app.workflowState.PreviousMode = ModeBranchPicker
+	app.transitionTo(ModeTransition{
+		Mode:        ModeInput,
+		InputPrompt: fmt.Sprintf("Rename %s to:", sel.Name),
+		InputAction: "rename_branch",
+		FooterHint:  "Edit the branch name, press Enter to rename",
+	})
+	app.inputState.ReplaceValue(sel.Name)
+	return app, nil
+}
+
+// handleRenameBranchSubmit renames the branch selected in the picker and returns to it
+func (a *Application) handleRenameBranchSubmit(app *Application) (tea.Model, tea.Cmd) {
+	sel := app.selectedPickerBranch()
+	newName := strings.TrimSpace(app.inputState.Value)
+	if sel == nil {
+		return app.returnToMenu()
+	}
+	if newName == "" {
+		app.footerHint = ErrorMessages["branch_name_empty"]
+		return app, nil
+	}
+	if newName != sel.Name {
+		if !git.Execute("check-ref-format", "--branch", newName).Success {
+			app.footerHint = fmt.Sprintf(ErrorMessages["branch_name_invalid"], newName)
+			return app, nil
+		}
+		if git.Execute("rev-parse", "--verify", "--quiet", "refs/heads/"+newName).Success {
+			app.footerHint = fmt.Sprintf(ErrorMessages["branch_already_exists"], newName)
+			return app, nil
+		}
+		if err := git.RenameBranch(sel.Name, newName); err != nil {
+			app.footerHint = err.Error()
+			return app, nil
+		}
+	}
+	return app.returnToBranchPicker(newName, fmt.Sprintf(ConsoleMessages["branch_renamed"], sel.Name, newName))
+}
+
+// handleBranchPickerUpstream handles "u" key — unsets an existing upstream,
+// otherwise asks for one (pre-filled with origin/<name>)
+func (a *Application) handleBranchPickerUpstream(app *Application) (tea.Model, tea.Cmd) {
+	sel := app.selectedPickerBranch()
+	if sel == nil || app.refuseReadOnlyBranch(sel) {
+		return app, nil
+	}
+
+	if sel.TrackingRemote != "" {
+		name, upstream := sel.Name, sel.TrackingRemote
+		if err := git.UnsetUpstream(name); err != nil {
+			app.footerHint = err.Error()
+			return app, nil
+		}
+		if err := app.refreshBranchPicker(name); err != nil {
+			app.footerHint = fmt.Sprintf("Failed to refresh branches: %v", err)
+			return app, nil
+		}
+		app.footerHint = fmt.Sprintf(ConsoleMessages["branch_upstream_unset"], name, upstream)
+		return app, nil
+	}
+
+	app.workflowState.PreviousMode = ModeBranchPicker
+	app.transitionTo(ModeTransition{
+		Mode:        ModeInput,
+		InputPrompt: fmt.Sprintf("Upstream for %s:", sel.Name),
+		InputAction: "branch_upstream",
+		FooterHint:  "Remote branch to track (e.g. origin/main), press Enter to set",
+	})
+	app.inputState.ReplaceValue("origin/" + sel.Name)
+	return app, nil
+}
+
+// handleBranchUpstreamSubmit sets the upstream of the branch selected in the picker
+func (a *Application) handleBranchUpstreamSubmit(app *Application) (tea.Model, tea.Cmd) {
+	sel := app.selectedPickerBranch()
+	upstream := strings.TrimSpace(app.inputState.Value)
+	if sel == nil {
+		return app.returnToMenu()
+	}
+	if upstream == "" {
+		app.footerHint = ErrorMessages["branch_upstream_empty"]
+		return app, nil
+	}
+	name := sel.Name
+	if err := git.SetUpstream(name, upstream); err != nil {
+		app.footerHint = err.Error()
+		return app, nil
+	}
+	return app.returnToBranchPicker(name, fmt.Sprintf(ConsoleMessages["branch_upstream_set"], name, upstream))
+}
+
+// returnToBranchPicker reloads the picker focused on selectName and shows hint
+func (a *Application) returnToBranchPicker(selectName, hint string) (tea.Model, tea.Cmd) {
+	a.inputState.Reset()
+	a.mode = ModeBranchPicker
+	if err := a.refreshBranchPicker(selectName); err != nil {
+		a.footerHint = fmt.Sprintf("Failed to refresh branches: %v", err)
+		return a, nil
+	}
+	a.footerHint = hint
+	return a, nil
+}
+
+// BranchPruneMsg carries the local branches that are merged into canon or whose upstream is gone,
+// computed after `git fetch --prune` dropped deleted remote branches
+type BranchPruneMsg struct {
+	Branches []string
+	Canon    string
+	Err      error
+}
+
+// handleBranchPickerPrune handles "X" key — prunes remote branches, then offers to delete
+// every merged or gone local branch in one confirmation
+func (a *Application) handleBranchPickerPrune(app *Application) (tea.Model, tea.Cmd) {
+	hasRemote := app.gitState != nil && app.gitState.Remote == git.HasRemote
+	if hasRemote {
+		app.footerHint = ConsoleMessages["branch_prune_fetching"]
+	}
+	return app, func() tea.Msg {
+		if hasRemote {
+			if result := git.Execute("fetch", "--prune", "--quiet", "origin"); !result.Success {
+				return BranchPruneMsg{Err: fmt.Errorf("fetch --prune: %s", strings.TrimSpace(result.Stderr))}
+			}
+		}
+		locals, err := git.ListBranchesWithDetails()
+		if err != nil {
+			return BranchPruneMsg{Err: err}
+		}
+		canon := git.CanonBranch()
+		git.MarkMerged(locals, canon)
+		return BranchPruneMsg{Branches: git.PruneCandidates(locals, canon), Canon: canon}
+	}
+}
+
+// handleBranchPrune refreshes the picker and asks to delete the prune candidates
+func (a *Application) handleBranchPrune(msg BranchPruneMsg) (tea.Model, tea.Cmd) {
+	if a.mode != ModeBranchPicker || a.pickerState.BranchPicker == nil {
+		return a, nil // User left the picker while fetching
+	}
+	if msg.Err != nil {
+		a.footerHint = msg.Err.Error()
+		return a, nil
+	}
+	if err := a.refreshBranchPicker(""); err != nil {
+		a.footerHint = fmt.Sprintf("Failed to refresh branches: %v", err)
+		return a, nil
+	}
+	if len(msg.Branches) == 0 {
+		a.footerHint = ConsoleMessages["branch_prune_none"]
+		return a, nil
+	}
+
+	var list []string
+	for _, name := range msg.Branches {
+		list = append(list, "  "+name)
+	}
+	canon := msg.Canon
+	if canon == "" {
+		canon = "canon"
+	}
+	confirm := ConfirmationMessages[string(ConfirmBranchPrune)]
+	a.workflowState.PreviousMode = ModeBranchPicker
+	a.mode = ModeConfirmation
+	dialog := ui.NewConfirmationDialog(
+		ui.ConfirmationConfig{
+			Title:       fmt.Sprintf(confirm.Title, len(msg.Branches)),
+			Explanation: fmt.Sprintf(confirm.Explanation, strings.Join(list, "\n"), canon),
+			YesLabel:    confirm.YesLabel,
+			NoLabel:     confirm.NoLabel,
+			ActionID:    string(ConfirmBranchPrune),
+		},
+		a.sizing.ContentInnerWidth,
+		&a.theme,
+	)
+	a.dialogState.Show(dialog, map[string]string{"branches": strings.Join(msg.Branches, "\n")})
+	dialog.SelectNo()
+	return a, nil
+}
+
+// executeConfirmBranchPrune deletes the listed branches and returns to the picker
+func (a *Application) executeConfirmBranchPrune() (tea.Model, tea.Cmd) {
+	branches := strings.Split(a.dialogState.context["branches"], "\n")
+	a.dialogState.Hide()
+	if err := git.DeleteBranches(branches); err != nil {
+		a.mode = ModeBranchPicker
+		a.footerHint = err.Error()
+		a.refreshBranchPicker("")
+		return a, nil
+	}
+	return a.returnToBranchPicker("", fmt.Sprintf(ConsoleMessages["branch_prune_done"], len(branches)))
+}
+
+// executeRejectBranchPrune returns to the picker without deleting anything
+func (a *Application) executeRejectBranchPrune() (tea.Model, tea.Cmd) {
+	a.dialogState.Hide()
+	a.mode = ModeBranchPicker
+	return a, nil
+}
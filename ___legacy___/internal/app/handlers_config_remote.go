@@ -22,7 +22,7 @@ func (a *Application) handleConfigAddRemoteURLSubmit(app *Application) (tea.Mode
 	}
 
 	if !ui.ValidateRemoteURL(url) {
-		app.footerHint = ui.GetRemoteURLError()
+		app.footerHint = ui.GetRemoteURLError(url)
 		return app, nil
 	}
 
@@ -51,7 +51,7 @@ func (a *Application) handleConfigSwitchRemoteURLSubmit(app *Application) (tea.M
 	}
 
 	if !ui.ValidateRemoteURL(url) {
-		app.footerHint = ui.GetRemoteURLError()
+		app.footerHint = ui.GetRemoteURLError(url)
 		return app, nil
 	}
 
@@ -76,6 +76,14 @@ func (a *Application) cmdConfigAddRemote(url string) tea.Cmd {
 	ctx, cancel := context.WithCancel(context.Background())
 	a.cancelContext = cancel
 	return func() tea.Msg {
+		if failure := testRemoteConnection(ctx, url); failure != "" {
+			return GitOperationMsg{
+				Step:    "config_add_remote",
+				Success: false,
+				Error:   failure,
+			}
+		}
+
 		result := git.ExecuteWithStreaming(ctx, "remote", "add", "origin", url)
 		if !result.Success {
 			return GitOperationMsg{
@@ -98,6 +106,14 @@ func (a *Application) cmdConfigSwitchRemote(url string) tea.Cmd {
 	ctx, cancel := context.WithCancel(context.Background())
 	a.cancelContext = cancel
 	return func() tea.Msg {
+		if failure := testRemoteConnection(ctx, url); failure != "" {
+			return GitOperationMsg{
+				Step:    "config_switch_remote",
+				Success: false,
+				Error:   failure,
+			}
+		}
+
 		result := git.ExecuteWithStreaming(ctx, "remote", "set-url", "origin", url)
 		if !result.Success {
 			return GitOperationMsg{
@@ -47,27 +47,17 @@ func (a *Application) setupConflictResolver(operation string, columnLabels []str
 
 	if len(conflictFiles) > 0 {
 		// Check which stages exist for first file (all files should have same stage structure)
+		// Stages are read from the index without loading their contents
 		testFile := conflictFiles[0]
-
-		// Try stage 1 (BASE)
-		if _, err := git.ShowConflictVersion(testFile, 1); err == nil {
-			stagesPresent = append(stagesPresent, 1)
-		} else {
-			stagesDeleted = append(stagesDeleted, 1)
-		}
-
-		// Try stage 2 (LOCAL)
-		if _, err := git.ShowConflictVersion(testFile, 2); err == nil {
-			stagesPresent = append(stagesPresent, 2)
-		} else {
-			stagesDeleted = append(stagesDeleted, 2)
-		}
-
-		// Try stage 3 (REMOTE)
-		if _, err := git.ShowConflictVersion(testFile, 3); err == nil {
-			stagesPresent = append(stagesPresent, 3)
-		} else {
-			stagesDeleted = append(stagesDeleted, 3)
+		testStages, _ := git.ConflictStageBlobs(testFile)
+
+		// Stage 1 (BASE), 2 (LOCAL), 3 (REMOTE)
+		for i, blob := range testStages {
+			if blob.Exists() {
+				stagesPresent = append(stagesPresent, i+1)
+			} else {
+				stagesDeleted = append(stagesDeleted, i+1)
+			}
 		}
 
 		// Build active labels for all stages (present and deleted)
@@ -103,15 +93,30 @@ func (a *Application) setupConflictResolver(operation string, columnLabels []str
 		ScrollOffsets:     make([]int, numColumns),
 		LineCursors:       make([]int, numColumns),
 		Operation:         operation,
+		SplitDiff:         a.splitDiff(),
+		SyntaxHighlight:   a.syntaxHighlight(),
 	}
 
 	for _, filePath := range conflictFiles {
 		var versions []string
 
+		// Binary files show a summary per stage; their contents are never loaded
+		stages, err := git.ConflictStageBlobs(filePath)
+		binary := err == nil && git.IsBinaryConflict(filePath, stages)
+
 		// Read all 3 stages, showing placeholder for deleted stages
 		for stage := 1; stage <= 3; stage++ {
-			content, err := git.ShowConflictVersion(filePath, stage)
-			if err != nil {
+			var content string
+			exists := true
+			if binary {
+				exists = stages[stage-1].Exists()
+				content = ui.BinaryVersionContent(binarySide(filePath, stages[stage-1]))
+			} else {
+				var err error
+				content, err = git.ShowConflictVersion(filePath, stage)
+				exists = err == nil
+			}
+			if !exists {
 				// Stage doesn't exist (file was deleted in this version)
 				content = fmt.Sprintf("[FILE DELETED IN THIS VERSION]\n\nThis file was deleted in %s.\nThe conflict occurred because the other side modified it.",
 					map[int]string{1: "BASE", 2: "LOCAL", 3: "REMOTE"}[stage])
@@ -124,6 +129,7 @@ func (a *Application) setupConflictResolver(operation string, columnLabels []str
 			Path:     filePath,
 			Versions: versions,
 			Chosen:   -1, // Not yet marked
+			Binary:   binary,
 		}
 		resolveState.Files = append(resolveState.Files, conflictFile)
 	}
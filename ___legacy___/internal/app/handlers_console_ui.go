@@ -1,7 +1,7 @@
 package app
 
 import (
-	"os/exec"
+	"github.com/jrengmusic/tit/internal/git"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -62,10 +62,9 @@ func (a *Application) handleConsolePageDown(app *Application) (tea.Model, tea.Cm
 // Called on startup when HasRemote is detected to ensure timeline accuracy
 func cmdFetchRemote() tea.Cmd {
 	return func() tea.Msg {
-		cmd := exec.Command("git", "fetch", "--quiet")
-		err := cmd.Run()
-		if err != nil {
-			return RemoteFetchMsg{Success: false, Error: err.Error()}
+		result := git.Execute("fetch", "--quiet")
+		if !result.Success {
+			return RemoteFetchMsg{Success: false, Error: result.Stderr}
 		}
 		return RemoteFetchMsg{Success: true}
 	}
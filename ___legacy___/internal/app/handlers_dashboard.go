@@ -0,0 +1,253 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/jrengmusic/tit/internal/git"
+	"github.com/jrengmusic/tit/internal/ui"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// DashboardStatesMsg carries the states re-detected for every dashboard repository
+type DashboardStatesMsg struct {
+	States []*git.State
+}
+
+// DashboardBatchStatesMsg carries the states detected before a batch, to pick the repositories it runs in
+type DashboardBatchStatesMsg struct {
+	Op     string // "fetch" or "pull"
+	States []*git.State
+}
+
+// DashboardBatchMsg carries the outcome of a batch fetch or pull and the states detected after it.
+// Repos and Results are parallel and cover only the repositories the batch ran in.
+type DashboardBatchMsg struct {
+	Op      string // "fetch" or "pull"
+	Repos   []string
+	Results []git.CommandResult
+	States  []*git.State
+}
+
+// dashboardBatch describes a batch operation: which repositories it runs in, the
+// per-row result and summary names, and the ConsoleMessages status shown when none qualifies
+type dashboardBatch struct {
+	eligible      func(*git.State) bool
+	done, summary string
+	emptyKey      string
+}
+
+// dashboardBatches are the batch operations by op
+var dashboardBatches = map[string]dashboardBatch{
+	// Fetch origin in every repository that has a remote
+	"fetch": {
+		eligible: func(state *git.State) bool {
+			return state.Remote == git.HasRemote && state.Operation != git.DirtyOperation
+		},
+		done: "✓ fetched", summary: "Fetch", emptyKey: "dashboard_no_remote",
+	},
+	// Fast-forward every clean repository that is behind its remote
+	"pull": {
+		eligible: func(state *git.State) bool {
+			return state.Operation == git.Normal && state.WorkingTree == git.Clean && state.Timeline == git.Behind
+		},
+		done: "✓ fast-forwarded", summary: "Pull", emptyKey: "dashboard_nothing_to_pull",
+	},
+}
+
+// openDashboard returns to the dashboard from a repository and re-detects every row
+func (a *Application) openDashboard() (tea.Model, tea.Cmd) {
+	a.mode = ModeDashboard
+	a.footerHint = ""
+	return a, a.cmdDashboardRefresh()
+}
+
+// cmdDashboardRefresh re-detects all repositories concurrently
+func (a *Application) cmdDashboardRefresh() tea.Cmd {
+	a.dashboard.Busy = true
+	repos := a.dashboard.Repos
+	return func() tea.Msg {
+		return DashboardStatesMsg{States: git.DetectStates(repos)}
+	}
+}
+
+// handleDashboardStates applies re-detected states to the rows
+func (a *Application) handleDashboardStates(msg DashboardStatesMsg) (tea.Model, tea.Cmd) {
+	a.dashboard.Busy = false
+	a.setDashboardStates(msg.States)
+	a.dashboard.Status = fmt.Sprintf(ConsoleMessages["dashboard_summary"], len(msg.States))
+	return a, nil
+}
+
+// setDashboardStates rebuilds the rows from states, keeping each row's last batch result
+func (a *Application) setDashboardStates(states []*git.State) {
+	rows := make([]ui.DashboardRow, len(a.dashboard.Repos))
+	for i, repo := range a.dashboard.Repos {
+		if i < len(a.dashboard.Rows) {
+			rows[i].Result = a.dashboard.Rows[i].Result
+		}
+		a.fillDashboardRow(&rows[i], repo, states[i])
+	}
+	a.dashboard.Rows = rows
+}
+
+// fillDashboardRow formats one repository's branch and 5-axis state with the header's labels and colors
+func (a *Application) fillDashboardRow(row *ui.DashboardRow, repo string, state *git.State) {
+	row.Name = ui.DashboardCell{Text: filepath.Base(repo), Color: a.theme.ContentTextColor}
+	op := a.operationInfo[state.Operation]
+	row.Operation = ui.DashboardCell{Text: op.Label, Color: op.Color}
+	if state.Operation == git.NotRepo || state.Operation == git.DirtyOperation {
+		return // No further axes are detected in these states
+	}
+
+	branch := state.CurrentBranch
+	if state.Detached && !state.IsTitTimeTravel {
+		branch = "DETACHED @ " + state.CurrentHash
+	}
+	row.Branch = ui.DashboardCell{Text: branch, Color: a.theme.AccentTextColor}
+
+	wt := a.workingTreeInfo[state.WorkingTree]
+	wtLabel := wt.Label
+	if state.WorkingTree == git.Dirty && state.ModifiedCount > 0 {
+		wtLabel += fmt.Sprintf(" ● %d", state.ModifiedCount)
+	}
+	row.WorkingTree = ui.DashboardCell{Text: wtLabel, Color: wt.Color}
+
+	switch {
+	case state.Remote == git.NoRemote:
+		row.Timeline = ui.DashboardCell{Text: "No remote", Color: a.theme.DimmedTextColor}
+	case state.Timeline == "":
+		row.Timeline = ui.DashboardCell{Text: "N/A", Color: a.theme.DimmedTextColor}
+	default:
+		row.Timeline = ui.DashboardCell{Text: a.timelineLabel(state), Color: a.timelineInfo[state.Timeline].Color}
+	}
+}
+
+// handleDashboardUp moves the row cursor up
+func (a *Application) handleDashboardUp(app *Application) (tea.Model, tea.Cmd) {
+	if a.dashboard.SelectedIdx > 0 {
+		a.dashboard.SelectedIdx--
+	}
+	return a, nil
+}
+
+// handleDashboardDown moves the row cursor down
+func (a *Application) handleDashboardDown(app *Application) (tea.Model, tea.Cmd) {
+	if a.dashboard.SelectedIdx < len(a.dashboard.Rows)-1 {
+		a.dashboard.SelectedIdx++
+	}
+	return a, nil
+}
+
+// handleDashboardRefresh re-detects every row (R)
+func (a *Application) handleDashboardRefresh(app *Application) (tea.Model, tea.Cmd) {
+	if a.dashboard.Busy {
+		return a, nil
+	}
+	return a, a.cmdDashboardRefresh()
+}
+
+// handleDashboardOpen switches TIT into the selected repository (Enter).
+// The new Application keeps the dashboard so ESC from its menu comes back.
+func (a *Application) handleDashboardOpen(app *Application) (tea.Model, tea.Cmd) {
+	if a.dashboard.Busy || a.dashboard.SelectedIdx >= len(a.dashboard.Repos) {
+		return a, nil
+	}
+	repo := a.dashboard.Repos[a.dashboard.SelectedIdx]
+	next, err := a.switchRepository(repo)
+	if err != nil {
+		a.dashboard.Status = fmt.Sprintf(ErrorMessages["dashboard_open_failed"], filepath.Base(repo), err)
+		return a, nil
+	}
+	return next, next.Init()
+}
+
+// handleDashboardFetch fetches origin in every repository that has a remote (F)
+func (a *Application) handleDashboardFetch(app *Application) (tea.Model, tea.Cmd) {
+	return a.startDashboardBatch("fetch")
+}
+
+// handleDashboardPull fast-forwards every clean repository that is behind its remote (P)
+func (a *Application) handleDashboardPull(app *Application) (tea.Model, tea.Cmd) {
+	return a.startDashboardBatch("pull")
+}
+
+// startDashboardBatch re-detects every repository off the UI thread; the batch
+// runs in the ones whose fresh state qualifies (handleDashboardBatchStates)
+func (a *Application) startDashboardBatch(op string) (tea.Model, tea.Cmd) {
+	if a.dashboard.Busy {
+		return a, nil
+	}
+
+	// Decide on fresh states: a row may be minutes old
+	a.dashboard.Busy = true
+	repos := a.dashboard.Repos
+	return a, func() tea.Msg {
+		return DashboardBatchStatesMsg{Op: op, States: git.DetectStates(repos)}
+	}
+}
+
+// handleDashboardBatchStates applies the fresh states and runs the batch in the eligible
+// repositories, or shows the batch's empty status when none is
+func (a *Application) handleDashboardBatchStates(msg DashboardBatchStatesMsg) (tea.Model, tea.Cmd) {
+	batch := dashboardBatches[msg.Op]
+	all := a.dashboard.Repos
+	a.setDashboardStates(msg.States)
+	var repos []string
+	for i, state := range msg.States {
+		if batch.eligible(state) {
+			repos = append(repos, all[i])
+		}
+	}
+	if len(repos) == 0 {
+		a.dashboard.Busy = false
+		a.dashboard.Status = ConsoleMessages[batch.emptyKey]
+		return a, nil
+	}
+
+	op := msg.Op
+	a.dashboard.Status = fmt.Sprintf(ConsoleMessages["dashboard_"+op+"_running"], len(repos))
+	return a, func() tea.Msg {
+		var results []git.CommandResult
+		switch op {
+		case "fetch":
+			results = git.FetchRepos(context.Background(), repos)
+		case "pull":
+			results = git.FastForwardRepos(context.Background(), repos)
+		}
+		return DashboardBatchMsg{Op: op, Repos: repos, Results: results, States: git.DetectStates(all)}
+	}
+}
+
+// handleDashboardBatch records each repository's result and summarizes the batch
+func (a *Application) handleDashboardBatch(msg DashboardBatchMsg) (tea.Model, tea.Cmd) {
+	batch := dashboardBatches[msg.Op]
+	index := make(map[string]int, len(a.dashboard.Repos))
+	for i, repo := range a.dashboard.Repos {
+		index[repo] = i
+	}
+
+	failed := 0
+	for i, repo := range msg.Repos {
+		row := &a.dashboard.Rows[index[repo]]
+		result := msg.Results[i]
+		if result.Success {
+			row.Result = ui.DashboardCell{Text: batch.done, Color: a.theme.OutputStdoutColor}
+			continue
+		}
+		failed++
+		reason := strings.TrimSpace(result.Stderr)
+		if line, _, found := strings.Cut(reason, "\n"); found {
+			reason = line
+		}
+		row.Result = ui.DashboardCell{Text: "✗ " + reason, Color: a.theme.OutputStderrColor}
+	}
+
+	a.dashboard.Busy = false
+	a.setDashboardStates(msg.States)
+	a.dashboard.Status = fmt.Sprintf(ConsoleMessages["dashboard_batch_done"], batch.summary, len(msg.Repos)-failed, failed)
+	return a, nil
+}
@@ -0,0 +1,63 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/jrengmusic/tit/internal/config"
+	"github.com/jrengmusic/tit/internal/ui"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ========================================
+// Diff Display (unified / split layout, syntax colors)
+// ========================================
+
+// splitDiff reports whether diff panes start in the side-by-side layout (config appearance.diff_layout)
+func (a *Application) splitDiff() bool {
+	return a.appConfig != nil && a.appConfig.Appearance.DiffLayout == config.DiffLayoutSplit
+}
+
+// syntaxHighlight reports whether diff and conflict panes get syntax colors (config appearance.syntax_highlighting)
+func (a *Application) syntaxHighlight() bool {
+	return a.appConfig != nil && a.appConfig.Appearance.SyntaxHighlighting
+}
+
+// handleDiffLayoutToggle switches file history, compare and conflict resolver diff panes
+// between unified and split, persists the choice, and resets diff cursors
+// (rows differ between layouts)
+func (a *Application) handleDiffLayoutToggle(app *Application) (tea.Model, tea.Cmd) {
+	split := !a.splitDiff()
+	layout, hintKey := config.DiffLayoutUnified, "diff_layout_unified"
+	if split {
+		layout, hintKey = config.DiffLayoutSplit, "diff_layout_split"
+	}
+	app.footerHint = ConsoleMessages[hintKey]
+	if a.appConfig != nil {
+		if err := a.appConfig.SetDiffLayout(layout); err != nil {
+			app.footerHint = fmt.Sprintf(ErrorMessages["diff_layout_save_failed"], err)
+		}
+	}
+
+	if state := app.pickerState.FileHistory; state != nil {
+		state.SplitDiff = split
+		state.DiffLineCursor, state.DiffScrollOff, state.VisualModeActive = 0, 0, false
+	}
+	if state := app.pickerState.Compare; state != nil {
+		state.SplitDiff = split
+		state.DiffLineCursor, state.DiffScrollOff, state.VisualModeActive = 0, 0, false
+	}
+	if state := app.conflictResolveState; state != nil {
+		// One row per line in both layouts: cursors stay
+		state.SplitDiff = split
+	}
+	return app, nil
+}
+
+// selectedDiffLines returns the diff lines between two cursor rows of the given layout
+func selectedDiffLines(diffContent string, split bool, start, end int) []string {
+	if split {
+		return ui.GetSelectedLinesFromSplitDiff(diffContent, start, end)
+	}
+	return ui.GetSelectedLinesFromDiff(diffContent, start, end)
+}
@@ -10,7 +10,12 @@ import (
 )
 
 // handleGitOperationFailure handles the !msg.Success path: logs error, cleans up dirty state, reloads git state.
+// Remote operations that failed on authentication show a remediation dialog over the console.
 func (a *Application) handleGitOperationFailure(msg GitOperationMsg, buffer *ui.OutputBuffer) (tea.Model, tea.Cmd) {
+	problem, line := git.AuthNone, ""
+	if authDiagnosedSteps[msg.Step] {
+		problem, line = consoleAuthProblem(msg.Error)
+	}
 	buffer.Append(msg.Error, ui.TypeStderr)
 	buffer.Append(GetFooterMessageText(MessageOperationFailed), ui.TypeInfo)
 	a.footerHint = GetFooterMessageText(MessageOperationFailed)
@@ -29,6 +34,9 @@ func (a *Application) handleGitOperationFailure(msg GitOperationMsg, buffer *ui.
 		// State reload failed, but proceed with cleanup
 	}
 
+	if problem != git.AuthNone {
+		a.showAuthRemediation(problem, line, msg.Step)
+	}
 	return a, nil
 }
 
@@ -33,6 +33,7 @@ func (a *Application) startCloneOperation() (tea.Model, tea.Cmd) {
 func (a *Application) cmdCloneWorkflow() tea.Cmd {
 	cloneURL := a.workflowState.CloneURL
 	cloneMode := a.workflowState.CloneMode
+	opts := a.workflowState.CloneOptions
 
 	cwd, _ := os.Getwd()
 
@@ -58,24 +59,45 @@ func (a *Application) cmdCloneWorkflow() tea.Cmd {
 			}
 
 			// Step 3: Query remote default branch BEFORE fetch (using git ls-remote)
-			buffer.Append("Querying remote default branch...", ui.TypeStatus)
-			defaultBranch, err := git.GetRemoteDefaultBranch()
-			if err != nil {
-				return GitOperationMsg{
-					Step:    OpClone,
-					Success: false,
-					Error:   fmt.Sprintf("Failed to determine default branch: %v", err),
-					Path:    effectivePath,
+			// A branch chosen in the clone options replaces the remote default
+			defaultBranch := opts.Branch
+			if defaultBranch == "" {
+				buffer.Append("Querying remote default branch...", ui.TypeStatus)
+				branch, err := git.GetRemoteDefaultBranch()
+				if err != nil {
+					return GitOperationMsg{
+						Step:    OpClone,
+						Success: false,
+						Error:   fmt.Sprintf("Failed to determine default branch: %v", err),
+						Path:    effectivePath,
+					}
+				}
+				defaultBranch = branch
+				buffer.Append(fmt.Sprintf("Remote default branch: %s", defaultBranch), ui.TypeStatus)
+			}
+
+			// Single branch: narrow origin's refspec before the first fetch
+			if opts.SingleBranch {
+				result = git.ExecuteWithStreaming(ctx, "remote", "set-branches", "origin", defaultBranch)
+				if !result.Success {
+					return GitOperationMsg{Step: OpClone, Success: false, Error: "git remote set-branches failed", Path: effectivePath}
 				}
 			}
-			buffer.Append(fmt.Sprintf("Remote default branch: %s", defaultBranch), ui.TypeStatus)
 
-			// Step 4: Fetch all refs
-			result = git.ExecuteWithStreaming(ctx, "fetch", "--all", "--progress")
+			// Step 4: Fetch refs (depth and blob filter from clone options)
+			result = git.ExecuteWithStreaming(ctx, opts.FetchArgs()...)
 			if !result.Success {
 				return GitOperationMsg{Step: OpClone, Success: false, Error: "git fetch failed", Path: effectivePath}
 			}
 
+			// Sparse checkout is configured before checkout so excluded paths are never written
+			if sparseArgs := opts.SparseCheckoutArgs(); sparseArgs != nil {
+				result = git.ExecuteWithStreaming(ctx, sparseArgs...)
+				if !result.Success {
+					return GitOperationMsg{Step: OpClone, Success: false, Error: "git sparse-checkout set failed", Path: effectivePath}
+				}
+			}
+
 			// Step 5: Create and checkout local branch tracking remote
 			// This sets up upstream automatically: -t = --track (sets upstream to origin/<branch>)
 			result = git.ExecuteWithStreaming(ctx, "checkout", "-t", "origin/"+defaultBranch)
@@ -90,7 +112,7 @@ func (a *Application) cmdCloneWorkflow() tea.Cmd {
 		} else {
 			// Clone to subdir: git clone creates subdir with repo name automatically
 			// Don't specify a path - git will create it from the repo name
-			result := git.ExecuteWithStreaming(ctx, "clone", "--progress", cloneURL)
+			result := git.ExecuteWithStreaming(ctx, opts.CloneArgs(cloneURL)...)
 			if !result.Success {
 				return GitOperationMsg{
 					Step:    OpClone,
@@ -112,6 +134,14 @@ func (a *Application) cmdCloneWorkflow() tea.Cmd {
 				}
 			}
 			effectivePath = newPath
+
+			// Sparse clone starts with root files only; widen the cone to the chosen directories
+			if sparseArgs := opts.SparseCheckoutArgs(); sparseArgs != nil {
+				result = git.ExecuteWithStreaming(ctx, sparseArgs...)
+				if !result.Success {
+					return GitOperationMsg{Step: OpClone, Success: false, Error: "git sparse-checkout set failed", Path: effectivePath}
+				}
+			}
 		}
 
 		return GitOperationMsg{
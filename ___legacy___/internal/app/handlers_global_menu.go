@@ -21,6 +21,10 @@ func (a *Application) handleKeyESC(app *Application) (tea.Model, tea.Cmd) {
 		return a.handleConflictEsc(app)
 	}
 
+	if a.mode == ModeCompare {
+		return a.handleCompareEsc()
+	}
+
 	if (a.mode == ModeConsole || a.mode == ModeClone) && a.IsAsyncActive() {
 		return a.handleEscAsyncAbort()
 	}
@@ -34,6 +38,13 @@ func (a *Application) handleKeyESC(app *Application) (tea.Model, tea.Cmd) {
 	}
 
 	if a.mode == ModeMenu {
+		if a.dashboard != nil {
+			return a.openDashboard()
+		}
+		return a, nil
+	}
+
+	if a.mode == ModeDashboard {
 		return a, nil
 	}
 
@@ -47,13 +47,7 @@ func (a *Application) handleHistoryDown(app *Application) (tea.Model, tea.Cmd) {
 	} else { // Details pane focused - move line cursor
 		// Get total lines in selected commit's details
 		if app.pickerState.History.SelectedIdx >= 0 && app.pickerState.History.SelectedIdx < len(app.pickerState.History.Commits) {
-			commit := app.pickerState.History.Commits[app.pickerState.History.SelectedIdx]
-
-			// Build details lines (must match renderHistoryDetailsPane logic)
-			var totalLines int
-			totalLines += 2                                       // "Author:" and "Date:" lines
-			totalLines += 1                                       // Empty line separator
-			totalLines += strings.Count(commit.Subject, "\n") + 1 // Commit subject lines
+			totalLines := ui.HistoryDetailsLineCount(app.pickerState.History)
 
 			// Only increment if not at the last line
 			if app.pickerState.History.DetailsLineCursor < totalLines-1 {
@@ -87,20 +81,24 @@ func (a *Application) handleHistoryEnter(app *Application) (tea.Model, tea.Cmd)
 
 	// Get selected commit
 	commit := app.pickerState.History.Commits[app.pickerState.History.SelectedIdx]
+	app.showTimeTravelConfirmation(commit.Hash, commit.Subject)
+
+	return app, nil
+}
 
-	// Show time travel confirmation dialog with context
-	app.mode = ModeConfirmation
+// showTimeTravelConfirmation asks to time travel to hash (history Enter, bookmark quick travel)
+func (a *Application) showTimeTravelConfirmation(hash, subject string) {
+	a.mode = ModeConfirmation
 	dialogContext := map[string]string{
-		"commit_hash":    commit.Hash,
-		"commit_subject": commit.Subject,
+		"commit_hash":    hash,
+		"commit_subject": subject,
 	}
 
 	// Create confirmation dialog using SSOT
 	// Format: hash (first 7 chars) on first line, subject on second line
-	shortHash := git.ShortenHash(commit.Hash)
+	shortHash := git.ShortenHash(hash)
 
 	// Extract only first line of commit message (subject)
-	subject := commit.Subject
 	if idx := strings.Index(subject, "\n"); idx >= 0 {
 		subject = subject[:idx]
 	}
@@ -113,8 +111,6 @@ func (a *Application) handleHistoryEnter(app *Application) (tea.Model, tea.Cmd)
 		NoLabel:     msg.NoLabel,
 		ActionID:    "time_travel",
 	}
-	dialog := ui.NewConfirmationDialog(config, app.sizing.ContentInnerWidth, &app.theme)
-	app.dialogState.Show(dialog, dialogContext)
-
-	return app, nil
+	dialog := ui.NewConfirmationDialog(config, a.sizing.ContentInnerWidth, &a.theme)
+	a.dialogState.Show(dialog, dialogContext)
 }
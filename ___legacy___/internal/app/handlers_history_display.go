@@ -155,12 +155,12 @@ func (a *Application) handleFileHistoryCopy(app *Application) (tea.Model, tea.Cm
 	var linesToCopy []string
 	if app.pickerState.FileHistory.VisualModeActive {
 		// Visual mode: copy selected range
-		linesToCopy = ui.GetSelectedLinesFromDiff(app.pickerState.FileHistory.DiffContent, app.pickerState.FileHistory.VisualModeStart, app.pickerState.FileHistory.DiffLineCursor)
+		linesToCopy = selectedDiffLines(app.pickerState.FileHistory.DiffContent, app.pickerState.FileHistory.SplitDiff, app.pickerState.FileHistory.VisualModeStart, app.pickerState.FileHistory.DiffLineCursor)
 		// Exit visual mode after copy
 		app.pickerState.FileHistory.VisualModeActive = false
 	} else {
 		// Normal mode: copy current line
-		linesToCopy = ui.GetSelectedLinesFromDiff(app.pickerState.FileHistory.DiffContent, app.pickerState.FileHistory.DiffLineCursor, app.pickerState.FileHistory.DiffLineCursor)
+		linesToCopy = selectedDiffLines(app.pickerState.FileHistory.DiffContent, app.pickerState.FileHistory.SplitDiff, app.pickerState.FileHistory.DiffLineCursor, app.pickerState.FileHistory.DiffLineCursor)
 	}
 
 	// Copy to clipboard if we have lines
@@ -0,0 +1,56 @@
+package app
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/jrengmusic/tit/internal/git"
+)
+
+// applyIdentityProfile writes the matching identity profile (config.toml [[identity]])
+// to the repository's own config and records the identity shown in the header.
+// Called when a repository is opened, cloned, initialized or gets a remote.
+func (a *Application) applyIdentityProfile() {
+	env := &a.environmentState
+	env.IdentityProfile = ""
+	if a.gitState == nil || a.gitState.Operation == git.NotRepo {
+		env.Identity = git.CurrentIdentity()
+		return
+	}
+
+	if a.appConfig != nil {
+		cwd, _ := os.Getwd() // Empty cwd only disables path rules
+		host := ""
+		if parsed, err := git.ParseRemoteURL(git.GetRemoteURL()); err == nil {
+			host = parsed.Host
+		}
+		if profile := a.appConfig.MatchIdentity(cwd, host); profile != nil {
+			want := git.Identity{Name: profile.UserName, Email: profile.UserEmail}
+			if git.LocalIdentity() != want {
+				if err := git.SetIdentity(want, true); err != nil {
+					git.Warn(fmt.Sprintf(ErrorMessages["identity_apply_failed"], profile.Name, err))
+				} else {
+					git.Log(fmt.Sprintf(ConsoleMessages["identity_applied"], profile.Name, want.Email))
+				}
+			}
+			env.IdentityProfile = profile.Name
+		}
+	}
+	env.Identity = git.CurrentIdentity()
+}
+
+// identityLabel formats the header's identity: "👤 profile · email", email alone without a profile
+func (a *Application) identityLabel() string {
+	env := a.environmentState
+	email := env.Identity.Email
+	if email == "" {
+		email = env.Identity.Name
+	}
+	if email == "" {
+		return ""
+	}
+	if env.IdentityProfile != "" {
+		return "👤 " + env.IdentityProfile + " · " + email
+	}
+	return "👤 " + email
+}
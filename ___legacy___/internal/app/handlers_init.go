@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/jrengmusic/tit/internal/git"
 	"github.com/jrengmusic/tit/internal/ui"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -29,6 +30,16 @@ func (a *Application) handleInitCloneCheckout(msg GitOperationMsg) (tea.Model, t
 		a.EndAsyncOp()
 		return a, nil
 	}
+	a.applyIdentityProfile()
+	a.loadPolicy()
+	a.loadRepoCommands()
+
+	// A fresh clone's checked-out branch is the project's canon until told otherwise
+	if msg.Step == OpClone && a.gitState.CurrentBranch != "" && git.LoadWorkflow().Canon == "" {
+		if err := git.SaveWorkflow(git.BranchWorkflow{Canon: a.gitState.CurrentBranch}); err != nil {
+			git.Warn(fmt.Sprintf(ErrorMessages["workflow_save_failed"], err))
+		}
+	}
 
 	buffer.Append(GetFooterMessageText(MessageOperationComplete), ui.TypeInfo)
 	a.footerHint = GetFooterMessageText(MessageOperationComplete)
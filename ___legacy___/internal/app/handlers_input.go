@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"github.com/jrengmusic/tit/internal"
 
@@ -102,13 +103,37 @@ func (a *Application) handleInputSubmitSubdirName(app *Application) (tea.Model,
 	})
 }
 
-// handleInitBranchNameSubmit validates branch name and starts init operation
+// handleInitBranchNameSubmit records the initial (canon) branch and asks for the working branch
 func (a *Application) handleInitBranchNameSubmit() (tea.Model, tea.Cmd) {
 	branchName := strings.TrimSpace(a.inputState.Value)
 	if branchName == "" {
 		a.footerHint = ErrorMessages["branch_name_empty"]
 		return a, nil
 	}
+	a.workflowState.WorkflowCanon = branchName
+
+	a.transitionTo(ModeTransition{
+		Mode:        ModeInput,
+		InputPrompt: fmt.Sprintf(InputMessages["init_working_branch"].Prompt, branchName),
+		InputAction: "init_working_branch",
+		FooterHint:  InputMessages["init_working_branch"].Hint,
+	})
+	return a, nil
+}
+
+// handleInitWorkingBranchSubmit validates the optional working branch and starts init operation
+func (a *Application) handleInitWorkingBranchSubmit() (tea.Model, tea.Cmd) {
+	canon := a.workflowState.WorkflowCanon
+	working := strings.TrimSpace(a.inputState.Value)
+	if working == canon {
+		a.footerHint = ErrorMessages["workflow_same_branch"]
+		return a, nil
+	}
+	if working != "" && !git.Execute("check-ref-format", "--branch", working).Success {
+		a.footerHint = fmt.Sprintf(ErrorMessages["branch_name_invalid"], working)
+		return a, nil
+	}
+	a.workflowState.WorkflowCanon = ""
 
 	buffer := ui.GetBuffer()
 	buffer.Clear()
@@ -118,7 +143,7 @@ func (a *Application) handleInitBranchNameSubmit() (tea.Model, tea.Cmd) {
 	a.StartAsyncOp()
 	a.inputState.Value = ""
 
-	return a, a.cmdInit(branchName)
+	return a, a.cmdInit(canon, working)
 }
 
 // Clone workflow handlers
@@ -142,7 +167,7 @@ func (a *Application) handleCloneURLSubmit(app *Application) (tea.Model, tea.Cmd
 
 		// Route based on how we got here
 		if app.inputState.Action == InputActionCloneURL {
-			// CWD not empty: start clone to subdir operation
+			// CWD not empty: clone to subdir
 			cwd, err := os.Getwd()
 			if err != nil {
 				app.footerHint = ErrorMessages["cwd_read_failed"]
@@ -150,12 +175,74 @@ func (a *Application) handleCloneURLSubmit(app *Application) (tea.Model, tea.Cmd
 			}
 
 			app.workflowState.ClonePath = cwd // git clone will create subdir automatically
-			return app.startCloneOperation()
 		}
 
-		// CWD empty: either clone_here or clone_to_subdir
-		return app.startCloneOperation()
+		// Every route goes through the options step; "Start clone" launches the operation
+		app.workflowState.CloneOptions = git.CloneOptions{}
+		app.showCloneOptions()
+		return app, nil
+	})
+}
+
+// showCloneOptions (re)builds the clone options menu, keeping the selection in range
+func (a *Application) showCloneOptions() {
+	a.mode = ModeCloneOptions
+	a.workflowState.PreviousMode = ModeMenu // ESC abandons the clone
+	a.menuItems = a.menuCloneOptions()
+	if a.selectedIndex < 0 || a.selectedIndex >= len(a.menuItems) {
+		a.selectedIndex = 0
+	}
+	a.footerHint = a.menuItems[a.selectedIndex].Hint
+	a.rebuildMenuShortcuts(ModeCloneOptions)
+}
+
+// enterCloneOptionInput opens the text input for one clone option, pre-filled with its value
+func (a *Application) enterCloneOptionInput(action, value string) {
+	a.transitionTo(ModeTransition{
+		Mode:        ModeInput,
+		InputPrompt: InputMessages[action].Prompt,
+		InputAction: action,
+		FooterHint:  InputMessages[action].Hint,
 	})
+	a.inputState.ReplaceValue(value)
+}
+
+// handleCloneDepthSubmit stores the clone depth (empty or 0 = full history)
+func (a *Application) handleCloneDepthSubmit(app *Application) (tea.Model, tea.Cmd) {
+	value := strings.TrimSpace(app.inputState.Value)
+	depth := 0
+	if value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			app.footerHint = ErrorMessages["clone_depth_invalid"]
+			return app, nil
+		}
+		depth = n
+	}
+	app.workflowState.CloneOptions.Depth = depth
+	app.showCloneOptions()
+	return app, nil
+}
+
+// handleCloneBranchSubmit stores the branch to check out (empty = remote default)
+func (a *Application) handleCloneBranchSubmit(app *Application) (tea.Model, tea.Cmd) {
+	branch := strings.TrimSpace(app.inputState.Value)
+	if branch != "" {
+		if valid, msg := ui.Validators["branch_name"](branch); !valid {
+			app.footerHint = msg
+			return app, nil
+		}
+	}
+	app.workflowState.CloneOptions.Branch = branch
+	app.showCloneOptions()
+	return app, nil
+}
+
+// handleCloneSparseSubmit stores the sparse-checkout cone directories (empty = everything)
+func (a *Application) handleCloneSparseSubmit(app *Application) (tea.Model, tea.Cmd) {
+	app.workflowState.CloneOptions.SparsePatterns = strings.Fields(app.inputState.Value)
+	app.showCloneOptions()
+	return app, nil
 }
 
 // handleCloneLocationSelection handles enter on clone location menu
@@ -238,6 +325,27 @@ func (a *Application) handleCommitSubmit(app *Application) (tea.Model, tea.Cmd)
 	return app, app.cmdCommit(message)
 }
 
+// handleCommitStagedSubmit validates commit message and commits the index as-is
+func (a *Application) handleCommitStagedSubmit(app *Application) (tea.Model, tea.Cmd) {
+	// UI THREAD - Validate commit message
+	message := ui.SanitizeCommitMessage(app.inputState.Value)
+	if message == "" {
+		app.footerHint = ErrorMessages["commit_message_empty"]
+		return app, nil
+	}
+
+	// Set up async state for console display
+	app.StartAsyncOp()
+	app.workflowState.PreviousMode = ModeMenu
+	app.workflowState.PreviousMenuIndex = 0
+	app.mode = ModeConsole
+	app.consoleState.Reset()
+	app.inputState.Value = ""
+
+	// Execute commit asynchronously without staging
+	return app, app.cmdCommitStaged(message)
+}
+
 // handleCommitPushSubmit validates commit message and executes commit+push
 func (a *Application) handleCommitPushSubmit(app *Application) (tea.Model, tea.Cmd) {
 	// UI THREAD - Validate commit message
@@ -270,7 +378,7 @@ func (a *Application) handleAddRemoteSubmit(app *Application) (tea.Model, tea.Cm
 
 	// Validate URL format
 	if !ui.ValidateRemoteURL(url) {
-		app.footerHint = ui.GetRemoteURLError()
+		app.footerHint = ui.GetRemoteURLError(url)
 		return app, nil
 	}
 
@@ -0,0 +1,436 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/jrengmusic/tit/internal/git"
+	"github.com/jrengmusic/tit/internal/ui"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ========================================
+// LFS Mode Handlers (SSOT: matches branch picker navigation pattern)
+// ========================================
+
+// LFSLocksMsg carries the lock owners by path, queried from the LFS server off the UI thread
+type LFSLocksMsg struct {
+	Owners map[string]string
+	Err    error
+}
+
+// LFSLockMsg carries the result of a lock or unlock on the LFS server
+type LFSLockMsg struct {
+	Result  git.CommandResult
+	Success string // Status shown when the command succeeded
+}
+
+// handleLFSUp handles UP/K navigation in the LFS list
+func (a *Application) handleLFSUp(app *Application) (tea.Model, tea.Cmd) {
+	lfs := app.pickerState.LFS
+	if lfs != nil && lfs.SelectedIdx > 0 {
+		lfs.SelectedIdx--
+		app.updateLFSDetails()
+	}
+	return app, nil
+}
+
+// handleLFSDown handles DOWN/J navigation in the LFS list
+func (a *Application) handleLFSDown(app *Application) (tea.Model, tea.Cmd) {
+	lfs := app.pickerState.LFS
+	if lfs != nil && lfs.SelectedIdx < len(lfs.Rows)-1 {
+		lfs.SelectedIdx++
+		app.updateLFSDetails()
+	}
+	return app, nil
+}
+
+// handleLFSAdd handles "a" — opens the pattern input for `git lfs track`
+func (a *Application) handleLFSAdd(app *Application) (tea.Model, tea.Cmd) {
+	app.workflowState.PreviousMode = ModeLFS
+	app.transitionTo(ModeTransition{
+		Mode:        ModeInput,
+		InputPrompt: InputMessages["lfs_track_pattern"].Prompt,
+		InputAction: "lfs_track_pattern",
+		FooterHint:  InputMessages["lfs_track_pattern"].Hint,
+	})
+	return app, nil
+}
+
+// handleLFSTrackPatternSubmit tracks the entered pattern and returns to LFS mode
+func (a *Application) handleLFSTrackPatternSubmit(app *Application) (tea.Model, tea.Cmd) {
+	pattern := strings.TrimSpace(app.inputState.Value)
+	if pattern == "" {
+		app.footerHint = ErrorMessages["lfs_pattern_empty"]
+		return app, nil
+	}
+
+	app.inputState.Value = ""
+	app.mode = ModeLFS
+	return app, app.finishLFSAction(git.TrackLFSPattern(pattern), fmt.Sprintf("Tracking %s in LFS", pattern))
+}
+
+// handleLFSUntrack handles "x" — removes the selected pattern from .gitattributes
+func (a *Application) handleLFSUntrack(app *Application) (tea.Model, tea.Cmd) {
+	row, ok := app.pickerState.LFS.Selected()
+	if !ok || row.Kind != ui.LFSRowPattern {
+		return app, nil
+	}
+	return app, app.finishLFSAction(git.UntrackLFSPattern(row.Path), fmt.Sprintf("Stopped tracking %s", row.Path))
+}
+
+// handleLFSLock handles "l" — locks the selected file on the LFS server
+func (a *Application) handleLFSLock(app *Application) (tea.Model, tea.Cmd) {
+	row, ok := app.pickerState.LFS.Selected()
+	if !ok || row.Kind != ui.LFSRowFile || row.LockOwner != "" {
+		return app, nil
+	}
+	app.pickerState.LFS.Status = fmt.Sprintf("Locking %s…", row.Path)
+	return app, cmdLFSLock(git.LockLFSFile, row.Path, fmt.Sprintf("Locked %s", row.Path))
+}
+
+// handleLFSUnlock handles "u" — releases the lock on the selected file
+func (a *Application) handleLFSUnlock(app *Application) (tea.Model, tea.Cmd) {
+	row, ok := app.pickerState.LFS.Selected()
+	if !ok || row.Kind != ui.LFSRowFile || row.LockOwner == "" {
+		return app, nil
+	}
+	app.pickerState.LFS.Status = fmt.Sprintf("Unlocking %s…", row.Path)
+	return app, cmdLFSLock(git.UnlockLFSFile, row.Path, fmt.Sprintf("Unlocked %s", row.Path))
+}
+
+// cmdLFSLock runs a lock or unlock on the LFS server off the UI thread
+func cmdLFSLock(run func(context.Context, string) git.CommandResult, path, success string) tea.Cmd {
+	return func() tea.Msg {
+		return LFSLockMsg{Result: run(context.Background(), path), Success: success}
+	}
+}
+
+// handleLFSLockResult reports a finished lock or unlock and reloads the list
+func (a *Application) handleLFSLockResult(msg LFSLockMsg) (tea.Model, tea.Cmd) {
+	if a.mode != ModeLFS || a.pickerState.LFS == nil {
+		return a, nil // User left the LFS manager while the server answered
+	}
+	return a, a.finishLFSAction(msg.Result, msg.Success)
+}
+
+// handleLFSMigrate handles "m" — confirms, then rewrites history so the pattern's files move to LFS
+func (a *Application) handleLFSMigrate(app *Application) (tea.Model, tea.Cmd) {
+	row, ok := app.pickerState.LFS.Selected()
+	if !ok || row.Kind != ui.LFSRowPattern {
+		return app, nil
+	}
+
+	msg := ConfirmationMessages[string(ConfirmLFSMigrate)]
+	app.mode = ModeConfirmation
+	dialog := ui.NewConfirmationDialog(
+		ui.ConfirmationConfig{
+			Title:       msg.Title,
+			Explanation: fmt.Sprintf(msg.Explanation, row.Path),
+			YesLabel:    msg.YesLabel,
+			NoLabel:     msg.NoLabel,
+			ActionID:    string(ConfirmLFSMigrate),
+		},
+		app.sizing.ContentInnerWidth,
+		&app.theme,
+	)
+	app.dialogState.Show(dialog, map[string]string{"pattern": row.Path})
+	dialog.SelectNo()
+	return app, nil
+}
+
+// executeConfirmLFSMigrate handles YES response to the migrate confirmation
+func (a *Application) executeConfirmLFSMigrate() (tea.Model, tea.Cmd) {
+	pattern := a.dialogState.context["pattern"]
+	a.dialogState.Hide()
+	a.prepareAsyncOperation(GetFooterMessageText(MessageOperationInProgress))
+	return a, a.cmdLFSMigrate(pattern)
+}
+
+// executeRejectLFSMigrate handles NO response to the migrate confirmation
+func (a *Application) executeRejectLFSMigrate() (tea.Model, tea.Cmd) {
+	a.dialogState.Hide()
+	a.mode = ModeLFS
+	return a, nil
+}
+
+// cmdLFSMigrate runs `git lfs migrate import` for pattern
+func (a *Application) cmdLFSMigrate(pattern string) tea.Cmd {
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancelContext = cancel
+	return func() tea.Msg {
+		buffer := ui.GetBuffer()
+		buffer.Clear()
+
+		result := git.MigrateLFSImport(ctx, pattern)
+		if !result.Success {
+			return GitOperationMsg{
+				Step:    OpLFSMigrate,
+				Success: false,
+				Error:   fmt.Sprintf("Failed to migrate %s to LFS", pattern),
+			}
+		}
+
+		return GitOperationMsg{
+			Step:    OpLFSMigrate,
+			Success: true,
+			Output:  fmt.Sprintf("Migrated %s to LFS. Branch history was rewritten: force push to publish it.", pattern),
+		}
+	}
+}
+
+// handleLFSMigrateResult handles OpLFSMigrate: reload state, stay in console
+func (a *Application) handleLFSMigrateResult(msg GitOperationMsg) (tea.Model, tea.Cmd) {
+	buffer := ui.GetBuffer()
+	if err := a.reloadGitState(); err != nil {
+		buffer.Append(fmt.Sprintf(ErrorMessages["failed_detect_state"], err), ui.TypeStderr)
+	}
+	buffer.Append(GetFooterMessageText(MessageOperationComplete), ui.TypeInfo)
+	a.footerHint = GetFooterMessageText(MessageOperationComplete)
+	a.EndAsyncOp()
+	return a, nil
+}
+
+// finishLFSAction reports a finished LFS command and reloads the list;
+// the returned command fetches the locks
+func (a *Application) finishLFSAction(result git.CommandResult, success string) tea.Cmd {
+	status := success
+	if !result.Success {
+		status = result.Stderr
+	}
+	if err := a.reloadGitState(); err != nil {
+		status = fmt.Sprintf("%s (failed to reload state: %v)", status, err)
+	}
+	cmd := a.refreshLFS()
+	a.pickerState.LFS.Status = status
+	return cmd
+}
+
+// refreshLFS reloads tracked patterns and LFS files into the LFS rows.
+// Locks need the LFS server, so they are only queried when a remote exists,
+// by the returned command; LFSLocksMsg fills in the owners.
+// Failures are reported in the status line; whatever loaded is still shown.
+func (a *Application) refreshLFS() tea.Cmd {
+	lfs := a.pickerState.LFS
+	var problems []string
+	var cmd tea.Cmd
+
+	var rows []ui.LFSRow
+	for _, pattern := range git.LFSTrackedPatterns() {
+		rows = append(rows, ui.LFSRow{Kind: ui.LFSRowPattern, Path: pattern})
+	}
+
+	if !git.IsLFSBinaryAvailable() {
+		problems = append(problems, "git-lfs is not installed: files and locks unavailable")
+	} else {
+		if a.gitState != nil && a.gitState.Remote == git.HasRemote {
+			cmd = cmdLFSLocks()
+		}
+
+		files, err := git.ListLFSFiles()
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("Failed to list LFS files: %v", err))
+		}
+		for _, f := range files {
+			rows = append(rows, ui.LFSRow{Kind: ui.LFSRowFile, Path: f.Path, Materialized: f.Materialized})
+		}
+	}
+
+	lfs.Rows = rows
+	lfs.Status = strings.Join(problems, "\n")
+	if lfs.SelectedIdx > len(rows)-1 {
+		lfs.SelectedIdx = len(rows) - 1
+	}
+	if lfs.SelectedIdx < 0 {
+		lfs.SelectedIdx = 0
+	}
+	a.updateLFSDetails()
+	return cmd
+}
+
+// cmdLFSLocks queries the lock owners off the UI thread
+func cmdLFSLocks() tea.Cmd {
+	return func() tea.Msg {
+		locks, err := git.ListLFSLocks(context.Background())
+		owners := make(map[string]string)
+		for _, lock := range locks {
+			owners[lock.Path] = lock.Owner.Name
+		}
+		return LFSLocksMsg{Owners: owners, Err: err}
+	}
+}
+
+// handleLFSLocks fills the lock owners into the file rows
+func (a *Application) handleLFSLocks(msg LFSLocksMsg) (tea.Model, tea.Cmd) {
+	lfs := a.pickerState.LFS
+	if a.mode != ModeLFS || lfs == nil {
+		return a, nil // User left the LFS manager while the server answered
+	}
+	if msg.Err != nil {
+		lfs.Status = strings.TrimSpace(lfs.Status + "\n" + fmt.Sprintf("Locks unavailable: %v", msg.Err))
+	}
+	for i := range lfs.Rows {
+		if lfs.Rows[i].Kind == ui.LFSRowFile {
+			lfs.Rows[i].LockOwner = msg.Owners[lfs.Rows[i].Path]
+		}
+	}
+	a.updateLFSDetails()
+	return a, nil
+}
+
+// updateLFSDetails rebuilds the details pane for the selected row
+func (a *Application) updateLFSDetails() {
+	lfs := a.pickerState.LFS
+	lfs.DetailsLineCursor = 0
+	lfs.DetailsScrollOff = 0
+	lfs.Details = nil
+
+	row, ok := lfs.Selected()
+	if !ok {
+		return
+	}
+
+	if row.Kind == ui.LFSRowPattern {
+		var matched []string
+		pointers := 0
+		for _, r := range lfs.Rows {
+			if r.Kind == ui.LFSRowFile && lfsPatternMatches(row.Path, r.Path) {
+				matched = append(matched, r.Path)
+				if !r.Materialized {
+					pointers++
+				}
+			}
+		}
+		lfs.Details = []string{
+			"PATTERN",
+			"  " + row.Path,
+			"",
+			"FILES",
+			fmt.Sprintf("  %d file(s) in LFS, %d pointer-only", len(matched), pointers),
+		}
+		for i, m := range matched {
+			if i == maxPreviewFiles {
+				lfs.Details = append(lfs.Details, fmt.Sprintf("  … and %d more", len(matched)-maxPreviewFiles))
+				break
+			}
+			lfs.Details = append(lfs.Details, "  "+m)
+		}
+		lfs.Details = append(lfs.Details,
+			"",
+			"ACTIONS",
+			"  x  untrack pattern (.gitattributes)",
+			"  m  migrate existing history to LFS (rewrites commits)")
+		return
+	}
+
+	content := "materialized (real content checked out)"
+	if !row.Materialized {
+		content = "pointer only (object not downloaded)"
+	}
+	lock := "not locked"
+	action := "  l  lock"
+	if row.LockOwner != "" {
+		lock = "locked by " + row.LockOwner
+		action = "  u  unlock"
+	}
+	lfs.Details = []string{
+		"FILE",
+		"  " + row.Path,
+		"",
+		"CONTENT",
+		"  " + content,
+		"",
+		"LOCK",
+		"  " + lock,
+		"",
+		"ACTIONS",
+		action,
+	}
+}
+
+// lfsPatternMatches approximates .gitattributes matching for display:
+// patterns without a slash match the file name, others the full path ("dir/**" by prefix)
+func lfsPatternMatches(pattern, file string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "/**"); ok {
+		return strings.HasPrefix(file, strings.TrimPrefix(prefix, "/")+"/")
+	}
+	if !strings.Contains(pattern, "/") {
+		matched, _ := path.Match(pattern, path.Base(file))
+		return matched
+	}
+	matched, _ := path.Match(strings.TrimPrefix(pattern, "/"), file)
+	return matched
+}
+
+// ========================================
+// Large binary guard (commit workflows)
+// ========================================
+
+// largeBinaryContinuations resume a commit workflow once the user accepts the warning
+var largeBinaryContinuations = map[string]func(*Application) tea.Cmd{
+	"commit":        (*Application).enterCommitInput,
+	"commit_staged": (*Application).enterCommitStagedInput,
+	"commit_push":   (*Application).enterCommitPushInput,
+}
+
+// warnLargeBinaries shows a confirmation when the commit would add large binaries
+// that are not tracked by LFS. Returns true if the dialog was shown.
+func (a *Application) warnLargeBinaries(action string, stagedOnly bool) bool {
+	if a.gitState == nil {
+		return false
+	}
+
+	var paths []string
+	for _, e := range a.gitState.Entries {
+		if e.Index == 'D' || e.WorkTree == 'D' || (stagedOnly && !e.IsStaged()) {
+			continue
+		}
+		paths = append(paths, e.Path)
+	}
+
+	large, err := git.FindLargeBinaries(paths, git.LFSLargeFileThreshold)
+	if err != nil || len(large) == 0 {
+		return false // Warning is advisory; never block a commit on a failed check
+	}
+
+	var list []string
+	for _, f := range large {
+		list = append(list, fmt.Sprintf("  %s (%d MB)", f.Path, f.Size/(1024*1024)))
+	}
+
+	msg := ConfirmationMessages[string(ConfirmLFSLargeBinaries)]
+	a.mode = ModeConfirmation
+	dialog := ui.NewConfirmationDialog(
+		ui.ConfirmationConfig{
+			Title:       msg.Title,
+			Explanation: fmt.Sprintf(msg.Explanation, strings.Join(list, "\n"), git.LFSLargeFileThreshold/(1024*1024)),
+			YesLabel:    msg.YesLabel,
+			NoLabel:     msg.NoLabel,
+			ActionID:    string(ConfirmLFSLargeBinaries),
+		},
+		a.sizing.ContentInnerWidth,
+		&a.theme,
+	)
+	a.dialogState.Show(dialog, map[string]string{"action": action})
+	dialog.SelectNo()
+	return true
+}
+
+// executeConfirmLargeBinaries handles YES response: continue to the commit message input
+func (a *Application) executeConfirmLargeBinaries() (tea.Model, tea.Cmd) {
+	action := a.dialogState.context["action"]
+	a.dialogState.Hide()
+	if resume, ok := largeBinaryContinuations[action]; ok {
+		return a, resume(a)
+	}
+	return a.returnToMenu()
+}
+
+// executeRejectLargeBinaries handles NO response: abandon the commit
+func (a *Application) executeRejectLargeBinaries() (tea.Model, tea.Cmd) {
+	a.dialogState.Hide()
+	return a.returnToMenu()
+}
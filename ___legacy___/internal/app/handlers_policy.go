@@ -0,0 +1,145 @@
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jrengmusic/tit/internal/config"
+	"github.com/jrengmusic/tit/internal/git"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ========================================
+// Destructive-action policy ([policy] in config.toml and .tit.toml)
+// ========================================
+
+// loadPolicy merges the global policy with the repository's .tit.toml.
+// A broken repository file is reported and ignored; the global policy still applies.
+func (a *Application) loadPolicy() {
+	a.policy = config.PolicyConfig{}
+	if a.appConfig != nil {
+		a.policy = a.appConfig.Policy
+	}
+	if a.gitState == nil || a.gitState.Operation == git.NotRepo {
+		return
+	}
+	repo, err := config.LoadRepoPolicy(".")
+	if err != nil {
+		git.Warn(fmt.Sprintf(ErrorMessages["policy_load_failed"], err))
+		return
+	}
+	a.policy = a.policy.Merge(repo)
+}
+
+// protection returns how action is restricted on the checked-out branch
+func (a *Application) protection(action string) config.ProtectMode {
+	if a.gitState == nil || a.gitState.Detached {
+		return config.ProtectNone
+	}
+	return a.policy.Protection(a.gitState.CurrentBranch, action)
+}
+
+// policyMenuItems returns the menu items for ids, dropping destructive ones the
+// policy hides and flagging the ones that need the branch name typed
+func (a *Application) policyMenuItems(ids ...string) []MenuItem {
+	items := make([]MenuItem, 0, len(ids))
+	for _, id := range ids {
+		item := GetMenuItem(id)
+		if config.IsDestructiveAction(id) {
+			switch a.protection(id) {
+			case config.ProtectHide:
+				continue
+			case config.ProtectConfirm:
+				item.Hint = fmt.Sprintf(ConsoleMessages["policy_typed_hint"], a.gitState.CurrentBranch)
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// discardMenuItem returns "discard changes", disabled when more files changed than the policy allows
+func (a *Application) discardMenuItem() MenuItem {
+	item := GetMenuItem("reset_discard_changes")
+	limit := a.policy.MaxDiscardFiles
+	if changed := len(a.gitState.Entries); limit > 0 && changed > limit {
+		item.Enabled = false
+		item.Hint = fmt.Sprintf(ConsoleMessages["policy_discard_limit"], changed, limit)
+	}
+	return item
+}
+
+// guardPolicy applies the policy before a destructive action's regular confirmation.
+// Returns true when it took over: the action was refused or the typed confirmation is shown.
+func (a *Application) guardPolicy(action string) bool {
+	branch := a.gitState.CurrentBranch
+	switch a.protection(action) {
+	case config.ProtectHide:
+		a.footerHint = fmt.Sprintf(ErrorMessages["policy_hidden"], branch)
+		return true
+	case config.ProtectConfirm:
+		msg := InputMessages["policy_confirm_"+action]
+		a.workflowState.PolicyAction = action
+		a.transitionTo(ModeTransition{
+			Mode:        ModeInput,
+			InputPrompt: fmt.Sprintf(msg.Prompt, branch),
+			InputAction: "policy_confirm",
+			FooterHint:  msg.Hint,
+		})
+		return true
+	}
+	return false
+}
+
+// handlePolicyConfirmSubmit runs the pending destructive action once the branch name matches
+func (a *Application) handlePolicyConfirmSubmit(app *Application) (tea.Model, tea.Cmd) {
+	branch := app.gitState.CurrentBranch
+	if strings.TrimSpace(app.inputState.Value) != branch {
+		app.footerHint = fmt.Sprintf(ErrorMessages["policy_confirm_mismatch"], branch)
+		return app, nil
+	}
+	action := app.workflowState.PolicyAction
+	app.workflowState.PolicyAction = ""
+	app.inputState.Reset()
+
+	// Same handlers the regular confirmation dialogs call on Yes
+	switch action {
+	case config.ActionForcePush:
+		// Leave the input first: the dialogs return to the mode force push started from
+		app.mode = app.workflowState.PreviousMode
+		app.continueForcePush() // Canon gate, then which remote commits are lost
+		return app, nil
+	case config.ActionReplaceLocal:
+		return app.executeConfirmHardReset()
+	case config.ActionRewind:
+		return app.executeConfirmRewind()
+	}
+	return app.returnToMenu()
+}
+
+// rewindExceedsPolicy reports (and explains in the footer) a rewind to hash that
+// would drop more commits than max_rewind_commits allows, or whose count fails
+func (a *Application) rewindExceedsPolicy(hash string) bool {
+	limit := a.policy.MaxRewindCommits
+	if limit == 0 {
+		return false
+	}
+	// Fails closed: a count that cannot be read refuses the rewind
+	result := git.Execute("rev-list", "--count", hash+"..HEAD")
+	if !result.Success {
+		a.footerHint = fmt.Sprintf(ErrorMessages["policy_rewind_count_failed"], result.Stderr)
+		return true
+	}
+	dropped, err := strconv.Atoi(strings.TrimSpace(result.Stdout))
+	if err != nil {
+		a.footerHint = fmt.Sprintf(ErrorMessages["policy_rewind_count_failed"], err)
+		return true
+	}
+	if dropped <= limit {
+		return false
+	}
+	a.footerHint = fmt.Sprintf(ErrorMessages["policy_rewind_limit"], dropped, limit)
+	return true
+}
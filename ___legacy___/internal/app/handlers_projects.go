@@ -0,0 +1,104 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/jrengmusic/tit/internal"
+	"github.com/jrengmusic/tit/internal/config"
+	"github.com/jrengmusic/tit/internal/git"
+	"github.com/jrengmusic/tit/internal/ui"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// recordRecentRepo adds the opened repository to the project switcher's recent list
+func recordRecentRepo(repoPath string) {
+	if err := config.RecordRecentRepo(repoPath); err != nil {
+		git.Warn(fmt.Sprintf(ErrorMessages["recent_repos_save_failed"], err))
+	}
+}
+
+// switchRepository re-runs the startup flow in repoPath without restarting the process.
+// The returned Application is built exactly as if TIT had been started there (including
+// ModeStartup remote gating); the caller returns it with its Init().
+func (a *Application) switchRepository(repoPath string) (*Application, error) {
+	if err := os.Chdir(repoPath); err != nil {
+		return nil, err
+	}
+	// Preloads still in flight write into this manager; drop everything it holds
+	a.cacheManager.Invalidate()
+
+	next := NewApplication(a.sizing, a.theme, a.appConfig, git.CurrentBackend())
+	next.width, next.height, next.sizing = a.width, a.height, a.sizing // Terminal size, not the startup default
+	next.dashboard = a.dashboard
+	return next, nil
+}
+
+// openProjectSwitcher shows recent repositories that still exist, most recent first
+func (a *Application) openProjectSwitcher() {
+	cwd, _ := os.Getwd()
+	var entries []ui.ProjectEntry
+	for _, repo := range config.LoadRecentRepos() {
+		if _, err := os.Stat(filepath.Join(repo.Path, internal.GitDirectoryName)); err != nil {
+			continue // Moved or deleted since
+		}
+		entries = append(entries, ui.ProjectEntry{Path: repo.Path, Current: repo.Path == cwd})
+	}
+	a.pickerState.Projects = &ui.ProjectSwitcherState{Repos: entries}
+
+	a.inputState.Reset()
+	a.inputState.ClearConfirming = false
+	a.workflowState.PreviousMode = a.mode
+	a.workflowState.PreviousMenuIndex = a.selectedIndex
+	a.mode = ModeProjectSwitcher
+	a.footerHint = ""
+}
+
+// dispatchConfigProjects opens the project switcher from the config menu
+func (a *Application) dispatchConfigProjects(app *Application) tea.Cmd {
+	app.openProjectSwitcher()
+	return nil
+}
+
+// handleKeyCtrlO opens the project switcher from the main menu
+func (a *Application) handleKeyCtrlO(app *Application) (tea.Model, tea.Cmd) {
+	if app.mode == ModeMenu {
+		app.openProjectSwitcher()
+	}
+	return app, nil
+}
+
+// handleProjectSwitcherUp moves the selection up the matches
+func (a *Application) handleProjectSwitcherUp(app *Application) (tea.Model, tea.Cmd) {
+	if a.pickerState.Projects.SelectedIdx > 0 {
+		a.pickerState.Projects.SelectedIdx--
+	}
+	return a, nil
+}
+
+// handleProjectSwitcherDown moves the selection down the matches
+func (a *Application) handleProjectSwitcherDown(app *Application) (tea.Model, tea.Cmd) {
+	switcher := a.pickerState.Projects
+	if switcher.SelectedIdx < len(switcher.Matches(a.inputState.Value))-1 {
+		switcher.SelectedIdx++
+	}
+	return a, nil
+}
+
+// handleProjectSwitcherOpen switches TIT into the selected repository (Enter)
+func (a *Application) handleProjectSwitcherOpen(app *Application) (tea.Model, tea.Cmd) {
+	matches := a.pickerState.Projects.Matches(a.inputState.Value)
+	if a.pickerState.Projects.SelectedIdx >= len(matches) {
+		return a, nil
+	}
+	repo := matches[a.pickerState.Projects.SelectedIdx].Path
+
+	next, err := a.switchRepository(repo)
+	if err != nil {
+		a.footerHint = fmt.Sprintf(ErrorMessages["project_switch_failed"], filepath.Base(repo), err)
+		return a, nil
+	}
+	return next, next.Init()
+}
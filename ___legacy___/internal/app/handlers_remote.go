@@ -28,6 +28,7 @@ func (a *Application) handleFetchRemote(msg GitOperationMsg) (tea.Model, tea.Cmd
 		a.EndAsyncOp()
 		return a, nil
 	}
+	a.applyIdentityProfile() // Host rules match the new remote
 
 	// SSOT: detached HEAD cannot have upstream - skip upstream setting
 	if a.gitState.Detached {
@@ -38,3 +39,16 @@ func (a *Application) handleFetchRemote(msg GitOperationMsg) (tea.Model, tea.Cmd
 
 	return a, a.cmdSetUpstream(a.gitState.CurrentBranch)
 }
+
+// handleShallowFetch handles OpDeepenHistory and OpUnshallow: reload state, stay in console.
+// History caches rebuild when ESC returns to the menu, picking up the new boundary.
+func (a *Application) handleShallowFetch(msg GitOperationMsg) (tea.Model, tea.Cmd) {
+	buffer := ui.GetBuffer()
+	if err := a.reloadGitState(); err != nil {
+		buffer.Append(fmt.Sprintf(ErrorMessages["failed_detect_state"], err), ui.TypeStderr)
+	}
+	buffer.Append(GetFooterMessageText(MessageOperationComplete), ui.TypeInfo)
+	a.footerHint = GetFooterMessageText(MessageOperationComplete)
+	a.EndAsyncOp()
+	return a, nil
+}
@@ -0,0 +1,368 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jrengmusic/tit/internal/git"
+	"github.com/jrengmusic/tit/internal/ui"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ========================================
+// Sparse Checkout Mode Handlers (SSOT: matches LFS manager navigation pattern)
+// ========================================
+
+// handleSparseUp handles UP/K navigation in the directory tree
+func (a *Application) handleSparseUp(app *Application) (tea.Model, tea.Cmd) {
+	sparse := app.pickerState.Sparse
+	if sparse != nil && sparse.SelectedIdx > 0 {
+		sparse.SelectedIdx--
+		app.updateSparseDetails()
+	}
+	return app, nil
+}
+
+// handleSparseDown handles DOWN/J navigation in the directory tree
+func (a *Application) handleSparseDown(app *Application) (tea.Model, tea.Cmd) {
+	sparse := app.pickerState.Sparse
+	if sparse != nil && sparse.SelectedIdx < len(sparse.Rows)-1 {
+		sparse.SelectedIdx++
+		app.updateSparseDetails()
+	}
+	return app, nil
+}
+
+// handleSparseExpand handles RIGHT/L — lists the selected directory's subdirectories
+func (a *Application) handleSparseExpand(app *Application) (tea.Model, tea.Cmd) {
+	row, ok := app.pickerState.Sparse.Selected()
+	if !ok || !row.HasChildren || row.Expanded {
+		return app, nil
+	}
+	app.pickerState.Sparse.Expanded[row.Path] = true
+	app.rebuildSparseRows()
+	return app, nil
+}
+
+// handleSparseCollapse handles LEFT/H — collapses the selected directory, or jumps to its parent
+func (a *Application) handleSparseCollapse(app *Application) (tea.Model, tea.Cmd) {
+	sparse := app.pickerState.Sparse
+	row, ok := sparse.Selected()
+	if !ok {
+		return app, nil
+	}
+	if row.Expanded {
+		delete(sparse.Expanded, row.Path)
+		app.rebuildSparseRows()
+		return app, nil
+	}
+	parent := sparseParent(row.Path)
+	for i, r := range sparse.Rows {
+		if r.Path == parent {
+			sparse.SelectedIdx = i
+			app.updateSparseDetails()
+			break
+		}
+	}
+	return app, nil
+}
+
+// handleSparseToggle handles SPACE — adds the selected directory to the pending cone or removes it.
+// Choosing a directory replaces any chosen subdirectories (the cone covers them).
+// A directory included through a chosen parent cannot be removed on its own.
+func (a *Application) handleSparseToggle(app *Application) (tea.Model, tea.Cmd) {
+	sparse := app.pickerState.Sparse
+	row, ok := sparse.Selected()
+	if !ok {
+		return app, nil
+	}
+
+	switch {
+	case sparse.Chosen[row.Path]:
+		delete(sparse.Chosen, row.Path)
+	case row.Inclusion == ui.SparseIncluded:
+		sparse.Status = fmt.Sprintf(ErrorMessages["sparse_parent_chosen"], sparseChosenAncestor(sparse.Chosen, row.Path))
+		return app, nil
+	default:
+		for dir := range sparse.Chosen {
+			if strings.HasPrefix(dir, row.Path+"/") {
+				delete(sparse.Chosen, dir)
+			}
+		}
+		sparse.Chosen[row.Path] = true
+	}
+
+	sparse.Status = ""
+	app.rebuildSparseRows()
+	return app, nil
+}
+
+// handleSparseApply handles ENTER — streams `git sparse-checkout set --cone` for the pending cone
+func (a *Application) handleSparseApply(app *Application) (tea.Model, tea.Cmd) {
+	sparse := app.pickerState.Sparse
+	if sparse == nil || sparse.Tree == nil {
+		return app, nil
+	}
+
+	dirs := sparseChosenList(sparse.Chosen)
+	if sparse.Enabled && sparse.Cone && strings.Join(dirs, "\n") == strings.Join(sparseChosenList(sparseBaseline(sparse)), "\n") {
+		sparse.Status = "No changes to apply"
+		return app, nil
+	}
+
+	files, size := sparse.Tree.ConeEstimate(dirs)
+	output := fmt.Sprintf("Sparse checkout set: %d file(s), %s checked out", files, ui.FormatBytes(size))
+	app.prepareAsyncOperation(GetFooterMessageText(MessageOperationInProgress))
+	return app, app.cmdSparseCheckout(git.SparseSetArgs(dirs), output)
+}
+
+// handleSparseDisable handles "F" — streams `git sparse-checkout disable` to restore a full checkout
+func (a *Application) handleSparseDisable(app *Application) (tea.Model, tea.Cmd) {
+	sparse := app.pickerState.Sparse
+	if sparse == nil {
+		return app, nil
+	}
+	if !sparse.Enabled {
+		sparse.Status = ErrorMessages["sparse_not_enabled"]
+		return app, nil
+	}
+
+	app.prepareAsyncOperation(GetFooterMessageText(MessageOperationInProgress))
+	return app, app.cmdSparseCheckout([]string{"sparse-checkout", "disable"}, "Sparse checkout disabled: full working tree restored")
+}
+
+// cmdSparseCheckout runs a `git sparse-checkout` subcommand with streaming output
+func (a *Application) cmdSparseCheckout(args []string, output string) tea.Cmd {
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancelContext = cancel
+	return func() tea.Msg {
+		result := git.ExecuteWithStreaming(ctx, args...)
+		if !result.Success {
+			return GitOperationMsg{
+				Step:    OpSparseCheckout,
+				Success: false,
+				Error:   result.Stderr,
+			}
+		}
+
+		return GitOperationMsg{
+			Step:    OpSparseCheckout,
+			Success: true,
+			Output:  output,
+		}
+	}
+}
+
+// handleSparseCheckoutResult handles OpSparseCheckout: reload state, stay in console
+func (a *Application) handleSparseCheckoutResult(msg GitOperationMsg) (tea.Model, tea.Cmd) {
+	buffer := ui.GetBuffer()
+	if err := a.reloadGitState(); err != nil {
+		buffer.Append(fmt.Sprintf(ErrorMessages["failed_detect_state"], err), ui.TypeStderr)
+	}
+	buffer.Append(GetFooterMessageText(MessageOperationComplete), ui.TypeInfo)
+	a.footerHint = GetFooterMessageText(MessageOperationComplete)
+	a.EndAsyncOp()
+	return a, nil
+}
+
+// refreshSparse loads HEAD's directory tree and the current sparse patterns.
+// The pending cone starts from the current cone; a full (or non-cone) checkout
+// starts with every top-level directory so the estimate equals the whole tree.
+func (a *Application) refreshSparse() {
+	sparse := a.pickerState.Sparse
+	sparse.Status = ""
+	sparse.Enabled = git.IsSparse()
+	sparse.Cone = sparse.Enabled && git.IsSparseCone()
+	sparse.Current = nil
+
+	tree, err := git.ReadRepoTree()
+	if err != nil {
+		sparse.Tree = nil
+		sparse.Rows = nil
+		sparse.Details = nil
+		sparse.Status = fmt.Sprintf(ErrorMessages["sparse_tree_failed"], err)
+		return
+	}
+	sparse.Tree = tree
+
+	if sparse.Enabled {
+		patterns, err := git.SparsePatterns()
+		if err != nil {
+			sparse.Status = fmt.Sprintf(ErrorMessages["sparse_patterns_failed"], err)
+		}
+		sparse.Current = patterns
+	}
+
+	sparse.Chosen = sparseBaseline(sparse)
+	a.rebuildSparseRows()
+}
+
+// sparseBaseline returns the cone equivalent of what is checked out now
+func sparseBaseline(sparse *ui.SparseCheckoutState) map[string]bool {
+	baseline := make(map[string]bool)
+	if sparse.Cone {
+		for _, dir := range sparse.Current {
+			if _, ok := sparse.Tree.Dirs[dir]; ok {
+				baseline[dir] = true
+			}
+		}
+		return baseline
+	}
+	for _, dir := range sparse.Tree.Root().Children {
+		baseline[dir] = true
+	}
+	return baseline
+}
+
+// rebuildSparseRows flattens the expanded part of the tree into list rows, keeping the selection
+func (a *Application) rebuildSparseRows() {
+	sparse := a.pickerState.Sparse
+	if sparse.Tree == nil {
+		return
+	}
+
+	selected := ""
+	if row, ok := sparse.Selected(); ok {
+		selected = row.Path
+	}
+
+	var rows []ui.SparseRow
+	var walk func(dir string, depth int)
+	walk = func(dir string, depth int) {
+		for _, child := range sparse.Tree.Dirs[dir].Children {
+			row := ui.SparseRow{
+				Path:        child,
+				Depth:       depth,
+				HasChildren: len(sparse.Tree.Dirs[child].Children) > 0,
+				Expanded:    sparse.Expanded[child],
+				Inclusion:   sparseInclusion(sparse.Chosen, child),
+			}
+			rows = append(rows, row)
+			if row.HasChildren && row.Expanded {
+				walk(child, depth+1)
+			}
+		}
+	}
+	walk("", 0)
+
+	sparse.Rows = rows
+	sparse.SelectedIdx = 0
+	for i, row := range rows {
+		if row.Path == selected {
+			sparse.SelectedIdx = i
+			break
+		}
+	}
+	a.updateSparseDetails()
+}
+
+// updateSparseDetails rebuilds the details pane: checkout mode, selection estimate, pending changes
+func (a *Application) updateSparseDetails() {
+	sparse := a.pickerState.Sparse
+	sparse.DetailsLineCursor = 0
+	sparse.DetailsScrollOff = 0
+	sparse.Details = nil
+	if sparse.Tree == nil {
+		return
+	}
+
+	var lines []string
+	switch {
+	case !sparse.Enabled:
+		lines = append(lines, "CHECKOUT", "  full (sparse checkout disabled)")
+	case sparse.Cone:
+		lines = append(lines, "CHECKOUT", "  cone mode")
+	default:
+		lines = append(lines, "CHECKOUT", "  pattern mode (applying switches to cone mode)")
+	}
+	if sparse.Enabled {
+		lines = append(lines, "", "CURRENT")
+		if len(sparse.Current) == 0 {
+			lines = append(lines, "  (root files only)")
+		}
+		for _, pattern := range sparse.Current {
+			lines = append(lines, "  "+pattern)
+		}
+	}
+
+	root := sparse.Tree.Root()
+	files, size := sparse.Tree.ConeEstimate(sparseChosenList(sparse.Chosen))
+	lines = append(lines,
+		"",
+		"SELECTION",
+		fmt.Sprintf("  %d of %d file(s)", files, root.Files),
+		fmt.Sprintf("  %s of %s", ui.FormatBytes(size), ui.FormatBytes(root.Size)))
+
+	baseline := sparseBaseline(sparse)
+	var changes []string
+	for _, dir := range sparseChosenList(sparse.Chosen) {
+		if !baseline[dir] {
+			changes = append(changes, "  + "+dir+"/")
+		}
+	}
+	for _, dir := range sparseChosenList(baseline) {
+		if !sparse.Chosen[dir] {
+			changes = append(changes, "  - "+dir+"/")
+		}
+	}
+	if len(changes) > 0 {
+		lines = append(lines, "", "PENDING")
+		lines = append(lines, changes...)
+	}
+
+	if row, ok := sparse.Selected(); ok {
+		dir := sparse.Tree.Dirs[row.Path]
+		lines = append(lines,
+			"",
+			"DIRECTORY",
+			"  "+row.Path+"/",
+			fmt.Sprintf("  %d file(s), %s", dir.Files, ui.FormatBytes(dir.Size)),
+			fmt.Sprintf("  %d file(s) directly inside", dir.DirectFiles))
+	}
+
+	sparse.Details = lines
+}
+
+// sparseInclusion reports whether dir is in the cone, partly in it, or out
+func sparseInclusion(chosen map[string]bool, dir string) ui.SparseInclusion {
+	if sparseChosenAncestor(chosen, dir) != "" {
+		return ui.SparseIncluded
+	}
+	for c := range chosen {
+		if strings.HasPrefix(c, dir+"/") {
+			return ui.SparsePartial
+		}
+	}
+	return ui.SparseExcluded
+}
+
+// sparseChosenAncestor returns dir or its nearest chosen ancestor, empty when none is chosen
+func sparseChosenAncestor(chosen map[string]bool, dir string) string {
+	for d := dir; d != ""; d = sparseParent(d) {
+		if chosen[d] {
+			return d
+		}
+	}
+	return ""
+}
+
+// sparseParent returns the parent of a repository-relative directory ("" for top-level)
+func sparseParent(dir string) string {
+	if i := strings.LastIndex(dir, "/"); i >= 0 {
+		return dir[:i]
+	}
+	return ""
+}
+
+// sparseChosenList returns the chosen directories sorted
+func sparseChosenList(chosen map[string]bool) []string {
+	dirs := make([]string, 0, len(chosen))
+	for dir := range chosen {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	return dirs
+}
@@ -19,6 +19,12 @@ func (a *Application) handleTimeTravelCheckout(msg git.TimeTravelCheckoutMsg) (t
 		a.EndAsyncOp()
 		a.PermitExit(true)
 
+		// A failed hop leaves the running session (HEAD and trail) as it was:
+		// stay in console, ESC returns to the time travel menu
+		if marker, err := git.ReadTimeTravelMarker(); err == nil && len(marker.Hops) > 0 {
+			return a, nil
+		}
+
 		// Try to cleanup time travel info file
 		git.ClearTimeTravelInfo()
 
@@ -41,6 +47,12 @@ func (a *Application) handleTimeTravelCheckout(msg git.TimeTravelCheckoutMsg) (t
 		return a, nil
 	}
 
+	// Pick up the trail the checkout recorded (header breadcrumb, back one hop)
+	if info, err := git.LoadTimeTravelInfo(); err == nil && info != nil {
+		a.timeTravelState.info = info
+	}
+	a.loadRepoCommands()
+
 	a.EndAsyncOp()
 	a.PermitExit(true)
 
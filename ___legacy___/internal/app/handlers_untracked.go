@@ -0,0 +1,239 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/jrengmusic/tit/internal/git"
+	"github.com/jrengmusic/tit/internal/ui"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ========================================
+// Untracked Triage Mode Handlers (SSOT: matches branch picker navigation pattern)
+// ========================================
+
+// maxPreviewFiles caps the files listed under each pattern preview
+const maxPreviewFiles = 8
+
+// handleUntrackedUp handles UP/K navigation in the triage list
+func (a *Application) handleUntrackedUp(app *Application) (tea.Model, tea.Cmd) {
+	triage := app.pickerState.Untracked
+	if triage != nil && triage.SelectedIdx > 0 {
+		triage.SelectedIdx--
+		app.updateUntrackedDetails()
+	}
+	return app, nil
+}
+
+// handleUntrackedDown handles DOWN/J navigation in the triage list
+func (a *Application) handleUntrackedDown(app *Application) (tea.Model, tea.Cmd) {
+	triage := app.pickerState.Untracked
+	if triage != nil && triage.SelectedIdx < len(triage.Rows)-1 {
+		triage.SelectedIdx++
+		app.updateUntrackedDetails()
+	}
+	return app, nil
+}
+
+// handleUntrackedAdd handles "a" — stages the selected file, or every file in the selected group
+func (a *Application) handleUntrackedAdd(app *Application) (tea.Model, tea.Cmd) {
+	row, ok := app.pickerState.Untracked.Selected()
+	if !ok || row.Ignored {
+		return app, nil
+	}
+
+	paths := []string{row.Path}
+	if row.Kind == ui.UntrackedRowGroup {
+		paths = app.pickerState.Untracked.GroupFiles(app.pickerState.Untracked.SelectedIdx)
+	}
+
+	status := fmt.Sprintf("Staged %d file(s)", len(paths))
+	if err := git.AddPaths(paths...); err != nil {
+		status = fmt.Sprintf("Failed to stage: %v", err)
+	}
+	app.finishUntrackedAction(status)
+	return app, nil
+}
+
+// handleUntrackedIgnore handles "i" — ignores the exact path (or the group pattern) in .gitignore
+func (a *Application) handleUntrackedIgnore(app *Application) (tea.Model, tea.Cmd) {
+	return app.ignoreSelected(git.IgnoreGitignore, func(p git.IgnorePatterns) string { return p.Exact })
+}
+
+// handleUntrackedIgnoreExtension handles "e" — ignores every file with the selected file's extension
+func (a *Application) handleUntrackedIgnoreExtension(app *Application) (tea.Model, tea.Cmd) {
+	return app.ignoreSelected(git.IgnoreGitignore, func(p git.IgnorePatterns) string { return p.Extension })
+}
+
+// handleUntrackedIgnoreDirectory handles "d" — ignores the selected file's parent directory
+func (a *Application) handleUntrackedIgnoreDirectory(app *Application) (tea.Model, tea.Cmd) {
+	return app.ignoreSelected(git.IgnoreGitignore, func(p git.IgnorePatterns) string { return p.Directory })
+}
+
+// handleUntrackedExclude handles "x" — ignores the exact path locally via .git/info/exclude
+func (a *Application) handleUntrackedExclude(app *Application) (tea.Model, tea.Cmd) {
+	return app.ignoreSelected(git.IgnoreExclude, func(p git.IgnorePatterns) string { return p.Exact })
+}
+
+// ignoreSelected appends the pattern chosen by pick for the selected row to target
+func (a *Application) ignoreSelected(target git.IgnoreTarget, pick func(git.IgnorePatterns) string) (tea.Model, tea.Cmd) {
+	row, ok := a.pickerState.Untracked.Selected()
+	if !ok || row.Ignored {
+		return a, nil
+	}
+
+	pattern := pick(untrackedRowPatterns(row))
+	if pattern == "" {
+		return a, nil // Pattern does not apply (no extension, root file, or group row)
+	}
+
+	status := fmt.Sprintf("Added %s to %s", pattern, target.Path())
+	if err := git.AppendIgnorePattern(target, pattern); err != nil {
+		status = fmt.Sprintf("Failed to update %s: %v", target.Path(), err)
+	}
+	a.finishUntrackedAction(status)
+	return a, nil
+}
+
+// untrackedRowPatterns returns the patterns an action can apply to a row.
+// A group row already names its pattern, so only the exact form is offered.
+func untrackedRowPatterns(row ui.UntrackedRow) git.IgnorePatterns {
+	if row.Kind == ui.UntrackedRowGroup {
+		return git.IgnorePatterns{Exact: row.Path}
+	}
+	return git.PatternsFor(row.Path)
+}
+
+// finishUntrackedAction reloads git state and the triage list after an action
+func (a *Application) finishUntrackedAction(status string) {
+	if err := a.reloadGitState(); err != nil {
+		status = fmt.Sprintf("%s (failed to reload state: %v)", status, err)
+	}
+	if err := a.refreshUntrackedTriage(); err != nil {
+		status = fmt.Sprintf("%s (failed to refresh list: %v)", status, err)
+	}
+	a.pickerState.Untracked.Status = status
+}
+
+// refreshUntrackedTriage reloads untracked and ignored paths into the triage rows.
+// The old selection index is clamped to the new list.
+func (a *Application) refreshUntrackedTriage() error {
+	untracked, err := git.ListUntracked()
+	if err != nil {
+		return err
+	}
+	ignored, err := git.ListIgnored()
+	if err != nil {
+		return err
+	}
+
+	var rows []ui.UntrackedRow
+	for _, group := range git.GroupUntracked(untracked) {
+		rows = append(rows, ui.UntrackedRow{Kind: ui.UntrackedRowGroup, Path: group.Pattern, Count: len(group.Files)})
+		for _, file := range group.Files {
+			rows = append(rows, ui.UntrackedRow{Kind: ui.UntrackedRowFile, Path: file})
+		}
+	}
+	if len(ignored) > 0 {
+		rows = append(rows, ui.UntrackedRow{Kind: ui.UntrackedRowGroup, Path: "ignored", Count: len(ignored), Ignored: true})
+		for _, path := range ignored {
+			rows = append(rows, ui.UntrackedRow{Kind: ui.UntrackedRowFile, Path: path, Ignored: true})
+		}
+	}
+
+	triage := a.pickerState.Untracked
+	triage.Rows = rows
+	if triage.SelectedIdx > len(rows)-1 {
+		triage.SelectedIdx = len(rows) - 1
+	}
+	if triage.SelectedIdx < 0 {
+		triage.SelectedIdx = 0
+	}
+	a.updateUntrackedDetails()
+	return nil
+}
+
+// updateUntrackedDetails rebuilds the details pane for the selected row.
+// Untracked rows preview what each action would match; ignored rows show the matching rule.
+func (a *Application) updateUntrackedDetails() {
+	triage := a.pickerState.Untracked
+	triage.DetailsLineCursor = 0
+	triage.DetailsScrollOff = 0
+	triage.Details = nil
+
+	row, ok := triage.Selected()
+	if !ok {
+		return
+	}
+
+	switch {
+	case row.Ignored && row.Kind == ui.UntrackedRowGroup:
+		triage.Details = []string{
+			"IGNORED",
+			fmt.Sprintf("  %d path(s) are ignored by .gitignore or exclude rules.", row.Count),
+			"  Select one to see which rule ignores it.",
+		}
+
+	case row.Ignored:
+		triage.Details = []string{"IGNORED", "  " + row.Path, "", "RULE"}
+		matches, err := git.CheckIgnore(row.Path)
+		switch {
+		case err != nil:
+			triage.Details = append(triage.Details, fmt.Sprintf("  (check-ignore failed: %v)", err))
+		case len(matches) == 0:
+			triage.Details = append(triage.Details, "  (ignored by a rule inside a nested directory)")
+		default:
+			for _, m := range matches {
+				triage.Details = append(triage.Details,
+					fmt.Sprintf("  %s:%d", m.Source, m.Line),
+					fmt.Sprintf("  pattern: %s", m.Pattern))
+			}
+		}
+
+	default:
+		heading := "FILE"
+		if row.Kind == ui.UntrackedRowGroup {
+			heading = fmt.Sprintf("PATTERN (%d file(s))", row.Count)
+		}
+		triage.Details = []string{heading, "  " + row.Path, "", "ACTIONS"}
+		triage.Details = append(triage.Details, "  a  stage")
+
+		patterns := untrackedRowPatterns(row)
+		actions := []struct {
+			key     string
+			pattern string
+			target  git.IgnoreTarget
+		}{
+			{"i", patterns.Exact, git.IgnoreGitignore},
+			{"e", patterns.Extension, git.IgnoreGitignore},
+			{"d", patterns.Directory, git.IgnoreGitignore},
+			{"x", patterns.Exact, git.IgnoreExclude},
+		}
+		for _, action := range actions {
+			if action.pattern == "" {
+				continue
+			}
+			triage.Details = append(triage.Details, "", fmt.Sprintf("  %s  %s → %s", action.key, action.pattern, action.target.Path()))
+			triage.Details = append(triage.Details, previewLines(action.pattern)...)
+		}
+	}
+}
+
+// previewLines lists the untracked files a pattern would match, capped at maxPreviewFiles
+func previewLines(pattern string) []string {
+	matches, err := git.PreviewIgnorePattern(pattern)
+	if err != nil {
+		return []string{fmt.Sprintf("     (preview failed: %v)", err)}
+	}
+
+	lines := []string{fmt.Sprintf("     matches %d file(s)", len(matches))}
+	for i, m := range matches {
+		if i == maxPreviewFiles {
+			lines = append(lines, fmt.Sprintf("     … and %d more", len(matches)-maxPreviewFiles))
+			break
+		}
+		lines = append(lines, "     "+m)
+	}
+	return lines
+}
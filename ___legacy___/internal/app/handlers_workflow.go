@@ -0,0 +1,309 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jrengmusic/tit/internal/git"
+	"github.com/jrengmusic/tit/internal/ui"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ========================================
+// Canon/working branch workflow (tit.canon / tit.working in .git/config)
+// ========================================
+
+// workflow returns the repository's canon/working pair as last detected
+func (a *Application) workflow() git.BranchWorkflow {
+	if a.gitState == nil {
+		return git.BranchWorkflow{}
+	}
+	return git.BranchWorkflow{Canon: a.gitState.Canon, Working: a.gitState.Working}
+}
+
+// onCanon reports whether HEAD is the canon branch of a configured workflow
+func (a *Application) onCanon() bool {
+	return a.workflow().Configured() && !a.gitState.Detached && a.gitState.CurrentBranch == a.gitState.Canon
+}
+
+// onWorking reports whether HEAD is the working branch of a configured workflow
+func (a *Application) onWorking() bool {
+	return a.workflow().Configured() && !a.gitState.Detached && a.gitState.CurrentBranch == a.gitState.Working
+}
+
+// menuWorkflow returns update/deliver actions while on a clean working branch
+func (a *Application) menuWorkflow() []MenuItem {
+	if !a.onWorking() || a.gitState.WorkingTree != git.Clean {
+		return []MenuItem{}
+	}
+
+	var items []MenuItem
+	if a.gitState.WorkingBehind > 0 {
+		item := GetMenuItem("workflow_update")
+		item.Hint = fmt.Sprintf(item.Hint, a.gitState.WorkingBehind, a.gitState.Canon, a.gitState.Working)
+		items = append(items, item)
+	} else if a.gitState.WorkingAhead > 0 {
+		// Deliver only fast-forwards: canon must not have anything working lacks
+		item := GetMenuItem("workflow_deliver")
+		item.Hint = fmt.Sprintf(item.Hint, a.gitState.WorkingAhead, a.gitState.Canon)
+		items = append(items, item)
+	}
+	return items
+}
+
+// workflowDescription is the header line relating the working branch to canon
+func (a *Application) workflowDescription() string {
+	if !a.onWorking() {
+		return ""
+	}
+	s := a.gitState
+	switch {
+	case s.WorkingBehind > 0:
+		return fmt.Sprintf(StateDescriptions["workflow_behind"], s.WorkingBehind, s.Canon)
+	case s.WorkingAhead > 0:
+		return fmt.Sprintf(StateDescriptions["workflow_ahead"], s.WorkingAhead, s.Canon)
+	}
+	return fmt.Sprintf(StateDescriptions["workflow_even"], s.Canon)
+}
+
+// dispatchWorkflowUpdate merges canon into the working branch (same flow as "Merge from...")
+func (a *Application) dispatchWorkflowUpdate(app *Application) tea.Cmd {
+	app.workflowState.PreviousMode = app.mode
+	_, cmd := app.handleMergeBranchSelection(app.gitState.Canon)
+	return cmd
+}
+
+// dispatchWorkflowDeliver asks before fast-forwarding canon to the working branch (and pushing it)
+func (a *Application) dispatchWorkflowDeliver(app *Application) tea.Cmd {
+	s := app.gitState
+	msg := ConfirmationMessages["workflow_deliver_local"]
+	if s.Remote == git.HasRemote {
+		msg = ConfirmationMessages[string(ConfirmWorkflowDeliver)]
+	}
+	app.workflowState.PreviousMode = app.mode
+	app.mode = ModeConfirmation
+	dialog := ui.NewConfirmationDialog(
+		ui.ConfirmationConfig{
+			Title:       fmt.Sprintf(msg.Title, s.Working, s.Canon),
+			Explanation: fmt.Sprintf(msg.Explanation, s.Canon, s.WorkingAhead, s.Working),
+			YesLabel:    msg.YesLabel,
+			NoLabel:     msg.NoLabel,
+			ActionID:    string(ConfirmWorkflowDeliver),
+		},
+		app.sizing.ContentInnerWidth,
+		&app.theme,
+	)
+	app.dialogState.Show(dialog, nil)
+	return nil
+}
+
+// executeConfirmWorkflowDeliver starts the delivery
+func (a *Application) executeConfirmWorkflowDeliver() (tea.Model, tea.Cmd) {
+	s := a.gitState
+	a.dialogState.Hide()
+	a.prepareAsyncOperation(fmt.Sprintf(OutputMessages["workflow_delivering"], s.Working, s.Canon))
+	return a, a.cmdDeliver(s.Canon, s.Working, s.Remote == git.HasRemote)
+}
+
+// executeRejectWorkflowDeliver abandons the delivery
+func (a *Application) executeRejectWorkflowDeliver() (tea.Model, tea.Cmd) {
+	a.dialogState.Hide()
+	return a.returnToMenu()
+}
+
+// cmdDeliver fast-forwards canon to working, pushes canon when there is a remote,
+// and always switches back to working. Canon is never merged into here, so delivery
+// cannot conflict: a working branch behind canon must be updated first.
+func (a *Application) cmdDeliver(canon, working string, push bool) tea.Cmd {
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancelContext = cancel
+	return func() tea.Msg {
+		buffer := ui.GetBuffer()
+
+		if result := git.ExecuteWithStreaming(ctx, "checkout", canon); !result.Success {
+			return GitOperationMsg{Step: OpDeliver, Success: false, Error: fmt.Sprintf(ErrorMessages["failed_checkout_branch"], canon)}
+		}
+
+		failure := ""
+		if result := git.ExecuteWithStreaming(ctx, "merge", "--ff-only", working); !result.Success {
+			failure = fmt.Sprintf(ErrorMessages["workflow_deliver_not_ff"], canon, working)
+		} else if push {
+			if result := git.ExecuteWithStreaming(ctx, "push", "--progress", "-u", "origin", canon); !result.Success {
+				failure = fmt.Sprintf(ErrorMessages["workflow_push_failed"], canon)
+			}
+		}
+
+		if result := git.ExecuteWithStreaming(ctx, "checkout", working); !result.Success {
+			buffer.Append(fmt.Sprintf(ErrorMessages["failed_checkout_branch"], working), ui.TypeStderr)
+		}
+		if failure != "" {
+			return GitOperationMsg{Step: OpDeliver, Success: false, Error: failure}
+		}
+
+		return GitOperationMsg{
+			Step:    OpDeliver,
+			Success: true,
+			Output:  fmt.Sprintf(OutputMessages["workflow_delivered"], working, canon),
+		}
+	}
+}
+
+// ========================================
+// Canon protection gates
+// ========================================
+
+// continueCommit resumes a commit workflow after the canon gate (large binary warning, then input)
+func (a *Application) continueCommit(action string) tea.Cmd {
+	if a.warnLargeBinaries(action, action == "commit_staged") {
+		return nil
+	}
+	return largeBinaryContinuations[action](a)
+}
+
+// guardCanonCommit asks before committing directly to canon. Returns true if the dialog was shown.
+func (a *Application) guardCanonCommit(action string) bool {
+	if !a.onCanon() {
+		return false
+	}
+	a.showCanonGate(ConfirmCanonCommit, map[string]string{"action": action})
+	return true
+}
+
+// showCanonGate shows a canon protection dialog, defaulting to No
+func (a *Application) showCanonGate(kind ConfirmationType, ctx map[string]string) {
+	msg := ConfirmationMessages[string(kind)]
+	a.workflowState.PreviousMode = a.mode
+	a.mode = ModeConfirmation
+	dialog := ui.NewConfirmationDialog(
+		ui.ConfirmationConfig{
+			Title:       fmt.Sprintf(msg.Title, a.gitState.Canon),
+			Explanation: fmt.Sprintf(msg.Explanation, a.gitState.Canon, a.gitState.Working),
+			YesLabel:    msg.YesLabel,
+			NoLabel:     msg.NoLabel,
+			ActionID:    string(kind),
+		},
+		a.sizing.ContentInnerWidth,
+		&a.theme,
+	)
+	a.dialogState.Show(dialog, ctx)
+	dialog.SelectNo()
+}
+
+// executeConfirmCanonCommit continues the commit on canon
+func (a *Application) executeConfirmCanonCommit() (tea.Model, tea.Cmd) {
+	action := a.dialogState.context["action"]
+	a.dialogState.Hide()
+	if _, ok := largeBinaryContinuations[action]; !ok {
+		return a.returnToMenu()
+	}
+	return a, a.continueCommit(action)
+}
+
+// executeRejectCanonCommit abandons the commit
+func (a *Application) executeRejectCanonCommit() (tea.Model, tea.Cmd) {
+	a.dialogState.Hide()
+	return a.returnToMenu()
+}
+
+// executeConfirmCanonForcePush moves on to the regular force push confirmation
+func (a *Application) executeConfirmCanonForcePush() (tea.Model, tea.Cmd) {
+	a.dialogState.Hide()
+	a.showForcePushConfirmation()
+	return a, nil
+}
+
+// executeRejectCanonForcePush abandons the force push
+func (a *Application) executeRejectCanonForcePush() (tea.Model, tea.Cmd) {
+	a.dialogState.Hide()
+	return a.returnToMenu()
+}
+
+// ========================================
+// Config: set canon and working branches
+// ========================================
+
+// dispatchConfigWorkflow asks for the canon branch, then the working branch
+func (a *Application) dispatchConfigWorkflow(app *Application) tea.Cmd {
+	canon := app.workflow().Canon
+	if canon == "" {
+		canon = git.CanonBranch()
+	}
+	app.transitionTo(ModeTransition{
+		Mode:        ModeInput,
+		InputPrompt: InputMessages["workflow_canon"].Prompt,
+		InputAction: "workflow_canon",
+		FooterHint:  InputMessages["workflow_canon"].Hint,
+	})
+	app.inputState.ReplaceValue(canon)
+	return nil
+}
+
+// handleWorkflowCanonSubmit validates the canon branch and asks for the working branch
+func (a *Application) handleWorkflowCanonSubmit(app *Application) (tea.Model, tea.Cmd) {
+	canon := strings.TrimSpace(app.inputState.Value)
+	if canon == "" {
+		app.footerHint = ErrorMessages["branch_name_empty"]
+		return app, nil
+	}
+	if !git.Execute("rev-parse", "--verify", "--quiet", "refs/heads/"+canon).Success {
+		app.footerHint = fmt.Sprintf(ErrorMessages["workflow_canon_missing"], canon)
+		return app, nil
+	}
+	app.workflowState.WorkflowCanon = canon
+
+	working := app.workflow().Working
+	if working == "" && app.gitState != nil && app.gitState.CurrentBranch != canon && !app.gitState.Detached {
+		working = app.gitState.CurrentBranch
+	}
+	app.transitionTo(ModeTransition{
+		Mode:        ModeInput,
+		InputPrompt: fmt.Sprintf(InputMessages["workflow_working"].Prompt, canon),
+		InputAction: "workflow_working",
+		FooterHint:  InputMessages["workflow_working"].Hint,
+	})
+	app.inputState.ReplaceValue(working)
+	return app, nil
+}
+
+// handleWorkflowWorkingSubmit saves the policy, creating the working branch from canon if needed.
+// An empty working branch keeps canon only (no gates, no deliver/update).
+func (a *Application) handleWorkflowWorkingSubmit(app *Application) (tea.Model, tea.Cmd) {
+	canon := app.workflowState.WorkflowCanon
+	working := strings.TrimSpace(app.inputState.Value)
+	if working == canon {
+		app.footerHint = ErrorMessages["workflow_same_branch"]
+		return app, nil
+	}
+	if working != "" {
+		if !git.Execute("check-ref-format", "--branch", working).Success {
+			app.footerHint = fmt.Sprintf(ErrorMessages["branch_name_invalid"], working)
+			return app, nil
+		}
+		if !git.Execute("rev-parse", "--verify", "--quiet", "refs/heads/"+working).Success {
+			if result := git.Execute("branch", working, canon); !result.Success {
+				app.footerHint = result.Stderr
+				return app, nil
+			}
+		}
+	}
+
+	if err := git.SaveWorkflow(git.BranchWorkflow{Canon: canon, Working: working}); err != nil {
+		app.footerHint = fmt.Sprintf(ErrorMessages["workflow_save_failed"], err)
+		return app, nil
+	}
+	app.workflowState.WorkflowCanon = ""
+	if err := app.reloadGitState(); err != nil {
+		app.footerHint = fmt.Sprintf(ErrorMessages["failed_detect_state"], err)
+		return app, nil
+	}
+
+	model, cmd := app.returnToMenu()
+	if working == "" {
+		app.footerHint = fmt.Sprintf(ConsoleMessages["workflow_canon_only"], canon)
+	} else {
+		app.footerHint = fmt.Sprintf(ConsoleMessages["workflow_saved"], canon, working)
+	}
+	return model, cmd
+}
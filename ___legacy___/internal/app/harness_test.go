@@ -0,0 +1,416 @@
+package app
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jrengmusic/tit/internal/git"
+	"github.com/jrengmusic/tit/internal/ui"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ========================================
+// Integration Harness
+// ========================================
+//
+// The harness builds throwaway repositories under t.TempDir():
+//   - remote.git: bare repository acting as origin
+//   - work:       the repository TIT operates on (process cwd)
+//   - peer:       second clone used to publish upstream commits
+//
+// CONTRACT: TIT resolves every git call against the process cwd, so harness
+// tests must never call t.Parallel(). HOME is redirected to the temp dir so
+// stash tracking, themes and git identity never touch the real user config.
+
+// harnessTimeout bounds a single pump of async commands
+const harnessTimeout = 30 * time.Second
+
+// testRepo holds paths of one scripted scenario
+type testRepo struct {
+	t      *testing.T
+	root   string
+	work   string
+	remote string
+	peer   string
+}
+
+// newTestRepo creates an isolated HOME plus a work repository with one commit on main
+func newTestRepo(t *testing.T) *testRepo {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git binary not available")
+	}
+
+	root := t.TempDir()
+	home := filepath.Join(root, "home")
+	sshDir := filepath.Join(home, ".ssh")
+	if err := os.MkdirAll(sshDir, 0700); err != nil {
+		t.Fatalf("mkdir ssh dir: %v", err)
+	}
+	// DetectGitEnvironment only checks that a key pair exists
+	if err := os.WriteFile(filepath.Join(sshDir, "id_ed25519"), []byte("harness"), 0600); err != nil {
+		t.Fatalf("write fake key: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(sshDir, "id_ed25519.pub"), []byte("ssh-ed25519 AAAAharness harness@example.com\n"), 0644); err != nil {
+		t.Fatalf("write fake public key: %v", err)
+	}
+
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, ".config"))
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+	t.Setenv("GIT_AUTHOR_NAME", "Harness")
+	t.Setenv("GIT_AUTHOR_EMAIL", "harness@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Harness")
+	t.Setenv("GIT_COMMITTER_EMAIL", "harness@example.com")
+	t.Setenv("TIT_TEST_SETUP", "")
+
+	r := &testRepo{
+		t:      t,
+		root:   root,
+		work:   filepath.Join(root, "work"),
+		remote: filepath.Join(root, "remote.git"),
+		peer:   filepath.Join(root, "peer"),
+	}
+
+	r.gitIn(root, "init", "-q", "-b", DefaultBranch, r.work)
+	r.write("README.md", "hello\n")
+	r.git("add", "-A")
+	r.git("commit", "-q", "-m", "initial")
+
+	t.Chdir(r.work)
+	return r
+}
+
+// withRemote creates the bare origin, pushes main and clones the peer
+func (r *testRepo) withRemote() *testRepo {
+	r.t.Helper()
+	r.gitIn(r.root, "init", "-q", "--bare", "-b", DefaultBranch, r.remote)
+	r.git("remote", "add", "origin", r.remote)
+	r.git("push", "-q", "-u", "origin", DefaultBranch)
+	r.gitIn(r.root, "clone", "-q", r.remote, r.peer)
+	return r
+}
+
+// gitIn runs git in dir and fails the test on error
+func (r *testRepo) gitIn(dir string, args ...string) string {
+	r.t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		r.t.Fatalf("git %s (in %s): %v\n%s", strings.Join(args, " "), dir, err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// git runs git in the work repository
+func (r *testRepo) git(args ...string) string {
+	r.t.Helper()
+	return r.gitIn(r.work, args...)
+}
+
+// write creates or replaces a file in the work repository
+func (r *testRepo) write(path, content string) {
+	r.t.Helper()
+	r.writeIn(r.work, path, content)
+}
+
+// writeIn creates or replaces a file relative to dir
+func (r *testRepo) writeIn(dir, path, content string) {
+	r.t.Helper()
+	full := filepath.Join(dir, path)
+	if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+		r.t.Fatalf("mkdir %s: %v", path, err)
+	}
+	if err := os.WriteFile(full, []byte(content), 0644); err != nil {
+		r.t.Fatalf("write %s: %v", path, err)
+	}
+}
+
+// commit writes a file in the work repository and commits it
+func (r *testRepo) commit(path, content, message string) {
+	r.t.Helper()
+	r.write(path, content)
+	r.git("add", "-A")
+	r.git("commit", "-q", "-m", message)
+}
+
+// publish commits a file from the peer clone and pushes it to origin
+func (r *testRepo) publish(path, content, message string) {
+	r.t.Helper()
+	r.writeIn(r.peer, path, content)
+	r.gitIn(r.peer, "add", "-A")
+	r.gitIn(r.peer, "commit", "-q", "-m", message)
+	r.gitIn(r.peer, "push", "-q", "origin", DefaultBranch)
+}
+
+// read returns the content of a file in the work repository
+func (r *testRepo) read(path string) string {
+	r.t.Helper()
+	data, err := os.ReadFile(filepath.Join(r.work, path))
+	if err != nil {
+		r.t.Fatalf("read %s: %v", path, err)
+	}
+	return string(data)
+}
+
+// ========================================
+// Model Driver
+// ========================================
+
+// harness drives an Application the same way tea.Program would
+type harness struct {
+	t   *testing.T
+	app *Application
+}
+
+// newHarness builds the Application for the current cwd and runs Init to completion
+func newHarness(t *testing.T) *harness {
+	t.Helper()
+	// nil config keeps auto-update disabled so no minute-long ticks are scheduled
+	h := &harness{t: t, app: NewApplication(ui.CalculateDynamicSizing(80, 40), harnessTheme(t), nil, git.NewExecBackend())}
+	h.run(h.app.Init())
+	return h
+}
+
+// newDashboardHarness builds the `tit dash` Application over repos and runs Init to completion
+func newDashboardHarness(t *testing.T, repos []string) *harness {
+	t.Helper()
+	h := &harness{t: t, app: NewDashboardApplication(ui.CalculateDynamicSizing(80, 40), harnessTheme(t), nil, git.NewExecBackend(), repos)}
+	h.run(h.app.Init())
+	return h
+}
+
+// harnessTheme creates and loads the default theme under the redirected HOME
+func harnessTheme(t *testing.T) ui.Theme {
+	t.Helper()
+	if _, err := ui.CreateDefaultThemeIfMissing(); err != nil {
+		t.Fatalf("create default theme: %v", err)
+	}
+	theme, err := ui.LoadDefaultTheme()
+	if err != nil {
+		t.Fatalf("load default theme: %v", err)
+	}
+	return theme
+}
+
+// isPeriodicMsg reports messages that only drive animation or timeouts.
+// The harness drops them so self-rescheduling tickers terminate.
+func isPeriodicMsg(msg tea.Msg) bool {
+	switch msg.(type) {
+	case OutputRefreshMsg, CacheRefreshTickMsg, StartupSpinnerMsg,
+		AutoUpdateTickMsg, AutoUpdateAnimationMsg, TickMsg, ClearTickMsg:
+		return true
+	}
+	return false
+}
+
+// run executes cmd and every command it produces, feeding results into Update
+// until no work is outstanding. Commands run concurrently like in tea.Program,
+// but Update is only ever called from the test goroutine.
+func (h *harness) run(cmd tea.Cmd) {
+	h.t.Helper()
+	results := make(chan tea.Msg)
+	pending := 0
+
+	var launch func(c tea.Cmd)
+	launch = func(c tea.Cmd) {
+		if c == nil {
+			return
+		}
+		pending++
+		go func() { results <- c() }()
+	}
+	launch(cmd)
+
+	deadline := time.After(harnessTimeout)
+	for pending > 0 {
+		select {
+		case msg := <-results:
+			pending--
+			switch m := msg.(type) {
+			case nil:
+			case tea.BatchMsg:
+				for _, c := range m {
+					launch(c)
+				}
+			default:
+				if isPeriodicMsg(m) {
+					continue
+				}
+				model, next := h.app.Update(m)
+				h.app = model.(*Application) // The dashboard swaps in a new Application per repository
+				launch(next)
+			}
+		case <-deadline:
+			h.t.Fatalf("harness: %d command(s) still pending after %s (mode=%s)", pending, harnessTimeout, GetModeMetadata(h.app.mode).Name)
+		}
+	}
+}
+
+// press sends a key through Update and runs the resulting commands
+func (h *harness) press(key string) {
+	h.t.Helper()
+	var msg tea.KeyMsg
+	switch key {
+	case "enter":
+		msg = tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		msg = tea.KeyMsg{Type: tea.KeyEsc}
+	case "tab":
+		msg = tea.KeyMsg{Type: tea.KeyTab}
+	case "left":
+		msg = tea.KeyMsg{Type: tea.KeyLeft}
+	case "right":
+		msg = tea.KeyMsg{Type: tea.KeyRight}
+	case "up":
+		msg = tea.KeyMsg{Type: tea.KeyUp}
+	case "down":
+		msg = tea.KeyMsg{Type: tea.KeyDown}
+	case " ":
+		msg = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+	case "backspace":
+		msg = tea.KeyMsg{Type: tea.KeyBackspace}
+	case "ctrl+o":
+		msg = tea.KeyMsg{Type: tea.KeyCtrlO}
+	default:
+		msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+	}
+	model, cmd := h.app.Update(msg)
+	h.app = model.(*Application)
+	h.run(cmd)
+}
+
+// dispatch triggers a menu action by ID, failing if it is not offered and enabled
+func (h *harness) dispatch(id string) {
+	h.t.Helper()
+	if !h.hasMenuItem(id) {
+		h.t.Fatalf("menu item %q not available; menu=%v", id, h.menuIDs())
+	}
+	h.run(h.app.dispatchAction(id))
+}
+
+// confirm answers the active confirmation dialog
+func (h *harness) confirm(yes bool) {
+	h.t.Helper()
+	if h.app.mode != ModeConfirmation || h.app.dialogState.dialog == nil {
+		h.t.Fatalf("expected confirmation dialog, mode=%s", GetModeMetadata(h.app.mode).Name)
+	}
+	_, cmd := h.app.handleConfirmationResponse(yes)
+	h.run(cmd)
+}
+
+// submitInput fills the active input field and submits it
+func (h *harness) submitInput(value string) {
+	h.t.Helper()
+	if !h.app.isInputMode() {
+		h.t.Fatalf("expected input mode, mode=%s", GetModeMetadata(h.app.mode).Name)
+	}
+	h.app.inputState.Value = value
+	h.app.inputState.CursorPosition = len(value)
+	h.press("enter")
+}
+
+// backToMenu leaves the console the way a user does after an operation completes
+func (h *harness) backToMenu() {
+	h.t.Helper()
+	if h.app.mode == ModeMenu {
+		return
+	}
+	h.press("esc")
+	if h.app.mode != ModeMenu {
+		h.t.Fatalf("expected menu after ESC, mode=%s", GetModeMetadata(h.app.mode).Name)
+	}
+}
+
+// console returns the console output buffer as text, one line per entry
+func (h *harness) console() string {
+	var lines []string
+	for _, line := range ui.GetBuffer().GetAllLines() {
+		lines = append(lines, line.Text)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// menuIDs lists selectable item IDs of the current menu
+func (h *harness) menuIDs() []string {
+	var ids []string
+	for _, item := range h.app.menuItems {
+		if !item.Separator {
+			ids = append(ids, item.ID)
+		}
+	}
+	return ids
+}
+
+// hasMenuItem reports whether id is present and enabled in the current menu
+func (h *harness) hasMenuItem(id string) bool {
+	for _, item := range h.app.menuItems {
+		if item.ID == id && !item.Separator && item.Enabled {
+			return true
+		}
+	}
+	return false
+}
+
+// wantState is the expected 5-axis snapshot; empty fields are not checked
+type wantState struct {
+	WorkingTree git.WorkingTree
+	Timeline    git.Timeline
+	Operation   git.Operation
+	Remote      git.Remote
+}
+
+// assertState compares both the model's state and a fresh detection against want
+func (h *harness) assertState(want wantState) {
+	h.t.Helper()
+	h.compareState("model", h.app.gitState, want)
+	h.assertDetected(want)
+}
+
+// assertDetected compares a fresh DetectState against want, ignoring the model
+func (h *harness) assertDetected(want wantState) {
+	h.t.Helper()
+	fresh, err := git.DetectState()
+	if err != nil {
+		h.t.Fatalf("DetectState: %v", err)
+	}
+	h.compareState("detected", fresh, want)
+}
+
+// compareState reports every axis of got that differs from a non-empty axis of want
+func (h *harness) compareState(label string, got *git.State, want wantState) {
+	h.t.Helper()
+	if want.WorkingTree != "" && got.WorkingTree != want.WorkingTree {
+		h.t.Errorf("%s WorkingTree: got %q, want %q", label, got.WorkingTree, want.WorkingTree)
+	}
+	if want.Timeline != "" && got.Timeline != want.Timeline {
+		h.t.Errorf("%s Timeline: got %q, want %q", label, got.Timeline, want.Timeline)
+	}
+	if want.Operation != "" && got.Operation != want.Operation {
+		h.t.Errorf("%s Operation: got %q, want %q", label, got.Operation, want.Operation)
+	}
+	if want.Remote != "" && got.Remote != want.Remote {
+		h.t.Errorf("%s Remote: got %q, want %q", label, got.Remote, want.Remote)
+	}
+}
+
+// assertMenu checks that every wanted ID is offered and every unwanted ID is absent
+func (h *harness) assertMenu(want []string, notWant []string) {
+	h.t.Helper()
+	for _, id := range want {
+		if !h.hasMenuItem(id) {
+			h.t.Errorf("menu missing %q; menu=%v", id, h.menuIDs())
+		}
+	}
+	for _, id := range notWant {
+		if h.hasMenuItem(id) {
+			h.t.Errorf("menu unexpectedly offers %q; menu=%v", id, h.menuIDs())
+		}
+	}
+}
@@ -159,7 +159,10 @@ func (a *Application) cmdPreloadFileHistoryDiffs() tea.Cmd {
 			// Cache both diff versions for each file in this commit
 			for _, file := range files {
 				// Version 1: Commit vs parent (Clean state)
-				parentDiff, err := git.GetCommitDiff(commit.Hash, file.Path, "parent")
+				parentDiff, binary, err := git.GetCommitDiff(commit.Hash, file.Path, "parent")
+				if binary != nil {
+					parentDiff = binaryDiffContent(*binary)
+				}
 				if err == nil {
 					// Thread-safe: cache the diff
 					key := DiffCacheKey(commit.Hash, file.Path, "parent")
@@ -167,7 +170,10 @@ func (a *Application) cmdPreloadFileHistoryDiffs() tea.Cmd {
 				}
 
 				// Version 2: Commit vs working tree (Modified state)
-				wipDiff, err := git.GetCommitDiff(commit.Hash, file.Path, "wip")
+				wipDiff, binary, err := git.GetCommitDiff(commit.Hash, file.Path, "wip")
+				if binary != nil {
+					wipDiff = binaryDiffContent(*binary)
+				}
 				if err == nil {
 					// Thread-safe: cache the diff
 					key := DiffCacheKey(commit.Hash, file.Path, "wip")
@@ -236,6 +242,8 @@ func (a *Application) invalidateHistoryCaches() tea.Cmd {
 		DiffLineCursor:    0,
 		VisualModeActive:  false,
 		VisualModeStart:   0,
+		SplitDiff:         a.splitDiff(),
+		SyntaxHighlight:   a.syntaxHighlight(),
 	}
 
 	// CONTRACT: Restart loading for ALL states (Normal, TimeTraveling, etc.)
@@ -0,0 +1,1323 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jrengmusic/tit/internal/config"
+	"github.com/jrengmusic/tit/internal/git"
+	"github.com/jrengmusic/tit/internal/ui"
+)
+
+func TestIntegration_StartupNoRemote(t *testing.T) {
+	newTestRepo(t)
+	h := newHarness(t)
+
+	if h.app.mode != ModeMenu {
+		t.Fatalf("mode: got %s, want menu", GetModeMetadata(h.app.mode).Name)
+	}
+	h.assertState(wantState{WorkingTree: git.Clean, Operation: git.Normal, Remote: git.NoRemote})
+	h.assertMenu([]string{"history", "file_history", "add_remote"}, []string{"commit", "push", "pull_merge"})
+}
+
+func TestIntegration_CommitDirtyTree(t *testing.T) {
+	r := newTestRepo(t)
+	r.write("notes.txt", "draft\n")
+	h := newHarness(t)
+
+	h.assertState(wantState{WorkingTree: git.Dirty, Operation: git.Normal})
+	h.assertMenu([]string{"commit", "reset_discard_changes"}, []string{"commit_push"})
+
+	h.dispatch("commit")
+	h.submitInput("add notes")
+	h.backToMenu()
+
+	h.assertState(wantState{WorkingTree: git.Clean, Operation: git.Normal})
+	if got := r.git("log", "-1", "--format=%s"); got != "add notes" {
+		t.Errorf("last commit subject: got %q, want %q", got, "add notes")
+	}
+}
+
+func TestIntegration_CommitStagedOnly(t *testing.T) {
+	r := newTestRepo(t)
+	r.write("staged.txt", "ready\n")
+	r.write("README.md", "hello\nwork in progress\n")
+	r.write("scratch.txt", "later\n")
+	r.git("add", "staged.txt")
+	h := newHarness(t)
+
+	want := git.ChangeCounts{Staged: 1, Unstaged: 1, Untracked: 1}
+	if got := h.app.gitState.Changes; got != want {
+		t.Errorf("changes: got %+v, want %+v", got, want)
+	}
+	if got := workingTreeBreakdown(h.app.gitState.Changes); got != "1 staged · 1 unstaged · 1 untracked" {
+		t.Errorf("header breakdown: got %q", got)
+	}
+	h.assertMenu([]string{"commit", "commit_staged"}, nil)
+
+	h.dispatch("commit_staged")
+	h.submitInput("staged only")
+	h.backToMenu()
+
+	h.assertState(wantState{WorkingTree: git.Dirty, Operation: git.Normal})
+	if got := r.git("show", "--name-only", "--format=%s", "HEAD"); got != "staged only\n\nstaged.txt" {
+		t.Errorf("last commit: got %q, want only staged.txt", got)
+	}
+	want = git.ChangeCounts{Unstaged: 1, Untracked: 1}
+	if got := h.app.gitState.Changes; got != want {
+		t.Errorf("changes after commit: got %+v, want %+v", got, want)
+	}
+	h.assertMenu([]string{"commit"}, []string{"commit_staged"})
+}
+
+func TestIntegration_UntrackedTriage(t *testing.T) {
+	r := newTestRepo(t)
+	r.write("a.log", "a\n")
+	r.write("b.log", "b\n")
+	r.write("build/out.o", "obj\n")
+	r.write("notes.txt", "todo\n")
+	h := newHarness(t)
+
+	h.assertMenu([]string{"untracked_triage"}, nil)
+	h.dispatch("untracked_triage")
+	if h.app.mode != ModeUntrackedTriage {
+		t.Fatalf("mode: got %s, want untracked_triage", GetModeMetadata(h.app.mode).Name)
+	}
+
+	// selectRow moves the cursor down from the top until the row with path is selected
+	selectRow := func(path string, ignored bool) {
+		t.Helper()
+		triage := h.app.pickerState.Untracked
+		for triage.SelectedIdx > 0 {
+			h.press("k")
+		}
+		for {
+			if row, _ := triage.Selected(); row.Path == path && row.Ignored == ignored {
+				return
+			}
+			if triage.SelectedIdx == len(triage.Rows)-1 {
+				t.Fatalf("row %q not found in %+v", path, triage.Rows)
+			}
+			h.press("j")
+		}
+	}
+
+	selectRow("*.log", false)
+	if got := strings.Join(h.app.pickerState.Untracked.Details, "\n"); !strings.Contains(got, "matches 2 file(s)") {
+		t.Errorf("pattern preview missing match count:\n%s", got)
+	}
+	h.press("i")
+	if got := r.read(".gitignore"); got != "*.log\n" {
+		t.Errorf(".gitignore: got %q", got)
+	}
+
+	selectRow("/build/", false)
+	h.press("x")
+	if got := r.read(".git/info/exclude"); !strings.HasSuffix(got, "/build/\n") {
+		t.Errorf("exclude: got %q", got)
+	}
+
+	selectRow("notes.txt", false)
+	h.press("a")
+
+	selectRow("a.log", true)
+	if got := strings.Join(h.app.pickerState.Untracked.Details, "\n"); !strings.Contains(got, ".gitignore:1") {
+		t.Errorf("check-ignore explanation missing:\n%s", got)
+	}
+
+	h.backToMenu()
+	want := git.ChangeCounts{Staged: 1, Untracked: 1} // notes.txt staged, .gitignore new
+	if got := h.app.gitState.Changes; got != want {
+		t.Errorf("changes after triage: got %+v, want %+v", got, want)
+	}
+}
+
+func TestIntegration_CommitWarnsLargeBinaries(t *testing.T) {
+	r := newTestRepo(t)
+	large := string(make([]byte, git.LFSLargeFileThreshold+1)) // NUL bytes: binary
+	r.write("video.bin", large)
+	h := newHarness(t)
+
+	h.dispatch("commit")
+	if h.app.mode != ModeConfirmation || h.app.dialogState.dialog.Config.ActionID != string(ConfirmLFSLargeBinaries) {
+		t.Fatalf("expected large binary warning, mode=%s", GetModeMetadata(h.app.mode).Name)
+	}
+	h.confirm(false)
+	if h.app.mode != ModeMenu {
+		t.Fatalf("cancel: got mode %s, want menu", GetModeMetadata(h.app.mode).Name)
+	}
+
+	h.dispatch("commit")
+	h.confirm(true)
+	h.submitInput("add video")
+	h.backToMenu()
+	h.assertState(wantState{WorkingTree: git.Clean, Operation: git.Normal})
+
+	// Files routed through LFS are not flagged
+	r.write(".gitattributes", "*.psd filter=lfs diff=lfs merge=lfs -text\n")
+	r.write("art.psd", large)
+	h = newHarness(t)
+	h.dispatch("commit")
+	if !h.app.isInputMode() {
+		t.Errorf("LFS-tracked binary: got mode %s, want commit input", GetModeMetadata(h.app.mode).Name)
+	}
+}
+
+func TestIntegration_PushAhead(t *testing.T) {
+	r := newTestRepo(t).withRemote()
+	r.commit("a.txt", "a\n", "local work")
+	h := newHarness(t)
+
+	h.assertState(wantState{WorkingTree: git.Clean, Timeline: git.Ahead, Remote: git.HasRemote})
+	h.assertMenu([]string{"push", "force_push"}, []string{"pull_merge", "add_remote"})
+
+	h.dispatch("push")
+	h.backToMenu()
+
+	h.assertState(wantState{Timeline: git.InSync})
+	h.assertMenu(nil, []string{"push", "pull_merge"})
+}
+
+func TestIntegration_PullMergeBehind(t *testing.T) {
+	r := newTestRepo(t).withRemote()
+	r.publish("upstream.txt", "remote\n", "remote work")
+	h := newHarness(t)
+
+	// Startup fetch (RemoteFetchMsg) must reveal the upstream commit
+	h.assertState(wantState{WorkingTree: git.Clean, Timeline: git.Behind})
+	h.assertMenu([]string{"pull_merge", "replace_local"}, []string{"dirty_pull_merge", "push"})
+
+	h.dispatch("pull_merge")
+	h.confirm(true)
+	h.backToMenu()
+
+	h.assertState(wantState{WorkingTree: git.Clean, Timeline: git.InSync, Operation: git.Normal})
+	if got := r.read("upstream.txt"); got != "remote\n" {
+		t.Errorf("upstream.txt: got %q, want %q", got, "remote\n")
+	}
+}
+
+func TestIntegration_DirtyPullPreservesChanges(t *testing.T) {
+	r := newTestRepo(t).withRemote()
+	r.publish("upstream.txt", "remote\n", "remote work")
+	r.write("README.md", "hello\nlocal edit\n")
+	h := newHarness(t)
+
+	h.assertState(wantState{WorkingTree: git.Dirty, Timeline: git.Behind})
+	h.assertMenu([]string{"dirty_pull_merge", "commit"}, []string{"pull_merge"})
+
+	h.dispatch("dirty_pull_merge")
+	h.confirm(true) // Save changes
+	h.backToMenu()
+
+	h.assertState(wantState{WorkingTree: git.Dirty, Timeline: git.InSync, Operation: git.Normal})
+	if got := r.read("README.md"); got != "hello\nlocal edit\n" {
+		t.Errorf("README.md: got %q, want local edit preserved", got)
+	}
+	if got := r.read("upstream.txt"); got != "remote\n" {
+		t.Errorf("upstream.txt: got %q, want %q", got, "remote\n")
+	}
+	if git.FileExists(".git/TIT_DIRTY_OP") {
+		t.Error("dirty operation marker left behind")
+	}
+}
+
+func TestIntegration_PushAutoSyncDiverged(t *testing.T) {
+	r := newTestRepo(t).withRemote()
+	r.publish("upstream.txt", "remote\n", "remote work")
+	r.commit("local.txt", "local\n", "local work")
+	h := newHarness(t)
+
+	h.assertState(wantState{WorkingTree: git.Clean, Timeline: git.Diverged})
+	h.assertMenu([]string{"push_auto_sync", "pull_merge_diverged", "force_push"}, []string{"push"})
+
+	h.dispatch("push_auto_sync")
+	h.backToMenu()
+
+	h.assertState(wantState{Timeline: git.InSync, Operation: git.Normal})
+	remoteLog := r.gitIn(r.remote, "log", "--format=%s", DefaultBranch)
+	for _, subject := range []string{"remote work", "local work"} {
+		if !strings.Contains(remoteLog, subject) {
+			t.Errorf("remote log missing %q:\n%s", subject, remoteLog)
+		}
+	}
+}
+
+func TestIntegration_PullConflictResolve(t *testing.T) {
+	r := newTestRepo(t).withRemote()
+	r.publish("README.md", "remote line\n", "remote edit")
+	r.commit("README.md", "local line\n", "local edit")
+	h := newHarness(t)
+
+	h.assertState(wantState{Timeline: git.Diverged})
+
+	h.dispatch("pull_merge_diverged")
+	h.confirm(true)
+
+	if h.app.mode != ModeConflictResolve {
+		t.Fatalf("mode: got %s, want conflict resolver", GetModeMetadata(h.app.mode).Name)
+	}
+	// The resolver works from the pre-merge model; only the repository is conflicted
+	h.assertDetected(wantState{Operation: git.Conflicted})
+	if n := len(h.app.conflictResolveState.Files); n != 1 {
+		t.Fatalf("conflicted files: got %d, want 1", n)
+	}
+
+	// Mark LOCAL (column 1) and continue
+	h.press("tab")
+	h.press(" ")
+	h.press("enter")
+	h.backToMenu()
+
+	h.assertState(wantState{WorkingTree: git.Clean, Timeline: git.Ahead, Operation: git.Normal})
+	if got := strings.TrimSpace(r.read("README.md")); got != "local line" {
+		t.Errorf("README.md: got %q, want local version", got)
+	}
+}
+
+func TestIntegration_PullConflictAbort(t *testing.T) {
+	r := newTestRepo(t).withRemote()
+	r.publish("README.md", "remote line\n", "remote edit")
+	r.commit("README.md", "local line\n", "local edit")
+	head := r.git("rev-parse", "HEAD")
+	h := newHarness(t)
+
+	h.dispatch("pull_merge_diverged")
+	h.confirm(true)
+	if h.app.mode != ModeConflictResolve {
+		t.Fatalf("mode: got %s, want conflict resolver", GetModeMetadata(h.app.mode).Name)
+	}
+
+	h.press("esc")
+	h.backToMenu()
+
+	h.assertState(wantState{WorkingTree: git.Clean, Timeline: git.Diverged, Operation: git.Normal})
+	if got := r.git("rev-parse", "HEAD"); got != head {
+		t.Errorf("HEAD moved after abort: got %s, want %s", got, head)
+	}
+}
+
+// travelTo opens History and time travels to the commit with the given subject
+// (a further hop when already time traveling)
+func (h *harness) travelTo(subject string) {
+	h.t.Helper()
+	h.selectInHistory(subject)
+	h.press("enter")
+	h.confirm(true)
+	h.backToMenu()
+}
+
+// selectInHistory opens History and selects the commit with the given subject
+// (leaving History first, so ESC still returns to the menu)
+func (h *harness) selectInHistory(subject string) {
+	h.t.Helper()
+	if h.app.mode == ModeHistory {
+		h.press("esc")
+	}
+	if h.app.gitState.Operation == git.TimeTraveling {
+		h.dispatch("time_travel_history")
+	} else {
+		h.dispatch("history")
+	}
+	if h.app.mode != ModeHistory {
+		h.t.Fatalf("mode: got %s, want history", GetModeMetadata(h.app.mode).Name)
+	}
+	idx := -1
+	for i, c := range h.app.pickerState.History.Commits {
+		if strings.HasPrefix(c.Subject, subject) {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		h.t.Fatalf("commit %q not found in history", subject)
+	}
+	h.app.pickerState.History.SelectedIdx = idx
+}
+
+func TestIntegration_TimeTravelReturn(t *testing.T) {
+	r := newTestRepo(t)
+	r.commit("b.txt", "b\n", "second")
+	head := r.git("rev-parse", "HEAD")
+	h := newHarness(t)
+
+	h.travelTo("initial")
+	h.assertState(wantState{Operation: git.TimeTraveling})
+	h.assertMenu([]string{"time_travel_history", "time_travel_return"}, []string{"commit", "history"})
+	if !git.FileExists(".git/TIT_TIME_TRAVEL") {
+		t.Fatal("time travel marker missing")
+	}
+
+	h.dispatch("time_travel_return")
+	h.confirm(true)
+	h.backToMenu()
+
+	h.assertState(wantState{WorkingTree: git.Clean, Operation: git.Normal})
+	if got := r.git("rev-parse", "HEAD"); got != head {
+		t.Errorf("HEAD after return: got %s, want %s", got, head)
+	}
+	if got := r.git("rev-parse", "--abbrev-ref", "HEAD"); got != DefaultBranch {
+		t.Errorf("branch after return: got %q, want %q", got, DefaultBranch)
+	}
+	if git.FileExists(".git/TIT_TIME_TRAVEL") {
+		t.Error("time travel marker left behind")
+	}
+}
+
+func TestIntegration_TimeTravelHopsAndBookmarks(t *testing.T) {
+	r := newTestRepo(t)
+	initial := r.git("rev-parse", "HEAD")
+	r.commit("b.txt", "b\n", "second")
+	second := r.git("rev-parse", "HEAD")
+	r.commit("c.txt", "c\n", "third")
+	third := r.git("rev-parse", "HEAD")
+	r.commit("d.txt", "d\n", "fourth")
+	head := r.git("rev-parse", "HEAD")
+	h := newHarness(t)
+
+	// Hopping while time traveling keeps the trail
+	h.travelTo("second")
+	h.assertMenu([]string{"time_travel_bookmark"}, []string{"time_travel_back"})
+	h.travelTo("initial")
+	marker, err := git.ReadTimeTravelMarker()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Join(marker.Hops, " "), second+" "+initial; got != want {
+		t.Fatalf("hops: got %s, want %s", got, want)
+	}
+	wantCrumb := DefaultBranch + " ▸ " + git.ShortenHash(second) + " ▸ " + git.ShortenHash(initial)
+	if got := h.app.timeTravelBreadcrumb(h.app.timeTravelState.info); got != wantCrumb {
+		t.Errorf("breadcrumb: got %q, want %q", got, wantCrumb)
+	}
+
+	// Bookmark a commit from the history browser
+	h.selectInHistory("third")
+	h.press("b")
+	h.submitInput("last known good build")
+	if h.app.mode != ModeHistory {
+		t.Fatalf("mode after bookmark: got %s, want history", GetModeMetadata(h.app.mode).Name)
+	}
+	h.press("esc")
+	h.assertMenu([]string{"bookmark:last known good build", "time_travel_back"}, nil)
+
+	// Back one hop drops the current commit from the trail
+	h.dispatch("time_travel_back")
+	h.backToMenu()
+	if got := r.git("rev-parse", "HEAD"); got != second {
+		t.Fatalf("HEAD after back: got %s, want %s", got, second)
+	}
+	h.assertMenu(nil, []string{"time_travel_back"})
+
+	// Quick travel to the bookmark is another hop, named in the breadcrumb
+	h.dispatch("bookmark:last known good build")
+	h.confirm(true)
+	h.backToMenu()
+	if got := r.git("rev-parse", "HEAD"); got != third {
+		t.Fatalf("HEAD after bookmark travel: got %s, want %s", got, third)
+	}
+	if got := h.app.timeTravelBreadcrumb(h.app.timeTravelState.info); !strings.HasSuffix(got, " ▸ last known good build") {
+		t.Errorf("breadcrumb: got %q, want bookmark name last", got)
+	}
+
+	// Return unwinds the whole session; bookmarks stay
+	h.dispatch("time_travel_return")
+	h.confirm(true)
+	h.backToMenu()
+	h.assertState(wantState{WorkingTree: git.Clean, Operation: git.Normal})
+	if got := r.git("rev-parse", "HEAD"); got != head {
+		t.Errorf("HEAD after return: got %s, want %s", got, head)
+	}
+	if git.FileExists(".git/TIT_TIME_TRAVEL") {
+		t.Error("time travel marker left behind")
+	}
+	h.assertMenu([]string{"bookmark:last known good build"}, nil)
+}
+
+func TestIntegration_TimeTravelRepoCommands(t *testing.T) {
+	r := newTestRepo(t)
+	initial := r.git("rev-parse", "HEAD")
+	r.commit("b.txt", "b\n", "second")
+	second := r.git("rev-parse", "HEAD")
+	r.commit(config.RepoConfigFile, "[[command]]\nname = \"build\"\nrun = \"test -f b.txt && echo built\"\n[[command]]\nname = \"artifact\"\nrun = \"touch out.bin\"\n", "add commands")
+	h := newHarness(t)
+
+	// The initial commit predates .tit.toml: commands come from the departure branch
+	h.travelTo("initial")
+	h.dispatch(RepoCommandActionPrefix + "build")
+	if h.app.mode != ModeConsole {
+		t.Fatalf("mode: got %s, want console", GetModeMetadata(h.app.mode).Name)
+	}
+	if out := h.console(); !strings.Contains(out, "build failed (exit 1)") {
+		t.Errorf("console missing failure summary:\n%s", out)
+	}
+	h.backToMenu()
+
+	h.travelTo("second")
+	h.dispatch(RepoCommandActionPrefix + "build")
+	if out := h.console(); !strings.Contains(out, "built") || !strings.Contains(out, "build passed") {
+		t.Errorf("console missing streamed output or pass summary:\n%s", out)
+	}
+	h.backToMenu()
+
+	records, err := git.LoadRunRecords()
+	if err != nil {
+		t.Fatal(err)
+	}
+	byHash := git.RunRecordsByHash(records)
+	if runs := byHash[initial]; len(runs) != 1 || runs[0].Passed {
+		t.Errorf("initial runs: got %+v, want one failed build", runs)
+	}
+	if runs := byHash[second]; len(runs) != 1 || !runs[0].Passed {
+		t.Errorf("second runs: got %+v, want one passed build", runs)
+	}
+
+	// History shows the recorded results
+	h.selectInHistory("second")
+	if runs := h.app.pickerState.History.Runs[second]; len(runs) != 1 || runs[0].Command != "build" {
+		t.Errorf("history runs: got %+v, want build", runs)
+	}
+
+	// Untracked output left by a run is reported: it makes the tree dirty
+	h.backToMenu()
+	h.dispatch(RepoCommandActionPrefix + "artifact")
+	if out := h.console(); !strings.Contains(out, "left 1 untracked file(s)") {
+		t.Errorf("console missing untracked output warning:\n%s", out)
+	}
+
+	// An aborted run says so and records nothing
+	h.backToMenu()
+	cmd := h.app.dispatchAction(RepoCommandActionPrefix + "build")
+	h.app.cancelContext()
+	h.run(cmd)
+	if out := h.console(); !strings.Contains(out, "build aborted") {
+		t.Errorf("console missing abort message:\n%s", out)
+	}
+}
+
+func TestIntegration_TimeTravelMerge(t *testing.T) {
+	r := newTestRepo(t)
+	r.commit("b.txt", "b\n", "second")
+	h := newHarness(t)
+
+	h.travelTo("initial")
+	r.write("fix.txt", "fix from the past\n")
+
+	// Return with a dirty tree offers merge (YES) or discard (NO)
+	h.dispatch("time_travel_return")
+	h.confirm(true)
+	h.backToMenu()
+
+	// Travel changes land on the branch as uncommitted work
+	h.assertState(wantState{WorkingTree: git.Dirty, Operation: git.Normal})
+	h.assertMenu([]string{"commit", "history"}, []string{"time_travel_return"})
+	if got := r.git("rev-parse", "--abbrev-ref", "HEAD"); got != DefaultBranch {
+		t.Errorf("branch after merge: got %q, want %q", got, DefaultBranch)
+	}
+	if got := r.read("fix.txt"); got != "fix from the past\n" {
+		t.Errorf("fix.txt: got %q, want time travel change merged", got)
+	}
+	if got := r.read("b.txt"); got != "b\n" {
+		t.Errorf("b.txt: got %q, want branch content kept", got)
+	}
+}
+
+func TestIntegration_ShallowCloneDeepen(t *testing.T) {
+	r := newTestRepo(t).withRemote()
+	for _, n := range []string{"2", "3", "4"} {
+		r.publish("log.txt", n+"\n", "commit "+n)
+	}
+	empty := filepath.Join(r.root, "shallow")
+	if err := os.Mkdir(empty, 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(empty)
+	h := newHarness(t)
+
+	// Empty cwd: location → URL → options
+	h.dispatch("clone")
+	h.press("1")
+	h.submitInput(r.remote)
+	if h.app.mode != ModeCloneOptions {
+		t.Fatalf("expected clone options after URL, mode=%s", GetModeMetadata(h.app.mode).Name)
+	}
+	h.dispatch("clone_opt_depth")
+	h.submitInput("1")
+	if got := h.app.workflowState.CloneOptions.Depth; got != 1 {
+		t.Fatalf("depth option: got %d, want 1", got)
+	}
+	h.dispatch("clone_start")
+	h.backToMenu()
+
+	h.assertState(wantState{WorkingTree: git.Clean, Timeline: git.InSync, Remote: git.HasRemote})
+	if !h.app.gitState.Shallow {
+		t.Fatal("depth-1 clone not detected as shallow")
+	}
+	if got := r.gitIn(empty, "rev-list", "--count", "HEAD"); got != "1" {
+		t.Errorf("commits after depth-1 clone: got %s, want 1", got)
+	}
+	h.assertMenu([]string{"deepen_history", "unshallow"}, nil)
+
+	// History marks the boundary commit
+	h.dispatch("history")
+	head := r.gitIn(empty, "rev-parse", "HEAD")
+	if !h.app.pickerState.History.ShallowBoundary[head] {
+		t.Errorf("history boundary: got %v, want %s", h.app.pickerState.History.ShallowBoundary, head)
+	}
+	h.press("esc")
+
+	h.dispatch("unshallow")
+	h.backToMenu()
+	if h.app.gitState.Shallow || git.IsShallow() {
+		t.Error("repository still shallow after unshallow")
+	}
+	if got := r.gitIn(empty, "rev-list", "--count", "HEAD"); got != "4" {
+		t.Errorf("commits after unshallow: got %s, want 4", got)
+	}
+	h.assertMenu([]string{"history"}, []string{"deepen_history", "unshallow"})
+}
+
+func TestIntegration_SparseCloneSubdir(t *testing.T) {
+	r := newTestRepo(t).withRemote()
+	r.writeIn(r.peer, "docs/guide.md", "guide\n")
+	r.publish("src/main.go", "package main\n", "add docs and src")
+	projects := filepath.Join(r.root, "projects")
+	r.writeIn(projects, "notes.txt", "not a repository\n")
+	t.Chdir(projects)
+	h := newHarness(t)
+
+	// Non-empty cwd: URL → options, clone lands in a subdirectory
+	h.dispatch("clone")
+	h.submitInput(r.remote)
+	h.dispatch("clone_opt_single_branch")
+	h.dispatch("clone_opt_sparse")
+	h.submitInput("docs")
+	opts := h.app.workflowState.CloneOptions
+	if !opts.SingleBranch || len(opts.SparsePatterns) != 1 || opts.SparsePatterns[0] != "docs" {
+		t.Fatalf("clone options: got %+v", opts)
+	}
+	h.dispatch("clone_start")
+	h.backToMenu()
+
+	clone := filepath.Join(projects, "remote")
+	for path, want := range map[string]bool{"README.md": true, "docs/guide.md": true, "src/main.go": false} {
+		if got := git.FileExists(filepath.Join(clone, path)); got != want {
+			t.Errorf("%s checked out: got %v, want %v", path, got, want)
+		}
+	}
+	if got := r.gitIn(clone, "config", "remote.origin.fetch"); !strings.Contains(got, "refs/heads/"+DefaultBranch) {
+		t.Errorf("single-branch refspec: got %q", got)
+	}
+}
+
+func TestIntegration_SparseCheckoutEditor(t *testing.T) {
+	r := newTestRepo(t)
+	r.write("docs/guide.md", "guide\n")
+	r.write("src/app/app.go", "package app\n")
+	r.commit("src/main.go", "package main\n", "add docs and src")
+	h := newHarness(t)
+
+	h.press("/")
+	h.dispatch("config_sparse")
+	if h.app.mode != ModeSparseCheckout {
+		t.Fatalf("mode: got %s, want sparse_checkout", GetModeMetadata(h.app.mode).Name)
+	}
+
+	// Full checkout: every top-level directory starts selected, nothing to apply
+	sparse := h.app.pickerState.Sparse
+	if row, _ := sparse.Selected(); row.Path != "docs" || row.Inclusion != ui.SparseIncluded {
+		t.Fatalf("first row: got %+v", row)
+	}
+	h.press("j")
+	h.press("l")
+	h.press("j")
+	if row, _ := sparse.Selected(); row.Path != "src/app" {
+		t.Fatalf("expanded row: got %+v", row)
+	}
+	h.press(" ")
+	if !strings.Contains(sparse.Status, "src/") {
+		t.Errorf("toggling a directory inside a chosen parent: status %q", sparse.Status)
+	}
+
+	// Drop docs/ from the cone and apply
+	h.press("k")
+	h.press("k")
+	h.press(" ")
+	if got := strings.Join(sparse.Details, "\n"); !strings.Contains(got, "3 of 4 file(s)") || !strings.Contains(got, "- docs/") {
+		t.Errorf("details after toggle:\n%s", got)
+	}
+	h.press("enter")
+	h.backToMenu()
+
+	for path, want := range map[string]bool{"README.md": true, "docs/guide.md": false, "src/app/app.go": true} {
+		if got := git.FileExists(filepath.Join(r.work, path)); got != want {
+			t.Errorf("%s checked out: got %v, want %v", path, got, want)
+		}
+	}
+	if !h.app.gitState.Sparse {
+		t.Errorf("gitState.Sparse = false after applying a cone")
+	}
+
+	// Reopening shows the cone; F restores the full tree
+	h.press("/")
+	h.dispatch("config_sparse")
+	if got := h.app.pickerState.Sparse.Current; len(got) != 1 || got[0] != "src" {
+		t.Errorf("current cone: got %v, want [src]", got)
+	}
+	h.press("F")
+	h.backToMenu()
+	if !git.FileExists(filepath.Join(r.work, "docs/guide.md")) || h.app.gitState.Sparse {
+		t.Errorf("full checkout not restored: docs present %v, sparse %v",
+			git.FileExists(filepath.Join(r.work, "docs/guide.md")), h.app.gitState.Sparse)
+	}
+}
+
+func TestIntegration_AddRemoteConnectivityTest(t *testing.T) {
+	r := newTestRepo(t)
+	r.gitIn(r.root, "clone", "-q", "--bare", r.work, r.remote)
+	h := newHarness(t)
+
+	// Unreachable remote: diagnosis shown, nothing saved
+	missing := filepath.Join(r.root, "missing.git")
+	h.dispatch("add_remote")
+	h.submitInput(missing)
+	if got := h.console(); !strings.Contains(got, "Repository not found") || !strings.Contains(got, "Remote was not saved") {
+		t.Errorf("console after missing remote:\n%s", got)
+	}
+	if got := r.git("remote"); got != "" {
+		t.Fatalf("remote saved after failed test: %q", got)
+	}
+	h.backToMenu()
+
+	// Reachable remote: default branch reported before saving
+	h.dispatch("add_remote")
+	h.submitInput(r.remote)
+	if got := h.console(); !strings.Contains(got, "Default branch: "+DefaultBranch) {
+		t.Errorf("console after add remote:\n%s", got)
+	}
+	h.backToMenu()
+	h.assertState(wantState{Remote: git.HasRemote})
+}
+
+func TestIntegration_PushAuthRemediation(t *testing.T) {
+	r := newTestRepo(t).withRemote()
+	r.commit("a.txt", "a\n", "local work")
+	missing := filepath.Join(r.root, "moved.git")
+	r.git("remote", "set-url", "origin", missing)
+	h := newHarness(t)
+
+	// Missing repository: remediation dialog instead of the push-sync flow
+	h.dispatch("push")
+	if h.app.mode != ModeConfirmation || h.app.dialogState.dialog.Config.ActionID != string(ConfirmAuthRemediation) {
+		t.Fatalf("expected auth remediation dialog, mode=%s", GetModeMetadata(h.app.mode).Name)
+	}
+	if got := h.app.dialogState.context["action"]; got != "change_url" {
+		t.Errorf("remediation action: got %q, want change_url", got)
+	}
+
+	// NO returns to the failure log
+	h.confirm(false)
+	if h.app.mode != ModeConsole {
+		t.Fatalf("expected console after NO, mode=%s", GetModeMetadata(h.app.mode).Name)
+	}
+	h.backToMenu()
+
+	// YES opens the remote URL input; the fixed URL pushes
+	h.dispatch("push")
+	h.confirm(true)
+	if h.app.inputState.Action != "config_switch_remote_url" {
+		t.Fatalf("expected remote URL input, action=%q", h.app.inputState.Action)
+	}
+	h.submitInput(r.remote)
+	h.press("esc") // Switching the remote returns to the config menu
+	h.backToMenu()
+	h.dispatch("push")
+	h.backToMenu()
+	h.assertState(wantState{Timeline: git.InSync})
+}
+
+func TestIntegration_SetupWizardSecondAccount(t *testing.T) {
+	newTestRepo(t)
+	h := newHarness(t)
+
+	// Opened from an auth failure: the wizard runs inside a working session
+	h.app.environmentState.SetupWizardStep = SetupStepWelcome
+	h.app.mode = ModeSetupWizard
+	h.press("enter")
+	h.press("enter") // Prerequisites
+	if h.app.environmentState.SetupWizardStep != SetupStepProvider {
+		t.Fatalf("expected provider step, got %s", h.app.environmentState.SetupWizardStep)
+	}
+
+	h.press("down") // GitLab
+	h.press("enter")
+	if got := h.app.inputState.Value; got != "gitlab.com" {
+		t.Errorf("alias prefill: got %q, want gitlab.com", got)
+	}
+	h.submitInput("gitlab.com work")
+	if h.app.environmentState.SetupWizardStep != SetupStepHostAlias {
+		t.Fatal("alias with a space accepted")
+	}
+	h.submitInput("gitlab.com-work")
+
+	// The harness key pair is offered for reuse, after it "Generate a new key"
+	if got := h.app.setupChoices(); len(got) != 2 || !strings.HasPrefix(got[0], "id_ed25519") {
+		t.Fatalf("key choices: got %q", got)
+	}
+	h.press("down")
+	h.press("enter")
+	h.press("enter") // ed25519
+	env := h.app.environmentState
+	if env.SetupWizardStep != SetupStepEmail || !env.SetupNewKey || env.SetupKeyType != git.SSHKeyEd25519 {
+		t.Fatalf("expected email step for a new ed25519 key, got step=%s new=%v type=%s", env.SetupWizardStep, env.SetupNewKey, env.SetupKeyType)
+	}
+	if got := filepath.Base(env.SetupKeyPath); got != "TIT_id_ed25519_gitlab.com-work" {
+		t.Errorf("new key path: got %s", got)
+	}
+	if env.SetupAlias != "gitlab.com-work" || env.SetupHostName != "gitlab.com" {
+		t.Errorf("host entry: alias=%s hostname=%s", env.SetupAlias, env.SetupHostName)
+	}
+	h.submitInput("me@example.com")
+	if h.app.environmentState.SetupWizardStep != SetupStepPassphrase || !h.app.isInputMode() {
+		t.Fatalf("expected passphrase input, got %s", h.app.environmentState.SetupWizardStep)
+	}
+}
+
+func TestIntegration_IdentityWizard(t *testing.T) {
+	r := newTestRepo(t)
+	t.Setenv("GIT_AUTHOR_NAME", "")
+	t.Setenv("GIT_AUTHOR_EMAIL", "")
+	h := newHarness(t)
+
+	// Key exists but no user.name / user.email: the wizard opens on the identity step
+	if h.app.mode != ModeSetupWizard || h.app.environmentState.SetupWizardStep != SetupStepIdentityName {
+		t.Fatalf("expected identity step, got mode=%s step=%s", GetModeMetadata(h.app.mode).Name, h.app.environmentState.SetupWizardStep)
+	}
+	h.submitInput("Jane Doe")
+	h.submitInput("not-an-email")
+	if h.app.environmentState.SetupWizardStep != SetupStepIdentityEmail {
+		t.Fatal("email without @ accepted")
+	}
+	h.submitInput("jane@example.com")
+	if h.app.environmentState.SetupWizardStep != SetupStepComplete {
+		t.Fatalf("expected complete step, got %s", h.app.environmentState.SetupWizardStep)
+	}
+	if got := r.git("config", "--global", "user.email"); got != "jane@example.com" {
+		t.Errorf("global user.email: got %q", got)
+	}
+	h.press("enter")
+	if h.app.mode != ModeMenu || !strings.Contains(h.app.identityLabel(), "jane@example.com") {
+		t.Errorf("after wizard: mode=%s identity=%q", GetModeMetadata(h.app.mode).Name, h.app.identityLabel())
+	}
+}
+
+func TestIntegration_IdentityProfile(t *testing.T) {
+	r := newTestRepo(t).withRemote()
+	h := newHarness(t)
+
+	h.app.appConfig = &config.Config{Identities: []config.IdentityProfile{
+		{Name: "other", UserName: "Other", UserEmail: "other@example.com", Hosts: []string{"example.org"}},
+		{Name: "work", UserName: "Jane Work", UserEmail: "jane@work.example", Paths: []string{r.root}},
+	}}
+	h.app.applyIdentityProfile()
+	if got := r.git("config", "--local", "user.email"); got != "jane@work.example" {
+		t.Errorf("local user.email: got %q, want jane@work.example", got)
+	}
+	if got := h.app.environmentState.IdentityProfile; got != "work" {
+		t.Errorf("applied profile: got %q, want work", got)
+	}
+	if !strings.Contains(h.console(), "Identity profile 'work' applied") {
+		t.Errorf("console missing applied message:\n%s", h.console())
+	}
+}
+
+func TestIntegration_Dashboard(t *testing.T) {
+	r := newTestRepo(t).withRemote()
+	r.commit("work.txt", "ahead\n", "work change")
+	r.git("push", "-q", "origin", DefaultBranch)
+
+	repos := git.FindRepos([]string{r.root})
+	if len(repos) != 2 || filepath.Base(repos[0]) != "peer" || filepath.Base(repos[1]) != "work" {
+		t.Fatalf("FindRepos: got %v, want [peer work]", repos)
+	}
+	h := newDashboardHarness(t, repos)
+	if h.app.mode != ModeDashboard || len(h.app.dashboard.Rows) != 2 {
+		t.Fatalf("expected dashboard with 2 rows, mode=%s rows=%d", GetModeMetadata(h.app.mode).Name, len(h.app.dashboard.Rows))
+	}
+
+	// Peer only learns it is behind once fetched; the batch pull then fast-forwards it alone
+	h.press("f")
+	if got := h.app.dashboard.Rows[0].Result.Text; got != "✓ fetched" {
+		t.Errorf("peer fetch result: got %q", got)
+	}
+	h.press("p")
+	if got := h.app.dashboard.Rows[0].Result.Text; got != "✓ fast-forwarded" {
+		t.Errorf("peer pull result: got %q", got)
+	}
+	if got, want := r.gitIn(r.peer, "rev-parse", "HEAD"), r.git("rev-parse", "HEAD"); got != want {
+		t.Errorf("peer HEAD: got %s, want %s", got, want)
+	}
+	if !strings.Contains(h.app.dashboard.Status, "1 succeeded, 0 failed") {
+		t.Errorf("pull summary: got %q", h.app.dashboard.Status)
+	}
+
+	// Enter opens the repository in a fresh Application; ESC from its menu comes back
+	h.press("enter")
+	if h.app.mode != ModeMenu {
+		t.Fatalf("expected menu after open, mode=%s", GetModeMetadata(h.app.mode).Name)
+	}
+	if cwd, _ := os.Getwd(); filepath.Base(cwd) != "peer" {
+		t.Errorf("cwd after open: got %s", cwd)
+	}
+	h.press("esc")
+	if h.app.mode != ModeDashboard {
+		t.Errorf("expected dashboard after ESC, mode=%s", GetModeMetadata(h.app.mode).Name)
+	}
+}
+
+func TestIntegration_ProjectSwitcher(t *testing.T) {
+	r := newTestRepo(t).withRemote()
+	if err := config.RecordRecentRepo(r.peer); err != nil {
+		t.Fatalf("seed recent repos: %v", err)
+	}
+	h := newHarness(t) // Opening work records it as most recent
+
+	h.press("ctrl+o")
+	if h.app.mode != ModeProjectSwitcher {
+		t.Fatalf("expected project switcher, mode=%s", GetModeMetadata(h.app.mode).Name)
+	}
+	if matches := h.app.pickerState.Projects.Matches(""); len(matches) != 2 || !matches[0].Current || matches[0].Path != r.work {
+		t.Fatalf("recent repos: got %+v, want work (current) then peer", matches)
+	}
+
+	// Every character types into the query, "q" included
+	h.press("q")
+	if h.app.inputState.Value != "q" || h.app.quitConfirmActive {
+		t.Fatalf("typing q: value=%q quitConfirm=%v", h.app.inputState.Value, h.app.quitConfirmActive)
+	}
+	h.press("backspace")
+	for _, key := range []string{"p", "e", "r"} {
+		h.press(key)
+	}
+	if matches := h.app.pickerState.Projects.Matches(h.app.inputState.Value); len(matches) == 0 || matches[0].Path != r.peer {
+		t.Fatalf("query %q: got %+v, want peer first", h.app.inputState.Value, matches)
+	}
+
+	h.press("enter")
+	if h.app.mode != ModeMenu {
+		t.Fatalf("expected menu after switch, mode=%s", GetModeMetadata(h.app.mode).Name)
+	}
+	if cwd, _ := os.Getwd(); cwd != r.peer {
+		t.Errorf("cwd after switch: got %s, want %s", cwd, r.peer)
+	}
+	if recent := config.LoadRecentRepos(); len(recent) == 0 || recent[0].Path != r.peer {
+		t.Errorf("recent list after switch: got %+v, want peer first", recent)
+	}
+}
+
+// selectBranch moves the branch picker cursor to the named branch
+func (h *harness) selectBranch(name string) ui.BranchInfo {
+	h.t.Helper()
+	for i, b := range h.app.pickerState.BranchPicker.Branches {
+		if b.Name == name {
+			h.app.pickerState.BranchPicker.SelectedIdx = i
+			return b
+		}
+	}
+	h.t.Fatalf("branch %q not in picker", name)
+	return ui.BranchInfo{}
+}
+
+func TestIntegration_BranchPickerCleanup(t *testing.T) {
+	r := newTestRepo(t).withRemote()
+	r.git("branch", "done") // Merged: same commit as the default branch
+	r.gitIn(r.peer, "checkout", "-q", "-b", "feature")
+	r.writeIn(r.peer, "feature.txt", "feature\n")
+	r.gitIn(r.peer, "add", "-A")
+	r.gitIn(r.peer, "commit", "-q", "-m", "feature work")
+	r.gitIn(r.peer, "push", "-q", "origin", "feature", "feature:stale")
+	r.git("fetch", "-q", "origin")
+	r.git("branch", "-q", "--track", "stale", "origin/stale")
+	r.gitIn(r.peer, "push", "-q", "origin", "--delete", "stale") // Gone once work prunes
+	h := newHarness(t)
+
+	h.run(h.app.dispatchConfigSwitchBranch(h.app))
+	if h.app.mode != ModeBranchPicker {
+		t.Fatalf("expected branch picker, mode=%s", GetModeMetadata(h.app.mode).Name)
+	}
+
+	// Enter on a remote-only branch creates a local tracking branch without switching
+	if remote := h.selectBranch("origin/feature"); !remote.IsRemote || remote.HasLocal {
+		t.Fatalf("origin/feature row: got %+v, want untracked remote", remote)
+	}
+	h.press("enter")
+	if got := r.git("rev-parse", "--abbrev-ref", "feature@{upstream}"); got != "origin/feature" {
+		t.Errorf("feature upstream: got %q, want origin/feature", got)
+	}
+	if got := r.git("branch", "--show-current"); got != DefaultBranch {
+		t.Errorf("current branch: got %q, want %q", got, DefaultBranch)
+	}
+
+	h.selectBranch("done")
+	h.press("r")
+	h.submitInput("finished")
+	if h.app.mode != ModeBranchPicker {
+		t.Fatalf("expected branch picker after rename, mode=%s", GetModeMetadata(h.app.mode).Name)
+	}
+	if finished := h.selectBranch("finished"); !finished.Merged {
+		t.Errorf("finished: got %+v, want merged", finished)
+	}
+
+	// Prune offers the merged and the gone branch, never canon or unmerged work
+	h.press("X")
+	if got := h.app.dialogState.context["branches"]; got != "finished\nstale" && got != "stale\nfinished" {
+		t.Fatalf("prune candidates: got %q, want finished and stale", got)
+	}
+	h.confirm(true)
+	if got := r.git("branch", "--format=%(refname:short)"); got != "feature\n"+DefaultBranch && got != DefaultBranch+"\nfeature" {
+		t.Errorf("branches after prune: got %q", got)
+	}
+	if h.app.mode != ModeBranchPicker {
+		t.Errorf("expected branch picker after prune, mode=%s", GetModeMetadata(h.app.mode).Name)
+	}
+}
+
+func TestIntegration_CanonWorkingWorkflow(t *testing.T) {
+	r := newTestRepo(t).withRemote()
+	h := newHarness(t)
+
+	// Config: canon is the current branch, the missing working branch is created from it
+	h.run(h.app.dispatchConfigWorkflow(h.app))
+	h.submitInput(DefaultBranch)
+	h.submitInput("dev")
+	if got := r.git("config", "tit.working"); got != "dev" {
+		t.Fatalf("tit.working: got %q, want dev", got)
+	}
+	if got := r.git("config", "tit.canon"); got != DefaultBranch {
+		t.Fatalf("tit.canon: got %q, want %q", got, DefaultBranch)
+	}
+
+	// Committing directly on canon asks first
+	r.write("direct.txt", "direct\n")
+	h = newHarness(t)
+	h.dispatch("commit")
+	if h.app.mode != ModeConfirmation || h.app.dialogState.dialog.Config.ActionID != string(ConfirmCanonCommit) {
+		t.Fatalf("expected canon commit gate, mode=%s", GetModeMetadata(h.app.mode).Name)
+	}
+	h.confirm(false)
+	if h.app.mode != ModeMenu {
+		t.Fatalf("cancel: got mode %s, want menu", GetModeMetadata(h.app.mode).Name)
+	}
+
+	// Work on dev, then deliver it: canon fast-forwards and is pushed
+	r.git("stash", "-q", "-u")
+	r.git("checkout", "-q", "dev")
+	r.commit("feature.txt", "feature\n", "feature work")
+	h = newHarness(t)
+	if h.app.gitState.WorkingAhead != 1 || h.app.gitState.WorkingBehind != 0 {
+		t.Fatalf("divergence: got ahead=%d behind=%d, want 1/0", h.app.gitState.WorkingAhead, h.app.gitState.WorkingBehind)
+	}
+	h.assertMenu([]string{"workflow_deliver"}, []string{"workflow_update"})
+	h.dispatch("workflow_deliver")
+	h.confirm(true)
+	h.backToMenu()
+	dev := r.git("rev-parse", "dev")
+	if got := r.git("rev-parse", DefaultBranch); got != dev {
+		t.Errorf("canon after deliver: got %s, want %s", got, dev)
+	}
+	if got := r.gitIn(r.remote, "rev-parse", DefaultBranch); got != dev {
+		t.Errorf("remote canon after deliver: got %s, want %s", got, dev)
+	}
+	if got := r.git("branch", "--show-current"); got != "dev" {
+		t.Errorf("current branch after deliver: got %q, want dev", got)
+	}
+	h.assertMenu(nil, []string{"workflow_deliver", "workflow_update"})
+
+	// Canon moving ahead offers update, which merges canon into dev
+	r.git("checkout", "-q", DefaultBranch)
+	r.commit("hotfix.txt", "hotfix\n", "hotfix")
+	r.git("checkout", "-q", "dev")
+	h = newHarness(t)
+	if !strings.Contains(h.app.workflowDescription(), "behind") {
+		t.Errorf("header description: got %q, want behind", h.app.workflowDescription())
+	}
+	h.assertMenu([]string{"workflow_update"}, []string{"workflow_deliver"})
+	h.dispatch("workflow_update")
+	h.confirm(true)
+	h.backToMenu()
+	if got := r.git("rev-list", "--count", "dev.."+DefaultBranch); got != "0" {
+		t.Errorf("dev behind canon after update: got %s commits", got)
+	}
+}
+
+func TestIntegration_PolicyProtectedBranch(t *testing.T) {
+	r := newTestRepo(t).withRemote()
+	r.commit(config.RepoConfigFile, "[policy]\nmax_discard_files = 1\n\n[[policy.protected]]\npattern = \""+DefaultBranch+"\"\n", "protect canon")
+	r.git("config", "tit.canon", DefaultBranch)
+	r.git("config", "tit.working", "dev")
+	h := newHarness(t)
+
+	// Protected in confirm mode: force push is offered, but only runs once the branch name is typed
+	h.assertMenu([]string{"push", "force_push"}, nil)
+	h.dispatch("force_push")
+	if h.app.inputState.Action != "policy_confirm" {
+		t.Fatalf("expected typed confirmation, mode=%s action=%q", GetModeMetadata(h.app.mode).Name, h.app.inputState.Action)
+	}
+	h.submitInput("wrong")
+	if h.app.inputState.Action != "policy_confirm" {
+		t.Fatalf("mismatched name left the prompt, mode=%s", GetModeMetadata(h.app.mode).Name)
+	}
+	h.submitInput(DefaultBranch)
+	if h.app.mode != ModeConfirmation || h.app.dialogState.dialog.Config.ActionID != string(ConfirmCanonForcePush) {
+		t.Fatalf("expected canon gate after the typed name, mode=%s", GetModeMetadata(h.app.mode).Name)
+	}
+	if h.app.workflowState.PreviousMode != ModeMenu {
+		t.Errorf("previous mode after typed name: got %s, want menu", GetModeMetadata(h.app.workflowState.PreviousMode).Name)
+	}
+	h.confirm(true)
+	h.confirm(true) // Then the regular preview dialog
+	h.backToMenu()
+	if got, want := r.gitIn(r.remote, "rev-parse", DefaultBranch), r.git("rev-parse", "HEAD"); got != want {
+		t.Errorf("remote after typed force push: got %s, want %s", got, want)
+	}
+
+	// Hide mode removes the item; the discard limit disables discarding past it
+	r.commit(config.RepoConfigFile, "[policy]\nmax_discard_files = 1\n\n[[policy.protected]]\npattern = \""+DefaultBranch+"\"\nmode = \"hide\"\n", "hide force push")
+	h = newHarness(t)
+	h.assertMenu([]string{"push"}, []string{"force_push"})
+	r.write("one.txt", "1\n")
+	h = newHarness(t)
+	h.assertMenu([]string{"reset_discard_changes"}, nil)
+	r.write("two.txt", "2\n")
+	h = newHarness(t)
+	h.assertMenu(nil, []string{"reset_discard_changes"})
+
+	// The rewind limit fails closed: a count git cannot produce refuses the rewind
+	h.app.policy.MaxRewindCommits = 1
+	if !h.app.rewindExceedsPolicy("not-a-commit") || !strings.Contains(h.app.footerHint, "could not count") {
+		t.Errorf("rewind with uncountable range: footer %q, want refused", h.app.footerHint)
+	}
+}
+
+func TestIntegration_ForcePushLease(t *testing.T) {
+	r := newTestRepo(t).withRemote()
+	r.publish("peer.txt", "peer\n", "peer work")
+	r.git("fetch", "-q", "origin")
+	r.commit("local.txt", "local\n", "local work")
+	h := newHarness(t)
+
+	// The confirmation lists the remote commits the push would destroy
+	h.dispatch("force_push")
+	if got := h.app.dialogState.dialog.Config.Explanation; !strings.Contains(got, "1 remote commit(s)") || !strings.Contains(got, "peer work") {
+		t.Fatalf("force push preview: got %q, want the peer commit", got)
+	}
+	h.confirm(false)
+
+	// The remote moves after the last fetch: the lease refuses and state is refreshed
+	r.publish("late.txt", "late\n", "late work")
+	late := r.gitIn(r.peer, "rev-parse", "HEAD")
+	h.dispatch("force_push")
+	h.confirm(true)
+	if got := r.gitIn(r.remote, "rev-parse", DefaultBranch); got != late {
+		t.Fatalf("remote after stale lease: got %s, want untouched %s", got, late)
+	}
+	if h.app.gitState.RemoteHash != late {
+		t.Errorf("remote hash after stale lease: got %s, want refetched %s", h.app.gitState.RemoteHash, late)
+	}
+	h.backToMenu()
+
+	h.dispatch("force_push")
+	if got := h.app.dialogState.dialog.Config.Explanation; !strings.Contains(got, "2 remote commit(s)") || !strings.Contains(got, "late work") {
+		t.Fatalf("force push preview after refresh: got %q", got)
+	}
+	h.confirm(true)
+	if got, want := r.gitIn(r.remote, "rev-parse", DefaultBranch), r.git("rev-parse", "HEAD"); got != want {
+		t.Errorf("remote after force push: got %s, want %s", got, want)
+	}
+}
+
+// bisectRepo builds initial..fifth where "fourth" introduces bug.txt; returns fourth's hash
+func bisectRepo(t *testing.T) (*testRepo, string) {
+	r := newTestRepo(t)
+	r.commit("b.txt", "b\n", "second")
+	r.commit("c.txt", "c\n", "third")
+	r.commit("bug.txt", "bug\n", "fourth")
+	culprit := r.git("rev-parse", "HEAD")
+	r.commit("e.txt", "e\n", "fifth")
+	return r, culprit
+}
+
+// markBisectRange marks initial good and fifth bad in History, then answers the test command prompt
+func (h *harness) markBisectRange(testCmd string) {
+	h.t.Helper()
+	h.selectInHistory("initial")
+	h.press("G")
+	h.selectInHistory("fifth")
+	h.press("B")
+	h.submitInput(testCmd)
+}
+
+func TestIntegration_BisectByHand(t *testing.T) {
+	r, culprit := bisectRepo(t)
+	head := r.git("rev-parse", "HEAD")
+	r.write("wip.txt", "work in progress\n")
+	h := newHarness(t)
+
+	h.markBisectRange("")
+	h.backToMenu()
+	h.assertState(wantState{WorkingTree: git.Clean, Operation: git.Bisecting})
+	progress := h.app.gitState.Bisect
+	if progress == nil || progress.Remaining != 4 || progress.OriginalBranch != DefaultBranch {
+		t.Fatalf("bisect progress: got %+v, want 4 remaining from %s", progress, DefaultBranch)
+	}
+
+	// Judge each checked-out commit by hand until git names the culprit
+	for step := 0; h.hasMenuItem("bisect_good"); step++ {
+		if step > 4 {
+			t.Fatal("bisect did not converge")
+		}
+		if r.git("ls-files", "bug.txt") != "" {
+			h.dispatch("bisect_bad")
+		} else {
+			h.dispatch("bisect_good")
+		}
+		h.backToMenu()
+	}
+
+	// Found: report, then back on the branch with the stashed work restored
+	if !strings.Contains(h.console(), "First bad commit: "+culprit+" fourth") {
+		t.Errorf("console does not report the culprit:\n%s", h.console())
+	}
+	h.assertState(wantState{WorkingTree: git.Dirty, Operation: git.Normal})
+	if got := r.git("rev-parse", "HEAD"); got != head {
+		t.Errorf("HEAD after bisect: got %s, want %s", got, head)
+	}
+	if got := r.read("wip.txt"); got != "work in progress\n" {
+		t.Errorf("wip.txt after bisect: got %q", got)
+	}
+}
+
+func TestIntegration_BisectRun(t *testing.T) {
+	r, culprit := bisectRepo(t)
+	h := newHarness(t)
+
+	// Good must be an ancestor of bad
+	h.selectInHistory("fifth")
+	h.press("G")
+	h.selectInHistory("initial")
+	h.press("B")
+	if h.app.mode != ModeHistory {
+		t.Fatalf("reversed range: got mode %s, want history", GetModeMetadata(h.app.mode).Name)
+	}
+
+	h.markBisectRange("test ! -f bug.txt")
+	if !strings.Contains(h.console(), "First bad commit: "+culprit+" fourth") {
+		t.Errorf("console does not report the culprit:\n%s", h.console())
+	}
+	h.backToMenu()
+	h.assertState(wantState{WorkingTree: git.Clean, Operation: git.Normal})
+	if got := r.git("rev-parse", "--abbrev-ref", "HEAD"); got != DefaultBranch {
+		t.Errorf("branch after bisect run: got %s, want %s", got, DefaultBranch)
+	}
+}
+
+// compareFiles lists the compare view's changed files as "status path", comma separated
+func (h *harness) compareFiles() string {
+	var paths []string
+	for _, f := range h.app.pickerState.Compare.Files {
+		paths = append(paths, f.Status+" "+f.Path)
+	}
+	return strings.Join(paths, ", ")
+}
+
+func TestIntegration_CompareBranchesAndCommits(t *testing.T) {
+	r := newTestRepo(t)
+	r.git("checkout", "-q", "-b", "feature")
+	r.commit("feature.txt", "feature\n", "feature work")
+	r.git("checkout", "-q", DefaultBranch)
+	r.commit("main.txt", "main\n", "main work")
+	r.git("tag", "-a", "v1", "-m", "release")
+	h := newHarness(t)
+
+	// The compare picker lists tags after branches; tags cannot be changed from it
+	h.run(h.app.dispatchConfigCompare(h.app))
+	if tag := h.selectBranch("v1"); !tag.IsTag || tag.LastCommitSubj != "main work" {
+		t.Fatalf("v1 row: got %+v, want tag peeled to main work", tag)
+	}
+	h.press("x")
+	if h.app.mode != ModeBranchPicker || h.app.footerHint != ErrorMessages["branch_tag_read_only"] {
+		t.Fatalf("x on tag: mode=%s hint=%q", GetModeMetadata(h.app.mode).Name, h.app.footerHint)
+	}
+
+	// Enter marks each side; the second mark opens the compare view
+	h.selectBranch(DefaultBranch)
+	h.press("enter")
+	h.selectBranch("feature")
+	h.press("enter")
+	if h.app.mode != ModeCompare {
+		t.Fatalf("mode: got %s, want compare", GetModeMetadata(h.app.mode).Name)
+	}
+	state := h.app.pickerState.Compare
+	if len(state.LeftOnly) != 1 || state.LeftOnly[0].Subject != "main work" {
+		t.Errorf("left only: got %+v, want main work", state.LeftOnly)
+	}
+	if len(state.RightOnly) != 1 || state.RightOnly[0].Subject != "feature work" {
+		t.Errorf("right only: got %+v, want feature work", state.RightOnly)
+	}
+
+	// Three-dot shows what feature added since the split; two-dot compares the tips
+	if got := h.compareFiles(); got != "A feature.txt" {
+		t.Errorf("three-dot files: got %v", got)
+	}
+	if !strings.Contains(state.DiffContent, "+feature") {
+		t.Errorf("diff: got %q, want feature.txt added", state.DiffContent)
+	}
+	h.press("m")
+	if got := h.compareFiles(); got != "A feature.txt, D main.txt" {
+		t.Errorf("two-dot files: got %v", got)
+	}
+	if state.Files[0].Added != 1 || state.Files[1].Deleted != 1 {
+		t.Errorf("two-dot stats: got %+v", state.Files)
+	}
+
+	h.press("esc")
+	if h.app.mode != ModeBranchPicker {
+		t.Fatalf("ESC from compare: got %s, want branch picker", GetModeMetadata(h.app.mode).Name)
+	}
+
+	// Two commits marked in History; ESC returns to a working History
+	h.press("esc")
+	h.selectInHistory("main work")
+	h.press("c")
+	h.selectInHistory("initial")
+	h.press("c")
+	if h.app.mode != ModeCompare {
+		t.Fatalf("mode: got %s, want compare", GetModeMetadata(h.app.mode).Name)
+	}
+	if got := len(h.app.pickerState.Compare.LeftOnly); got != 1 {
+		t.Errorf("left only commits: got %d, want 1", got)
+	}
+	// initial is the merge base: nothing added on the right since the split, main.txt gone tip to tip
+	if got := h.compareFiles(); got != "" {
+		t.Errorf("three-dot commit files: got %v, want none", got)
+	}
+	h.press("m")
+	if got := h.compareFiles(); got != "D main.txt" {
+		t.Errorf("two-dot commit files: got %v", got)
+	}
+	h.press("esc")
+	if h.app.mode != ModeHistory {
+		t.Fatalf("ESC from compare: got %s, want history", GetModeMetadata(h.app.mode).Name)
+	}
+	// Both commits share a timestamp, so either may be listed first
+	key, want := "j", h.app.pickerState.History.SelectedIdx+1
+	if want == len(h.app.pickerState.History.Commits) {
+		key, want = "k", want-2
+	}
+	h.press(key)
+	if h.app.pickerState.History.SelectedIdx != want {
+		t.Errorf("history keys after compare: selection %d, want %d", h.app.pickerState.History.SelectedIdx, want)
+	}
+}
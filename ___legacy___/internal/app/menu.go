@@ -28,6 +28,7 @@ func (a *Application) GenerateMenu() []MenuItem {
 		git.Rebasing:       (*Application).menuRebasing,
 		git.DirtyOperation: (*Application).menuDirtyOperation,
 		git.Rewinding:      (*Application).menuNormal, // Rewinding is transient — by render time it's done
+		git.Bisecting:      (*Application).menuBisecting,
 	}
 
 	if generator, exists := menuGenerators[a.gitState.Operation]; exists {
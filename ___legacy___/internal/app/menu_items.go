@@ -28,7 +28,23 @@ var MenuItems = map[string]MenuItem{
 		Shortcut: "c",
 		Emoji:    "📝",
 		Label:    "Commit changes",
-		Hint:     "Create a new commit with staged changes",
+		Hint:     "Stage all changes and create a new commit",
+		Enabled:  true,
+	},
+	"commit_staged": {
+		ID:       "commit_staged",
+		Shortcut: "s",
+		Emoji:    "📋",
+		Label:    "Commit staged only",
+		Hint:     "Commit only staged changes, leave the rest in the working tree",
+		Enabled:  true,
+	},
+	"untracked_triage": {
+		ID:       "untracked_triage",
+		Shortcut: "u",
+		Emoji:    "🧹",
+		Label:    "Triage untracked files",
+		Hint:     "Stage, .gitignore, or locally exclude untracked files by pattern",
 		Enabled:  true,
 	},
 	"commit_push": {
@@ -115,6 +131,24 @@ var MenuItems = map[string]MenuItem{
 		Enabled:  true,
 	},
 
+	// Canon/working workflow (on the working branch, clean tree)
+	"workflow_update": {
+		ID:       "workflow_update",
+		Shortcut: "<",
+		Emoji:    "🔽",
+		Label:    "Update from canon",
+		Hint:     "Merge %[1]d new commit(s) from %[2]s into %[3]s",
+		Enabled:  true,
+	},
+	"workflow_deliver": {
+		ID:       "workflow_deliver",
+		Shortcut: ">",
+		Emoji:    "🚚",
+		Label:    "Deliver to canon",
+		Hint:     "Fast-forward %[2]s by %[1]d commit(s) and push it",
+		Enabled:  true,
+	},
+
 	// History
 	"history": {
 		ID:       "history",
@@ -132,6 +166,22 @@ var MenuItems = map[string]MenuItem{
 		Hint:     "View history of specific files",
 		Enabled:  true,
 	},
+	"deepen_history": {
+		ID:       "deepen_history",
+		Shortcut: "d",
+		Emoji:    "⏬",
+		Label:    "Deepen history",
+		Hint:     "Shallow clone: fetch older commits past the boundary",
+		Enabled:  true,
+	},
+	"unshallow": {
+		ID:       "unshallow",
+		Shortcut: "D",
+		Emoji:    "📚",
+		Label:    "Fetch full history",
+		Hint:     "Shallow clone: fetch every remaining commit (git fetch --unshallow)",
+		Enabled:  true,
+	},
 
 	// Remote
 	"add_remote": {
@@ -168,6 +218,63 @@ var MenuItems = map[string]MenuItem{
 		Hint:     "Merge changes back to original branch",
 		Enabled:  true,
 	},
+	"time_travel_back": {
+		ID:       "time_travel_back",
+		Shortcut: "b",
+		Emoji:    "⏪",
+		Label:    "Back one hop",
+		Hint:     "Return to the commit visited before this one",
+		Enabled:  true,
+	},
+	"time_travel_bookmark": {
+		ID:       "time_travel_bookmark",
+		Shortcut: "n",
+		Emoji:    "🔖",
+		Label:    "Bookmark this commit",
+		Hint:     "Name this commit for quick travel from the menu",
+		Enabled:  true,
+	},
+	// Bisect (git bisect session)
+	"bisect_good": {
+		ID:       "bisect_good",
+		Shortcut: "g",
+		Emoji:    "✅",
+		Label:    "Good",
+		Hint:     "This commit works: the bug came later",
+		Enabled:  true,
+	},
+	"bisect_bad": {
+		ID:       "bisect_bad",
+		Shortcut: "b",
+		Emoji:    "❌",
+		Label:    "Bad",
+		Hint:     "This commit has the bug: it came here or earlier",
+		Enabled:  true,
+	},
+	"bisect_skip": {
+		ID:       "bisect_skip",
+		Shortcut: "s",
+		Emoji:    "⏭️",
+		Label:    "Skip",
+		Hint:     "This commit cannot be tested: try a nearby one",
+		Enabled:  true,
+	},
+	"bisect_run": {
+		ID:       "bisect_run",
+		Shortcut: "t",
+		Emoji:    "🧪",
+		Label:    "Run test command",
+		Hint:     "Let a shell command judge the remaining commits",
+		Enabled:  true,
+	},
+	"bisect_stop": {
+		ID:       "bisect_stop",
+		Shortcut: "r",
+		Emoji:    "🔙",
+		Label:    "Stop bisecting",
+		Hint:     "End bisect and restore the original branch and changes",
+		Enabled:  true,
+	},
 	"time_travel_return": {
 		ID:       "time_travel_return",
 		Shortcut: "r",
@@ -211,6 +318,56 @@ var MenuItems = map[string]MenuItem{
 		Enabled:  true,
 	},
 
+	// Clone options (labels show the current value, see menuCloneOptions)
+	"clone_opt_depth": {
+		ID:       "clone_opt_depth",
+		Shortcut: "d",
+		Emoji:    "📏",
+		Label:    "Depth",
+		Hint:     "Fetch only the latest N commits (shallow clone)",
+		Enabled:  true,
+	},
+	"clone_opt_single_branch": {
+		ID:       "clone_opt_single_branch",
+		Shortcut: "s",
+		Emoji:    "🌿",
+		Label:    "Single branch",
+		Hint:     "Fetch only the checked-out branch",
+		Enabled:  true,
+	},
+	"clone_opt_branch": {
+		ID:       "clone_opt_branch",
+		Shortcut: "b",
+		Emoji:    "🔀",
+		Label:    "Branch",
+		Hint:     "Check out a specific branch instead of the remote default",
+		Enabled:  true,
+	},
+	"clone_opt_blob_filter": {
+		ID:       "clone_opt_blob_filter",
+		Shortcut: "f",
+		Emoji:    "🪶",
+		Label:    "Partial clone",
+		Hint:     "Download file contents on demand (--filter=blob:none)",
+		Enabled:  true,
+	},
+	"clone_opt_sparse": {
+		ID:       "clone_opt_sparse",
+		Shortcut: "p",
+		Emoji:    "✂️",
+		Label:    "Sparse checkout",
+		Hint:     "Check out only the listed directories (cone mode)",
+		Enabled:  true,
+	},
+	"clone_start": {
+		ID:       "clone_start",
+		Shortcut: "c",
+		Emoji:    "⬇️",
+		Label:    "Start clone",
+		Hint:     "Clone with the options above",
+		Enabled:  true,
+	},
+
 	// Mid-operation recovery
 	"finalize_merge": {
 		ID:       "finalize_merge",
@@ -299,7 +456,7 @@ var MenuItems = map[string]MenuItem{
 		Shortcut: "b",
 		Emoji:    "🌿",
 		Label:    "Switch Branch",
-		Hint:     "Switch to a different local branch",
+		Hint:     "Switch, track, rename or prune branches",
 		Enabled:  true,
 	},
 	"config_merge_branch": {
@@ -310,6 +467,46 @@ var MenuItems = map[string]MenuItem{
 		Hint:     "Merge another branch into the current branch",
 		Enabled:  true,
 	},
+	"config_compare": {
+		ID:       "config_compare",
+		Shortcut: "c",
+		Emoji:    "🔍",
+		Label:    "Compare...",
+		Hint:     "Pick two branches or tags and compare their commits and files",
+		Enabled:  true,
+	},
+	"config_lfs": {
+		ID:       "config_lfs",
+		Shortcut: "l",
+		Emoji:    "📦",
+		Label:    "Git LFS",
+		Hint:     "Manage LFS tracked patterns, pointer files, and locks",
+		Enabled:  true,
+	},
+	"config_sparse": {
+		ID:       "config_sparse",
+		Shortcut: "w",
+		Emoji:    "🌿",
+		Label:    "Sparse checkout",
+		Hint:     "Choose which directories are checked out in the working tree",
+		Enabled:  true,
+	},
+	"config_projects": {
+		ID:       "config_projects",
+		Shortcut: "o",
+		Emoji:    "🗂️",
+		Label:    "Switch repository",
+		Hint:     "Open a recently used repository (Ctrl+O from the menu)",
+		Enabled:  true,
+	},
+	"config_workflow": {
+		ID:       "config_workflow",
+		Shortcut: "t",
+		Emoji:    "🛡️",
+		Label:    "Canon & working",
+		Hint:     "Set the canon branch and the working branch that delivers into it",
+		Enabled:  true,
+	},
 	"config_preferences": {
 		ID:       "config_preferences",
 		Shortcut: "p",
@@ -353,6 +550,14 @@ var MenuItems = map[string]MenuItem{
 		Hint:     "Cycle through available themes",
 		Enabled:  true,
 	},
+	"preferences_syntax": {
+		ID:       "preferences_syntax",
+		Shortcut: "", // No shortcut - navigation only
+		Emoji:    "🖍️",
+		Label:    "Syntax Colors",
+		Hint:     "Toggle syntax highlighting in diff and conflict panes ON/OFF",
+		Enabled:  true,
+	},
 }
 
 // GetMenuItem retrieves a menu item by ID from the SSOT map
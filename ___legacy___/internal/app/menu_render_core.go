@@ -39,6 +39,8 @@ func (a *Application) menuNormal() []MenuItem {
 
 	items = append(items, a.menuTimeline()...)
 
+	items = append(items, a.menuWorkflow()...)
+
 	// Separator before History section (if there are items above)
 	if len(items) > 0 {
 		items = append(items, Item("").Separator().Build())
@@ -74,13 +76,23 @@ func (a *Application) menuWorkingTree() []MenuItem {
 			GetMenuItem("commit"),
 		}
 
+		// Offer a staged-only commit when something is already in the index
+		if a.gitState.Changes.Staged > 0 {
+			items = append(items, GetMenuItem("commit_staged"))
+		}
+
+		// Offer triage when untracked files are cluttering the tree
+		if a.gitState.Changes.Untracked > 0 {
+			items = append(items, GetMenuItem("untracked_triage"))
+		}
+
 		// Show "Commit and push" only if remote exists
 		if a.gitState.Remote == git.HasRemote {
 			items = append(items, GetMenuItem("commit_push"))
 		}
 
-		// Always allow discarding changes if dirty, regardless of remote/sync status
-		items = append(items, GetMenuItem("reset_discard_changes"))
+		// Always offer discarding changes if dirty; policy may disable it past max_discard_files
+		items = append(items, a.discardMenuItem())
 
 		return items
 	}
@@ -89,6 +101,7 @@ func (a *Application) menuWorkingTree() []MenuItem {
 }
 
 // menuTimeline returns timeline sync actions
+// Destructive items go through policyMenuItems (protected branches hide or flag them)
 func (a *Application) menuTimeline() []MenuItem {
 	if a.gitState == nil {
 		return []MenuItem{}
@@ -110,43 +123,25 @@ func (a *Application) menuTimeline() []MenuItem {
 		// Local ahead → show push ONLY if working tree is Clean
 		// cannot push uncommitted changes
 		if a.gitState.WorkingTree == git.Clean {
-			items = append(items,
-				GetMenuItem("push"),
-				GetMenuItem("force_push"),
-			)
+			items = append(items, a.policyMenuItems("push", "force_push")...)
 		}
 
 	case git.Behind:
 		// Dirty → ONLY dirty pull (don't offer clean pull that would lose work)
 		if a.gitState.WorkingTree == git.Dirty {
-			items = append(items,
-				GetMenuItem("dirty_pull_merge"),
-				GetMenuItem("replace_local"), // Destructive option (has confirmation)
-			)
+			items = append(items, a.policyMenuItems("dirty_pull_merge", "replace_local")...)
 		} else {
 			// Clean → ONLY clean pull options
-			items = append(items,
-				GetMenuItem("pull_merge"),
-				GetMenuItem("replace_local"),
-			)
+			items = append(items, a.policyMenuItems("pull_merge", "replace_local")...)
 		}
 
 	case git.Diverged:
 		// Dirty → ONLY dirty pull (don't offer clean pull that would lose work)
 		if a.gitState.WorkingTree == git.Dirty {
-			items = append(items,
-				GetMenuItem("dirty_pull_merge"),
-				GetMenuItem("force_push"),    // Destructive option (has confirmation)
-				GetMenuItem("replace_local"), // Destructive option (has confirmation)
-			)
+			items = append(items, a.policyMenuItems("dirty_pull_merge", "force_push", "replace_local")...)
 		} else {
 			// Clean → auto sync push + power user options
-			items = append(items,
-				GetMenuItem("push_auto_sync"),
-				GetMenuItem("pull_merge_diverged"),
-				GetMenuItem("force_push"),
-				GetMenuItem("replace_local"),
-			)
+			items = append(items, a.policyMenuItems("push_auto_sync", "pull_merge_diverged", "force_push", "replace_local")...)
 		}
 	}
 
@@ -155,6 +150,14 @@ func (a *Application) menuTimeline() []MenuItem {
 
 // menuHistory returns history actions
 // CONTRACT: Disables menu items and shows progress while cache is building
+// Shallow clones with a remote also offer deepen/unshallow.
 func (a *Application) menuHistory() []MenuItem {
-	return a.getHistoryItemsWithCacheState("history", "file_history")
+	items := a.getHistoryItemsWithCacheState("history", "file_history")
+	if a.gitState != nil && a.gitState.Shallow && a.gitState.Remote == git.HasRemote {
+		items = append(items,
+			GetMenuItem("deepen_history"),
+			GetMenuItem("unshallow"),
+		)
+	}
+	return append(items, a.bookmarkMenuItems()...)
 }
@@ -101,6 +101,9 @@ func (a *Application) menuTimeTraveling() []MenuItem {
 
 	// Get history items with cache state applied (centralized logic)
 	items := a.getHistoryItemsWithCacheState("time_travel_history", "time_travel_files_history")
+	items = append(items, a.bookmarkMenuItems()...)
+	items = append(items, a.timeTravelTrailItems()...)
+	items = append(items, a.repoCommandMenuItems(append(items, GetMenuItem("time_travel_return")))...)
 
 	// Add single return option (handles both merge and discard via dialog when dirty)
 	returnItem := GetMenuItem("time_travel_return")
@@ -162,6 +165,46 @@ func (a *Application) menuCloneLocation() []MenuItem {
 	}
 }
 
+// menuCloneOptions returns the clone options menu; each label shows its current value
+func (a *Application) menuCloneOptions() []MenuItem {
+	opts := a.workflowState.CloneOptions
+	toggle := map[bool]string{true: "ON", false: "OFF"}
+
+	depth := GetMenuItem("clone_opt_depth")
+	depth.Label = "Depth: full history"
+	if opts.Depth > 0 {
+		depth.Label = fmt.Sprintf("Depth: %d commit(s)", opts.Depth)
+	}
+
+	singleBranch := GetMenuItem("clone_opt_single_branch")
+	singleBranch.Label = "Single branch: " + toggle[opts.SingleBranch]
+
+	branch := GetMenuItem("clone_opt_branch")
+	branch.Label = "Branch: remote default"
+	if opts.Branch != "" {
+		branch.Label = "Branch: " + opts.Branch
+	}
+
+	blobFilter := GetMenuItem("clone_opt_blob_filter")
+	blobFilter.Label = "Partial clone (blob:none): " + toggle[opts.BlobFilter]
+
+	sparse := GetMenuItem("clone_opt_sparse")
+	sparse.Label = "Sparse checkout: everything"
+	if len(opts.SparsePatterns) > 0 {
+		sparse.Label = "Sparse checkout: " + strings.Join(opts.SparsePatterns, " ")
+	}
+
+	return []MenuItem{
+		depth,
+		singleBranch,
+		branch,
+		blobFilter,
+		sparse,
+		Item("").Separator().Build(),
+		GetMenuItem("clone_start"),
+	}
+}
+
 // GenerateConfigMenu generates config menu items based on dynamic git state
 func (a *Application) GenerateConfigMenu() []MenuItem {
 	var items []MenuItem
@@ -183,6 +226,29 @@ func (a *Application) GenerateConfigMenu() []MenuItem {
 	// Branch picker (replaces individual new/switch/merge branch items)
 	items = append(items, GetMenuItem("config_branch"))
 
+	// Compare two branches or tags (needs a commit: refs must exist)
+	if a.gitState != nil && a.gitState.CurrentHash != "" {
+		items = append(items, GetMenuItem("config_compare"))
+	}
+
+	// LFS manager (repo uses LFS, or git-lfs is available to start using it)
+	if a.gitState != nil && (a.gitState.LFS || git.IsLFSBinaryAvailable()) {
+		items = append(items, GetMenuItem("config_lfs"))
+	}
+
+	// Sparse checkout (needs a commit: the tree comes from HEAD)
+	if a.gitState != nil && a.gitState.CurrentHash != "" {
+		items = append(items, GetMenuItem("config_sparse"))
+	}
+
+	// Canon/working branches (needs a commit: branches must exist)
+	if a.gitState != nil && a.gitState.CurrentHash != "" {
+		items = append(items, GetMenuItem("config_workflow"))
+	}
+
+	// Project switcher (always available: recent repositories live in the config directory)
+	items = append(items, GetMenuItem("config_projects"))
+
 	// Preferences (always available)
 	items = append(items, GetMenuItem("config_preferences"))
 
@@ -195,11 +261,12 @@ func (a *Application) GenerateConfigMenu() []MenuItem {
 }
 
 // GeneratePreferencesMenu generates preferences menu items
-// Only 3 items - no Back (ESC in footer is sufficient)
+// No Back item (ESC in footer is sufficient)
 func (a *Application) GeneratePreferencesMenu() []MenuItem {
 	return []MenuItem{
 		GetMenuItem("preferences_auto_update"),
 		GetMenuItem("preferences_interval"),
 		GetMenuItem("preferences_theme"),
+		GetMenuItem("preferences_syntax"),
 	}
 }
@@ -121,6 +121,62 @@ var InputMessages = map[string]InputMessage{
 		Prompt: "Commit message:",
 		Hint:   "Enter message and press Enter",
 	},
+	"commit_staged_message": {
+		Prompt: "Commit message (staged only):",
+		Hint:   "Enter message and press Enter (commits %d staged files)",
+	},
+	"lfs_track_pattern": {
+		Prompt: "LFS pattern to track:",
+		Hint:   "Enter a .gitattributes pattern (e.g. *.psd or assets/**) and press Enter",
+	},
+	"clone_depth": {
+		Prompt: "Clone depth (commits):",
+		Hint:   "Enter how many recent commits to fetch, or leave empty for full history",
+	},
+	"clone_branch": {
+		Prompt: "Branch to check out:",
+		Hint:   "Enter a branch name, or leave empty for the remote default",
+	},
+	"clone_sparse": {
+		Prompt: "Sparse checkout directories:",
+		Hint:   "Enter space-separated directories (e.g. src docs), or leave empty to check out everything",
+	},
+	"workflow_canon": {
+		Prompt: "Canon branch:",
+		Hint:   "Branch that only receives delivered work (e.g. main), press Enter to continue",
+	},
+	"workflow_working": {
+		Prompt: "Working branch (delivers into %s):",
+		Hint:   "Created from canon if missing; leave empty to work on canon directly",
+	},
+	"init_working_branch": {
+		Prompt: "Working branch (delivers into %s):",
+		Hint:   "Leave empty to commit on the initial branch directly",
+	},
+	"policy_confirm_force_push": {
+		Prompt: "Type %s to force push:",
+		Hint:   "Protected branch: enter the branch name to overwrite the remote, ESC to cancel",
+	},
+	"policy_confirm_replace_local": {
+		Prompt: "Type %s to replace local:",
+		Hint:   "Protected branch: enter the branch name to discard local commits, ESC to cancel",
+	},
+	"policy_confirm_rewind": {
+		Prompt: "Type %s to rewind:",
+		Hint:   "Protected branch: enter the branch name to reset --hard, ESC to cancel",
+	},
+	"bisect_run": {
+		Prompt: "Test command for %s..%s:",
+		Hint:   "Run at each step by git bisect run (exit 0 good, 125 skip, other bad); empty to test by hand",
+	},
+	"bookmark_name": {
+		Prompt: "Bookmark %s as:",
+		Hint:   "Name this commit (e.g. last known good build); empty removes its bookmark",
+	},
+	"credential_helper": {
+		Prompt: "credential.helper:",
+		Hint:   "Enter a helper (e.g. store, cache --timeout=3600, osxkeychain, manager); saved to the global git config",
+	},
 	"subdir_name": {
 		Prompt: "Subdirectory name:",
 		Hint:   "Enter new directory name",
@@ -15,12 +15,103 @@ type ConfirmationMessage struct {
 // ConfirmationMessages centralizes all confirmation dialog messages by domain
 // Replaces old ConfirmationTitles + ConfirmationExplanations + ConfirmationLabels
 var ConfirmationMessages = map[string]ConfirmationMessage{
+	"lfs_migrate": {
+		Title:       "Migrate existing files to LFS?",
+		Explanation: "This rewrites the history of the current branch so every file matching %s is stored in LFS.\n\nCommit hashes change: the branch must be force pushed, and collaborators must re-clone or reset.\n\nContinue?",
+		YesLabel:    "Migrate",
+		NoLabel:     "Cancel",
+	},
+	"lfs_large_binaries": {
+		Title:       "Large binaries not tracked by LFS",
+		Explanation: "%s\n\nThese binaries are over %d MB and are not tracked by Git LFS.\nOnce committed they stay in history and slow down every clone.\n\nTrack them from Config → Git LFS, or commit anyway?",
+		YesLabel:    "Commit anyway",
+		NoLabel:     "Cancel",
+	},
+	// Auth remediation (keys: "auth_" + git.AuthProblem; %[1]s = host or URL, %[2]s = git's error line)
+	"auth_host_key_unknown": {
+		Title:       "SSH host key not verified",
+		Explanation: "git said: %[2]s\n\n%[1]s is not in your known_hosts, and TIT runs ssh without a terminal so it cannot ask you to accept the key.\n\nRun `ssh -T git@%[1]s` once in a terminal, compare the fingerprint with the one your provider publishes, then retry.\n\nIf you have no SSH key set up yet, the SSH setup wizard creates one.",
+		YesLabel:    "SSH setup",
+		NoLabel:     "Back to log",
+	},
+	"auth_host_key_changed": {
+		Title:       "SSH host key has changed",
+		Explanation: "git said: %[2]s\n\nThe key %[1]s presents does not match your known_hosts. This happens when a provider rotates keys, but it is also what an interception looks like.\n\nCheck your provider's published fingerprints first. If they match, run `ssh-keygen -R %[1]s` in a terminal and reconnect to accept the new key.",
+		YesLabel:    "SSH setup",
+		NoLabel:     "Back to log",
+	},
+	"auth_publickey_denied": {
+		Title:       "SSH key rejected",
+		Explanation: "git said: %[2]s\n\n%[1]s refused every SSH key offered. Either no key is set up, or its public key has not been added to your account.\n\nThe SSH setup wizard creates a key and shows the public key to paste into your provider's settings.",
+		YesLabel:    "SSH setup",
+		NoLabel:     "Back to log",
+	},
+	"auth_credentials_missing": {
+		Title:       "HTTPS credentials missing",
+		Explanation: "git said: %[2]s\n\n%[1]s needs a username and password, and none is stored. TIT cannot prompt for them.\n\nConfigure a credential.helper, then run `git fetch` once in a terminal to enter and store your credentials.",
+		YesLabel:    "Set credential.helper",
+		NoLabel:     "Back to log",
+	},
+	"auth_token_required": {
+		Title:       "Access token required",
+		Explanation: "git said: %[2]s\n\n%[1]s no longer accepts account passwords over HTTPS (two-factor or token-only login).\n\nCreate a personal access token in your provider's settings. Configure a credential.helper, then run `git fetch` once in a terminal and enter the token as the password.",
+		YesLabel:    "Set credential.helper",
+		NoLabel:     "Back to log",
+	},
+	"auth_access_denied": {
+		Title:       "Access denied",
+		Explanation: "git said: %[2]s\n\nYou are signed in to %[1]s, but this account cannot access the repository (or cannot write to it).\n\nAsk the owner for access, or switch to the credentials of an account that has it.",
+		YesLabel:    "Fix credentials",
+		NoLabel:     "Back to log",
+	},
+	"auth_repo_not_found": {
+		Title:       "Repository not found",
+		Explanation: "git said: %[2]s\n\nNo repository answers at %[1]s. Check the URL for typos. Private repositories also report \"not found\" when your account has no access to them.",
+		YesLabel:    "Change URL",
+		NoLabel:     "Back to log",
+	},
 	"force_push": {
 		Title:       "Force Push Confirmation",
-		Explanation: "This will force push to remote, overwriting remote history.\n\nAny commits on the remote that you don't have locally will be permanently lost.\n\nContinue?",
+		Explanation: "This will force push to %s, overwriting remote history.\n\nAny commits on the remote that you don't have locally will be permanently lost.\nThe push is refused if the remote moved since your last fetch.\n\nContinue?",
+		YesLabel:    "Force push",
+		NoLabel:     "Cancel",
+	},
+	"force_push_safe": {
+		Title:       "Force Push Confirmation",
+		Explanation: "This will force push to %s.\n\nThe remote has no commits you don't have locally, so nothing is lost.\nThe push is refused if the remote moved since your last fetch.\n\nContinue?",
 		YesLabel:    "Force push",
 		NoLabel:     "Cancel",
 	},
+	"force_push_lost": {
+		Title:       "Force Push Confirmation",
+		Explanation: "This will force push to %s, overwriting remote history.\n\nThese %d remote commit(s) will be permanently lost:\n\n%s\n\nThe push is refused if the remote moved since your last fetch.\n\nContinue?",
+		YesLabel:    "Force push",
+		NoLabel:     "Cancel",
+	},
+	"canon_force_push": {
+		Title:       "Force push canon branch %s?",
+		Explanation: "%s is the canon branch: it only receives work delivered from %s.\n\nRewriting its remote history affects everyone who builds on it.\nThe regular force push confirmation follows.",
+		YesLabel:    "Continue",
+		NoLabel:     "Cancel",
+	},
+	"canon_commit": {
+		Title:       "Commit directly to canon branch %s?",
+		Explanation: "%s is the canon branch. Work normally goes to %s and is delivered from there.\n\nCommit on the canon branch anyway?",
+		YesLabel:    "Commit anyway",
+		NoLabel:     "Cancel",
+	},
+	"workflow_deliver": {
+		Title:       "Deliver %s into %s?",
+		Explanation: "%s will be fast-forwarded by %d commit(s) from %s and pushed to origin.\n\nYou stay on the working branch afterwards.",
+		YesLabel:    "Deliver",
+		NoLabel:     "Cancel",
+	},
+	"workflow_deliver_local": {
+		Title:       "Deliver %s into %s?",
+		Explanation: "%s will be fast-forwarded by %d commit(s) from %s.\n\nYou stay on the working branch afterwards.",
+		YesLabel:    "Deliver",
+		NoLabel:     "Cancel",
+	},
 	"hard_reset": {
 		Title:       "Replace Local Confirmation",
 		Explanation: "This will discard all local changes and commits, resetting to match the remote exactly.\n\nAll uncommitted changes and untracked files will be permanently lost.\n\nContinue?",
@@ -105,6 +196,12 @@ var ConfirmationMessages = map[string]ConfirmationMessage{
 		YesLabel:    "Discard changes",
 		NoLabel:     "Cancel",
 	},
+	"branch_prune": {
+		Title:       "Delete %d merged or gone branches?",
+		Explanation: "%s\n\nThese local branches are merged into %s or their remote branch was deleted.\nGone branches may hold unmerged commits; they are deleted too.\n\nContinue?",
+		YesLabel:    "Delete",
+		NoLabel:     "Cancel",
+	},
 	"remove_remote": {
 		Title:       "Remove Remote",
 		Explanation: "This will remove the origin remote from your repository.\n\nYou can add a new remote later.\n\nContinue?",
@@ -5,6 +5,12 @@ var ErrorMessages = map[string]string{
 	"cwd_read_failed":          "Failed to get current directory",
 	"operation_failed":         "Operation failed",
 	"branch_name_empty":        "Branch name cannot be empty",
+	"lfs_pattern_empty":        "LFS pattern cannot be empty",
+	"clone_depth_invalid":      "Depth must be a whole number of commits (empty = full history)",
+	"sparse_parent_chosen":     "Included by %s/ — deselect the parent directory first",
+	"sparse_not_enabled":       "Sparse checkout is not enabled: the full tree is already checked out",
+	"sparse_tree_failed":       "Failed to read repository tree: %v",
+	"sparse_patterns_failed":   "Failed to read sparse-checkout patterns: %v",
 	"commit_message_empty":     "Commit message cannot be empty",
 	"remote_url_empty":         "Remote URL cannot be empty",
 	"remote_already_exists":    "Remote 'origin' already exists",
@@ -40,12 +46,70 @@ var ErrorMessages = map[string]string{
 	"failed_get_stash_list":            "Failed to get stash list",
 	"failed_write_time_travel_info":    "Failed to write time travel info: %v",
 	"failed_load_time_travel_info":     "Error: %v",
+	"time_travel_hop_dirty":            "Commit or discard your changes before moving to another commit (Return keeps them)",
+	"bookmark_missing":                 "Bookmark %s points to a commit that no longer exists",
+	"bookmark_save_failed":             "Failed to save bookmark: %v",
+	"bookmark_load_failed":             "Bookmarks ignored: %v",
 
-	// Rewind (reset --hard) errors
-	"branch_name_invalid":      "Invalid branch name: %s",
-	"branch_already_exists":    "Branch '%s' already exists",
-	"merge_branch_failed":      "Failed to merge branch: %s",
+	// Repository commands ([[command]] in .tit.toml)
+	"repo_commands_load_failed":   "Repository commands ignored: %v",
+	"repo_command_record_failed":  "Failed to record command result: %v",
+	"repo_command_results_failed": "Command results ignored: %v",
+	"repo_command_aborted":        "%s aborted: no result recorded",
+
+	// Bisect errors
+	"bisect_not_normal":    "Bisect starts from a branch with no operation in progress",
+	"bisect_range_invalid": "Good commit %s is not an ancestor of bad commit %s",
+	"bisect_start_failed":  "Failed to start bisect: %s",
+	"bisect_stash_failed":  "Failed to stash changes before bisecting: %v",
+
+	// Compare errors
+	"compare_failed":      "Failed to compare %s and %s: %v",
+	"compare_diff_failed": "Failed to load diff: %v",
+
+	// SSH setup and credential helper errors
+	"ssh_alias_invalid":        "Host alias: letters, digits, '.', '_' or '-' (e.g. github.com-work)",
+	"credential_helper_empty":  "credential.helper cannot be empty",
+	"credential_helper_failed": "Failed to set credential.helper: %v",
+
+	// Git identity errors
+	"identity_email_invalid": "Enter an email address (e.g. you@example.com)",
+	"identity_apply_failed":  "Could not apply identity profile '%s': %v",
+
+	// Dashboard and project switcher errors
+	"dashboard_open_failed":    "Cannot open %s: %v",
+	"project_switch_failed":    "Cannot switch to %s: %v",
+	"recent_repos_save_failed": "Failed to save recent repositories: %v",
 
+	// Branch picker errors
+	"branch_name_invalid":     "Invalid branch name: %s",
+	"branch_already_exists":   "Branch '%s' already exists",
+	"merge_branch_failed":     "Failed to merge branch: %s",
+	"branch_remote_read_only": "Remote branches are changed by pushing; Enter creates a local tracking branch",
+	"branch_tag_read_only":    "Tags are read-only here; Enter marks the tag for compare",
+	"branch_upstream_empty":   "Upstream cannot be empty (e.g. origin/main)",
+
+	// Canon/working workflow errors
+	"workflow_canon_missing":  "No local branch named %s",
+	"workflow_same_branch":    "Working branch must differ from canon (leave empty to work on canon)",
+	"workflow_save_failed":    "Failed to save canon/working branches: %v",
+	"workflow_deliver_not_ff": "%s has commits %s lacks: update from canon first",
+	"workflow_push_failed":    "%s was delivered locally but the push failed; push it from the branch picker or retry",
+
+	// Preferences errors
+	"diff_layout_save_failed": "Diff layout changed for this session only: %v",
+
+	// Force push errors
+	"force_push_lease_stale": "Remote moved since the last fetch; nothing was overwritten. Fetched again: review the new state before forcing",
+
+	// Destructive-action policy errors (.tit.toml [policy])
+	"policy_load_failed":         "Repository policy ignored: %v",
+	"policy_confirm_mismatch":    "Type %s exactly to confirm, or ESC to cancel",
+	"policy_hidden":              "Policy: not allowed on protected branch %s",
+	"policy_rewind_limit":        "Policy: rewinding %d commits exceeds the limit of %d",
+	"policy_rewind_count_failed": "Policy: rewind refused, could not count the commits it drops: %v",
+
+	// Rewind (reset --hard) errors
 	"rewind_commit_hash_empty": "Commit hash cannot be empty",
 	"rewind_failed":            "Reset failed: %s",
 	// Timeline sync errors
@@ -71,6 +71,9 @@ var FooterHintShortcuts = map[string][]ui.FooterShortcut{
 		{Key: "↑↓", Desc: "navigate"},
 		{Key: "Enter", Desc: "time travel"},
 		{Key: "y", Desc: "copy hash"},
+		{Key: "b", Desc: "bookmark"},
+		{Key: "G/B", Desc: "bisect good/bad"},
+		{Key: "c", Desc: "compare"},
 		{Key: "Tab", Desc: "details"},
 		{Key: "Esc", Desc: "back"},
 	},
@@ -99,6 +102,7 @@ var FooterHintShortcuts = map[string][]ui.FooterShortcut{
 	"filehistory_diff": {
 		{Key: "↑↓", Desc: "scroll"},
 		{Key: "v", Desc: "visual"},
+		{Key: "s", Desc: "split/unified"},
 		{Key: "Tab", Desc: "commits"},
 		{Key: "Esc", Desc: "back"},
 	},
@@ -108,6 +112,33 @@ var FooterHintShortcuts = map[string][]ui.FooterShortcut{
 		{Key: "Esc", Desc: "cancel"},
 	},
 
+	// Compare mode
+	"compare_commits": {
+		{Key: "↑↓", Desc: "navigate"},
+		{Key: "m", Desc: "two/three-dot"},
+		{Key: "Tab", Desc: "next pane"},
+		{Key: "Esc", Desc: "back"},
+	},
+	"compare_files": {
+		{Key: "↑↓", Desc: "navigate"},
+		{Key: "m", Desc: "two/three-dot"},
+		{Key: "Tab", Desc: "diff"},
+		{Key: "Esc", Desc: "back"},
+	},
+	"compare_diff": {
+		{Key: "↑↓", Desc: "scroll"},
+		{Key: "v", Desc: "visual"},
+		{Key: "y", Desc: "copy line"},
+		{Key: "s", Desc: "split/unified"},
+		{Key: "Tab", Desc: "commits"},
+		{Key: "Esc", Desc: "back"},
+	},
+	"compare_visual": {
+		{Key: "↑↓", Desc: "extend"},
+		{Key: "y", Desc: "yank"},
+		{Key: "Esc", Desc: "cancel"},
+	},
+
 	// Conflict Resolver
 	"conflict_list": {
 		{Key: "↑↓", Desc: "navigate"},
@@ -117,6 +148,7 @@ var FooterHintShortcuts = map[string][]ui.FooterShortcut{
 	},
 	"conflict_diff": {
 		{Key: "↑↓", Desc: "scroll"},
+		{Key: "s", Desc: "diff/plain"},
 		{Key: "Tab", Desc: "list"},
 		{Key: "Esc", Desc: "back"},
 	},
@@ -141,6 +173,11 @@ var FooterHintShortcuts = map[string][]ui.FooterShortcut{
 		{Key: "Esc", Desc: "clear"},
 	},
 
+	"setup_choice": {
+		{Key: "↑↓", Desc: "select"},
+		{Key: "Enter", Desc: "continue"},
+	},
+
 	// Confirmation
 	"confirmation": {
 		{Key: "←→", Desc: "select"},
@@ -152,6 +189,10 @@ var FooterHintShortcuts = map[string][]ui.FooterShortcut{
 	"branch_picker_current": {
 		{Key: "↑↓", Desc: "navigate"},
 		{Key: "a", Desc: "add"},
+		{Key: "r", Desc: "rename"},
+		{Key: "u", Desc: "upstream"},
+		{Key: "X", Desc: "prune"},
+		{Key: "c", Desc: "compare"},
 		{Key: "Enter", Desc: "switch"},
 		{Key: "Esc", Desc: "cancel"},
 	},
@@ -161,9 +202,89 @@ var FooterHintShortcuts = map[string][]ui.FooterShortcut{
 		{Key: "a", Desc: "add"},
 		{Key: "m", Desc: "merge from"},
 		{Key: "x", Desc: "delete"},
+		{Key: "r", Desc: "rename"},
+		{Key: "u", Desc: "upstream"},
+		{Key: "X", Desc: "prune"},
+		{Key: "c", Desc: "compare"},
 		{Key: "Enter", Desc: "switch"},
 		{Key: "Esc", Desc: "cancel"},
 	},
+	// Branch Picker — remote branch selected
+	"branch_picker_remote": {
+		{Key: "↑↓", Desc: "navigate"},
+		{Key: "Enter", Desc: "track locally"},
+		{Key: "m", Desc: "merge from"},
+		{Key: "X", Desc: "prune"},
+		{Key: "c", Desc: "compare"},
+		{Key: "Esc", Desc: "cancel"},
+	},
+	// Branch Picker — picking the two sides of a compare (branches and tags)
+	"branch_picker_compare": {
+		{Key: "↑↓", Desc: "navigate"},
+		{Key: "Enter", Desc: "mark side"},
+		{Key: "Esc", Desc: "cancel"},
+	},
+
+	// Untracked Triage — untracked file or pattern group selected
+	"untracked_triage": {
+		{Key: "↑↓", Desc: "navigate"},
+		{Key: "a", Desc: "stage"},
+		{Key: "i", Desc: "ignore"},
+		{Key: "e", Desc: "ignore ext"},
+		{Key: "d", Desc: "ignore dir"},
+		{Key: "x", Desc: "exclude locally"},
+		{Key: "Esc", Desc: "back"},
+	},
+	// Untracked Triage — ignored path selected (explanation only)
+	"untracked_triage_ignored": {
+		{Key: "↑↓", Desc: "navigate"},
+		{Key: "Esc", Desc: "back"},
+	},
+
+	// LFS — tracked pattern selected
+	"lfs_pattern": {
+		{Key: "↑↓", Desc: "navigate"},
+		{Key: "a", Desc: "track"},
+		{Key: "x", Desc: "untrack"},
+		{Key: "m", Desc: "migrate"},
+		{Key: "Esc", Desc: "back"},
+	},
+	// LFS — file selected
+	"lfs_file": {
+		{Key: "↑↓", Desc: "navigate"},
+		{Key: "a", Desc: "track"},
+		{Key: "l", Desc: "lock"},
+		{Key: "u", Desc: "unlock"},
+		{Key: "Esc", Desc: "back"},
+	},
+
+	// Sparse checkout
+	"sparse_checkout": {
+		{Key: "↑↓", Desc: "navigate"},
+		{Key: "←→", Desc: "collapse/expand"},
+		{Key: "Space", Desc: "toggle"},
+		{Key: "Enter", Desc: "apply"},
+		{Key: "F", Desc: "full checkout"},
+		{Key: "Esc", Desc: "back"},
+	},
+
+	// Dashboard (tit dash)
+	"dashboard": {
+		{Key: "↑↓", Desc: "navigate"},
+		{Key: "Enter", Desc: "open"},
+		{Key: "f", Desc: "fetch all"},
+		{Key: "p", Desc: "pull clean+behind"},
+		{Key: "r", Desc: "refresh"},
+		{Key: "q", Desc: "quit"},
+	},
+
+	// Project switcher
+	"project_switcher": {
+		{Key: "type", Desc: "filter"},
+		{Key: "↑↓", Desc: "navigate"},
+		{Key: "Enter", Desc: "switch"},
+		{Key: "Esc", Desc: "back"},
+	},
 
 	// Preferences
 	"preferences": {
@@ -7,6 +7,8 @@ var OutputMessages = map[string]string{
 	"pushed_successfully":          "Pushed successfully",
 	"pulled_successfully":          "Pulled successfully",
 	"initializing_repo":            "Initializing repository...",
+	"workflow_delivering":          "Delivering %s into %s...",
+	"workflow_delivered":           "Delivered %s into %s",
 	"fetching_remote":              "Fetching from remote...",
 	"setting_upstream":             "Setting upstream tracking...",
 	"detecting_conflicts":          "Detecting conflict files...",
@@ -111,9 +113,95 @@ var ConsoleMessages = map[string]string{
 	// File history visual mode
 	"visual_mode_active": "-- VISUAL --",
 
+	// Diff layout toggle (file history, compare, conflict resolver)
+	"diff_layout_split":   "Side-by-side diff",
+	"diff_layout_unified": "Unified diff",
+
 	// Clipboard
 	"copy_success": "✓ Copied to clipboard",
 	"copy_failed":  "✗ Copy failed",
+
+	// Remote connectivity test (keys: "probe_" + git.RemoteProbeStatus)
+	"probe_testing":     "Testing connection to %s...",
+	"probe_ok":          "Remote reachable. Default branch: %s",
+	"probe_empty":       "Remote reachable. Repository is empty (no commits yet)",
+	"probe_auth_failed": "Authentication failed. Check that your SSH key is added to the host, or your HTTPS credentials/token",
+	"probe_host_key":    "SSH host key is unknown or has changed. Run `ssh -T %s` once in a terminal to verify and accept it",
+	"probe_not_found":   "Repository not found. Check the path and that your account has access to it",
+	"probe_unreachable": "Host unreachable. Check the host name and your network connection",
+	"probe_timeout":     "No answer within %s. The host or network is not responding",
+	"probe_failed":      "Connectivity test failed",
+	"probe_not_saved":   "Remote was not saved",
+
+	// SSH setup verification hints (keys: "ssh_verify_" + git.AuthProblem)
+	"ssh_verify_publickey_denied": "The host rejected the key. Check that the public key was added to your account, then retry",
+	"ssh_verify_host_key_changed": "The host key differs from known_hosts. Compare it with your provider's published fingerprints before removing the old entry",
+	"ssh_verify_access_denied":    "The key is known but this account has no access",
+
+	// Credential helper (auth remediation)
+	"credential_helper_set": "credential.helper set to %s. Run `git fetch` once in a terminal to store your credentials",
+
+	// Identity profiles (config.toml [[identity]])
+	"identity_applied": "Identity profile '%s' applied: commits here are authored as %s",
+
+	// Dashboard (tit dash)
+	"dashboard_summary":         "%d repositories",
+	"dashboard_fetch_running":   "Fetching %d repositories...",
+	"dashboard_pull_running":    "Fast-forwarding %d repositories...",
+	"dashboard_batch_done":      "%s: %d succeeded, %d failed",
+	"dashboard_no_remote":       "No repository has a remote to fetch",
+	"dashboard_nothing_to_pull": "No clean repository is behind its remote",
+
+	// Branch picker
+	"branch_tracking_created": "Created %s tracking %s",
+	"branch_already_tracked":  "%s is already tracked by %s",
+	"branch_renamed":          "Renamed %s to %s",
+	"branch_upstream_set":     "%s now tracks %s",
+	"branch_upstream_unset":   "%s no longer tracks %s",
+	"branch_prune_fetching":   "Fetching with --prune...",
+	"branch_prune_none":       "No merged or gone branches to delete",
+	"branch_prune_done":       "Deleted %d branches",
+
+	// Canon/working workflow
+	"workflow_saved":      "Canon %s, working %s",
+	"workflow_canon_only": "Canon %s, no working branch",
+
+	// Force push preview
+	"force_push_more": "  …and %d more",
+
+	// Destructive-action policy
+	"policy_typed_hint":    "Protected branch: type %s to confirm",
+	"policy_discard_limit": "Policy: %d changed files exceed the discard limit of %d",
+
+	// Time travel trail and bookmarks
+	"time_travel_back_status": "Jumping back one hop... (ESC to abort)",
+	"bookmark_travel_hint":    "Time travel to bookmarked commit %s",
+	"bookmark_saved":          "Bookmarked %s as %s",
+	"bookmark_removed":        "Removed bookmark %s",
+
+	// Repository commands run at time travel commits
+	"repo_command_hint":       "Run %s at this commit",
+	"repo_command_status":     "Running %s at %s... (ESC to abort)",
+	"repo_command_start":      "$ %s",
+	"repo_command_passed":     "%s passed in %s",
+	"repo_command_failed":     "%s failed (exit %d) after %s",
+	"repo_command_left_files": "The run left %d untracked file(s): the tree is dirty, so hops are blocked and Return offers to carry them. Ignore build output in .gitignore or .git/info/exclude",
+
+	// Bisect
+	"bisect_marked":        "Marked %s %s · now mark the %s commit (%s)",
+	"bisect_start_status":  "Starting bisect... (ESC to abort)",
+	"bisect_mark_status":   "Marking this commit %s...",
+	"bisect_run_status":    "Running test command on each step... (ESC to abort)",
+	"bisect_finish_status": "Ending bisect, returning to %s...",
+	"bisect_next":          "Testing %s · %d commit(s) left, ~%d step(s)",
+	"bisect_culprit":       "First bad commit: %s %s",
+	"bisect_culprit_by":    "  %s · %s",
+
+	// Compare
+	"compare_marked":       "Marked %s for compare · now mark the other side (c)",
+	"compare_unmarked":     "Cleared compare mark %s",
+	"compare_range":        "Comparing %s%s%s",
+	"compare_picker_title": "Select two branches or tags to compare",
 }
 
 // StateDescriptions centralizes git state display descriptions
@@ -122,12 +210,25 @@ var StateDescriptions = map[string]string{
 	"working_tree_clean": "No local changes",
 	"working_tree_dirty": "Local changes present",
 
+	// Working Tree breakdown (joined with " · " in the header)
+	"working_tree_staged":     "%d staged",
+	"working_tree_unstaged":   "%d unstaged",
+	"working_tree_untracked":  "%d untracked",
+	"working_tree_renamed":    "%d renamed",
+	"working_tree_deleted":    "%d deleted",
+	"working_tree_conflicted": "%d conflicted",
+
 	// Timeline (4 descriptions)
 	"timeline_in_sync":  "In sync with remote",
 	"timeline_ahead":    "%d commit(s) ahead",
 	"timeline_behind":   "%d commit(s) behind",
 	"timeline_diverged": "%d↑ %d↓",
 
+	// Canon/working workflow (appended to the timeline description)
+	"workflow_behind": "%d commit(s) behind canon %s",
+	"workflow_ahead":  "%d commit(s) to deliver to %s",
+	"workflow_even":   "Even with canon %s",
+
 	// Operation (7 descriptions)
 	"operation_normal":      "Ready",
 	"operation_not_repo":    "Not a repository",
@@ -136,4 +237,10 @@ var StateDescriptions = map[string]string{
 	"operation_rebasing":    "Rebase in progress",
 	"operation_dirty_op":    "Operation started with local changes",
 	"operation_time_travel": "Viewing commit %s (%s)",
+	"operation_bisecting":   "Bisect in progress",
+
+	// Bisect progress (timeline description while bisecting)
+	"bisect_range":    "good %s … bad %s · %d left, ~%d step(s)",
+	"bisect_found":    "First bad commit %s",
+	"bisect_awaiting": "Waiting for good and bad commits",
 }
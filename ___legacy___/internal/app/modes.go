@@ -15,6 +15,12 @@ package app
 // - ModeClone*: Git repository cloning workflows
 // - ModeFileHistory: File-specific history browsing
 // - ModeSetupWizard: First-time setup and configuration
+// - ModeUntrackedTriage: Untracked-file triage (stage or ignore)
+// - ModeLFS: Git LFS management (track patterns, locks, migration)
+// - ModeSparseCheckout: Sparse-checkout cone editor (directory tree, size estimate)
+// - ModeDashboard: Multi-repository dashboard (`tit dash`)
+// - ModeProjectSwitcher: Fuzzy-find a recent repository and switch into it
+// - ModeCompare: Compare two commits, branches or tags (range diff)
 
 type AppMode int
 
@@ -37,6 +43,13 @@ const (
 	ModeBranchPicker       // Branch selection with details pane
 	ModePreferences        // Preferences editor (auto-update, theme)
 	ModeStartup            // Blocking startup state: remote fetch in flight, menu not yet actionable
+	ModeUntrackedTriage    // Untracked-file triage: stage, .gitignore, or exclude grouped paths
+	ModeLFS                // Git LFS: tracked patterns, pointer/materialized files, locks
+	ModeCloneOptions       // Clone options: depth, single-branch, branch, blob filter, sparse cone
+	ModeSparseCheckout     // Sparse checkout: toggle directories of HEAD's tree, apply cone
+	ModeDashboard          // Multi-repository dashboard: state table, batch fetch/pull, open a repository
+	ModeProjectSwitcher    // Project switcher: fuzzy-find recent repositories, Enter switches into one
+	ModeCompare            // Compare two commits, branches or tags: commits unique to each side, changed files, per-file diff
 )
 
 // SetupWizardStep represents the current step in the setup wizard
@@ -45,9 +58,17 @@ type SetupWizardStep int
 const (
 	SetupStepWelcome       SetupWizardStep = iota // Welcome message
 	SetupStepPrerequisites                        // Check git + ssh installed
+	SetupStepProvider                             // Choose GitHub, GitLab, Bitbucket or another host
+	SetupStepHostAlias                            // Input ~/.ssh/config Host alias (per-account) or host name
+	SetupStepKeyChoice                            // Reuse an existing key or generate a new one
+	SetupStepKeyType                              // Choose ed25519 (default) or RSA
 	SetupStepEmail                                // Input email for key comment
+	SetupStepPassphrase                           // Input optional passphrase (new or existing encrypted key)
 	SetupStepGenerate                             // Generate SSH key + agent + config
 	SetupStepDisplayKey                           // Show public key + provider URLs
+	SetupStepVerify                               // ssh -T against the chosen host
+	SetupStepIdentityName                         // Input user.name when git has no identity
+	SetupStepIdentityEmail                        // Input user.email when git has no identity
 	SetupStepComplete                             // Setup complete
 	SetupStepError                                // Error display step
 )
@@ -58,12 +79,28 @@ func (s SetupWizardStep) String() string {
 		return "welcome"
 	case SetupStepPrerequisites:
 		return "prerequisites"
+	case SetupStepProvider:
+		return "provider"
+	case SetupStepHostAlias:
+		return "host_alias"
+	case SetupStepKeyChoice:
+		return "key_choice"
+	case SetupStepKeyType:
+		return "key_type"
 	case SetupStepEmail:
 		return "email"
+	case SetupStepPassphrase:
+		return "passphrase"
 	case SetupStepGenerate:
 		return "generate"
 	case SetupStepDisplayKey:
 		return "display_key"
+	case SetupStepVerify:
+		return "verify"
+	case SetupStepIdentityName:
+		return "identity_name"
+	case SetupStepIdentityEmail:
+		return "identity_email"
 	case SetupStepComplete:
 		return "complete"
 	case SetupStepError:
@@ -190,6 +227,48 @@ var modeDescriptions = map[AppMode]ModeMetadata{
 		AcceptsInput: false,
 		IsAsync:      true,
 	},
+	ModeUntrackedTriage: {
+		Name:         "untracked_triage",
+		Description:  "Untracked files grouped by pattern with stage/ignore/exclude actions and pattern preview",
+		AcceptsInput: true,
+		IsAsync:      false,
+	},
+	ModeLFS: {
+		Name:         "lfs",
+		Description:  "Git LFS manager: track/untrack patterns, pointer vs materialized files, locks, history migration",
+		AcceptsInput: true,
+		IsAsync:      false,
+	},
+	ModeCloneOptions: {
+		Name:         "clone_options",
+		Description:  "Menu of clone options (depth, single-branch, branch, blob:none filter, sparse cone) before cloning",
+		AcceptsInput: true,
+		IsAsync:      false,
+	},
+	ModeSparseCheckout: {
+		Name:         "sparse_checkout",
+		Description:  "Sparse-checkout editor: current cone/patterns, directory tree toggles with live file/size estimate",
+		AcceptsInput: true,
+		IsAsync:      false,
+	},
+	ModeDashboard: {
+		Name:         "dashboard",
+		Description:  "Multi-repository dashboard: 5-axis state per repository, batch fetch and fast-forward, Enter opens a repository",
+		AcceptsInput: true,
+		IsAsync:      false,
+	},
+	ModeProjectSwitcher: {
+		Name:         "project_switcher",
+		Description:  "Project switcher: type to fuzzy-find a recently opened repository, Enter re-runs startup there",
+		AcceptsInput: true,
+		IsAsync:      false,
+	},
+	ModeCompare: {
+		Name:         "compare",
+		Description:  "Compare two commits, branches or tags: commits unique to each side, changed files with stats, per-file diff (two-dot or three-dot)",
+		AcceptsInput: true,
+		IsAsync:      false,
+	},
 }
 
 // GetModeMetadata returns metadata for the given AppMode
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jrengmusic/tit/internal/git"
+	"github.com/jrengmusic/tit/internal/ui"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// cmdBisectStart stashes uncommitted work, starts bisecting good..bad and, when
+// testCmd is given, lets `git bisect run` judge every step
+func (a *Application) cmdBisectStart(good, bad, testCmd string) tea.Cmd {
+	originalBranch := a.gitState.CurrentBranch
+	dirty := a.gitState.WorkingTree == git.Dirty
+	ctx, cancel := context.WithCancel(context.Background())
+	a.OperationState.cancelContext = cancel
+	return func() tea.Msg {
+		if dirty {
+			if err := git.StashBisectDeparture(originalBranch); err != nil {
+				return GitOperationMsg{Step: OpBisectStart, Success: false, Error: fmt.Sprintf(ErrorMessages["bisect_stash_failed"], err)}
+			}
+		}
+		result := git.ExecuteWithStreaming(ctx, "bisect", "start", bad, good)
+		if !result.Success {
+			return GitOperationMsg{Step: OpBisectStart, Success: false, Error: fmt.Sprintf(ErrorMessages["bisect_start_failed"], consoleStderr())}
+		}
+		if testCmd == "" {
+			return GitOperationMsg{Step: OpBisect, Success: true}
+		}
+		result = git.ExecuteWithStreaming(ctx, "bisect", "run", "sh", "-c", testCmd)
+		if !result.Success {
+			return GitOperationMsg{Step: OpBisect, Success: false, Error: result.Stderr}
+		}
+		return GitOperationMsg{Step: OpBisect, Success: true}
+	}
+}
+
+// cmdBisectMark records a good/bad/skip verdict for HEAD
+func (a *Application) cmdBisectMark(verdict string) tea.Cmd {
+	return a.executeGitOp(OpBisect, "bisect", verdict)
+}
+
+// cmdBisectRun hands the running session to testCmd (exit 0 good, 125 skip, other bad)
+func (a *Application) cmdBisectRun(testCmd string) tea.Cmd {
+	return a.executeGitOp(OpBisect, "bisect", "run", "sh", "-c", testCmd)
+}
+
+// finishBisect ends the session in the console, keeping any output already shown
+func (a *Application) finishBisect() tea.Cmd {
+	originalBranch := a.gitState.CurrentBranch
+	if a.mode != ModeConsole {
+		a.consoleState.Reset()
+		a.mode = ModeConsole
+	}
+	a.footerHint = fmt.Sprintf(ConsoleMessages["bisect_finish_status"], originalBranch)
+	a.workflowState.PreviousMode = ModeMenu
+	a.workflowState.PreviousMenuIndex = 0
+	return git.ExecuteBisectFinish()
+}
+
+// handleBisectStep handles OpBisect: reports the next commit to test, or the
+// culprit once git has found it and then restores the original branch
+func (a *Application) handleBisectStep(msg GitOperationMsg) (tea.Model, tea.Cmd) {
+	buffer := ui.GetBuffer()
+	if err := a.reloadGitState(); err != nil {
+		buffer.Append(fmt.Sprintf(ErrorMessages["failed_detect_state"], err), ui.TypeStderr)
+		a.EndAsyncOp()
+		return a, nil
+	}
+
+	progress := a.gitState.Bisect
+	if progress != nil && progress.Culprit != "" {
+		a.reportBisectCulprit(progress.Culprit)
+		a.EndAsyncOp()
+		return a, a.finishBisect()
+	}
+
+	buffer.Append(GetFooterMessageText(MessageOperationComplete), ui.TypeInfo)
+	a.footerHint = GetFooterMessageText(MessageOperationComplete)
+	if progress != nil && progress.Good != "" && progress.Bad != "" {
+		a.footerHint = fmt.Sprintf(ConsoleMessages["bisect_next"], a.gitState.CurrentHash, progress.Remaining, progress.Steps)
+	}
+	a.EndAsyncOp()
+	return a, nil
+}
+
+// handleBisectStartFailure handles a failed OpBisectStart: nothing to bisect,
+// so undo whatever was set up (session, stash) right away
+func (a *Application) handleBisectStartFailure(msg GitOperationMsg) (tea.Model, tea.Cmd) {
+	buffer := ui.GetBuffer()
+	buffer.Append(msg.Error, ui.TypeStderr)
+	buffer.Append(GetFooterMessageText(MessageOperationFailed), ui.TypeInfo)
+	a.EndAsyncOp()
+	if err := a.reloadGitState(); err != nil {
+		buffer.Append(fmt.Sprintf(ErrorMessages["failed_detect_state"], err), ui.TypeStderr)
+	}
+	return a, a.finishBisect()
+}
+
+// reportBisectCulprit appends the first bad commit's hash, subject, author and date to the console
+func (a *Application) reportBisectCulprit(hash string) {
+	buffer := ui.GetBuffer()
+	result := git.Execute("show", "-s", "--format=%s%n%an <%ae>%n%ad", "--date=format:%Y-%m-%d %H:%M", hash)
+	lines := strings.SplitN(strings.TrimSpace(result.Stdout), "\n", 3)
+	if !result.Success || len(lines) < 3 {
+		buffer.Append(fmt.Sprintf(ConsoleMessages["bisect_culprit"], hash, ""), ui.TypeInfo)
+		return
+	}
+	buffer.Append(fmt.Sprintf(ConsoleMessages["bisect_culprit"], hash, lines[0]), ui.TypeInfo)
+	buffer.Append(fmt.Sprintf(ConsoleMessages["bisect_culprit_by"], lines[1], lines[2]), ui.TypeInfo)
+}
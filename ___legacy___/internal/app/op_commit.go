@@ -46,6 +46,32 @@ func (a *Application) cmdCommit(message string) tea.Cmd {
 	}
 }
 
+// cmdCommitStaged commits only what is already in the index
+func (a *Application) cmdCommitStaged(message string) tea.Cmd {
+	msg := message // Capture in closure
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancelContext = cancel
+	return func() tea.Msg {
+		buffer := ui.GetBuffer()
+		buffer.Clear()
+
+		result := git.ExecuteWithStreaming(ctx, "commit", "-m", msg)
+		if !result.Success {
+			return GitOperationMsg{
+				Step:    OpCommit,
+				Success: false,
+				Error:   "Failed to commit",
+			}
+		}
+
+		return GitOperationMsg{
+			Step:    OpCommit,
+			Success: true,
+			Output:  "Staged changes committed successfully",
+		}
+	}
+}
+
 // cmdCommitPush stages, commits, and pushes in one operation
 func (a *Application) cmdCommitPush(message string) tea.Cmd {
 	msg := message // Capture in closure
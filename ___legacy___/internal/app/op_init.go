@@ -10,9 +10,11 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 )
 
-// cmdInit executes `git init`, creates initial branch, and commits .gitignore
-func (a *Application) cmdInit(branchName string) tea.Cmd {
+// cmdInit executes `git init`, creates initial branch, and commits .gitignore.
+// A non-empty working branch is created on top and recorded with canon as the workflow.
+func (a *Application) cmdInit(branchName, workingBranch string) tea.Cmd {
 	name := branchName // Capture in closure
+	working := workingBranch
 	ctx, cancel := context.WithCancel(context.Background())
 	a.cancelContext = cancel
 	return func() tea.Msg {
@@ -66,10 +68,34 @@ func (a *Application) cmdInit(branchName string) tea.Cmd {
 			}
 		}
 
+		if working == "" {
+			return GitOperationMsg{
+				Step:    OpInit,
+				Success: true,
+				Output:  fmt.Sprintf("Repository initialized with branch '%s'", name),
+			}
+		}
+
+		result = git.ExecuteWithStreaming(ctx, "checkout", "-b", working)
+		if !result.Success {
+			return GitOperationMsg{
+				Step:    OpInit,
+				Success: false,
+				Error:   "Failed to create working branch",
+			}
+		}
+		if err := git.SaveWorkflow(git.BranchWorkflow{Canon: name, Working: working}); err != nil {
+			return GitOperationMsg{
+				Step:    OpInit,
+				Success: false,
+				Error:   fmt.Sprintf(ErrorMessages["workflow_save_failed"], err),
+			}
+		}
+
 		return GitOperationMsg{
 			Step:    OpInit,
 			Success: true,
-			Output:  fmt.Sprintf("Repository initialized with branch '%s'", name),
+			Output:  fmt.Sprintf("Repository initialized with branch '%s', working on '%s'", name, working),
 		}
 	}
 }
@@ -23,6 +23,14 @@ func (a *Application) cmdPush() tea.Cmd {
 			result = git.ExecuteWithStreaming(ctx, "push", "--progress")
 		}
 		if !result.Success {
+			// Auth failures cannot be fixed by syncing: report them for remediation
+			if problem, _ := consoleAuthProblem(""); problem != git.AuthNone {
+				return GitOperationMsg{
+					Step:    OpPush,
+					Success: false,
+					Error:   ErrorMessages["operation_failed"],
+				}
+			}
 			// Push rejected - trigger auto sync flow
 			return GitOperationMsg{
 				Step:    OpPushSyncNeeded,
@@ -36,11 +44,23 @@ func (a *Application) cmdPush() tea.Cmd {
 	}
 }
 
-// cmdForcePush force-pushes current branch (use with caution).
-// Uses -u origin <branch> when no upstream tracking is set (first push to bare remote).
-func (a *Application) cmdForcePush() tea.Cmd {
-	if !a.gitState.LocalBranchOnRemote {
-		return a.executeGitOp(OpForcePush, "push", "--progress", "-u", "origin", a.gitState.CurrentBranch, "--force")
+// cmdForcePush force-pushes current branch with a lease on the remote-tracking tip the
+// confirmation resolved (git.ResolveForcePushTarget), so commits pushed since the last
+// fetch are never overwritten. Uses -u when no upstream tracking is set.
+// A lease rejection fetches the target remote again; the failure handler then reloads the refreshed state.
+func (a *Application) cmdForcePush(target git.ForcePushTarget) tea.Cmd {
+	args := git.ForcePushArgs(a.gitState.CurrentBranch, target)
+	ctx, cancel := context.WithCancel(context.Background())
+	a.OperationState.cancelContext = cancel
+	return func() tea.Msg {
+		result := git.ExecuteWithStreaming(ctx, args...)
+		if result.Success {
+			return GitOperationMsg{Step: OpForcePush, Success: true}
+		}
+		if git.IsLeaseRejected(consoleStderr()) {
+			git.ExecuteWithStreaming(ctx, "fetch", "--progress", target.Remote)
+			return GitOperationMsg{Step: OpForcePush, Success: false, Error: ErrorMessages["force_push_lease_stale"]}
+		}
+		return GitOperationMsg{Step: OpForcePush, Success: false, Error: result.Stderr}
 	}
-	return a.executeGitOp(OpForcePush, "push", "--progress", "--force")
 }
@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/jrengmusic/tit/internal/git"
@@ -27,6 +28,15 @@ func (a *Application) cmdAddRemote(url string) tea.Cmd {
 			branchName = strings.TrimSpace(branchResult.Stdout)
 		}
 
+		// Test the connection before anything is saved
+		if failure := testRemoteConnection(ctx, u); failure != "" {
+			return GitOperationMsg{
+				Step:    OpAddRemote,
+				Success: false,
+				Error:   failure,
+			}
+		}
+
 		// Add remote
 		result := git.ExecuteWithStreaming(ctx, "remote", "add", "origin", u)
 		if !result.Success {
@@ -46,6 +56,49 @@ func (a *Application) cmdAddRemote(url string) tea.Cmd {
 	}
 }
 
+// testRemoteConnection runs the ls-remote connectivity test before a remote is saved.
+// The diagnosis and default branch go to the console; returns the error to report
+// when the remote cannot be used, empty when it answered.
+func testRemoteConnection(ctx context.Context, url string) string {
+	git.Log(fmt.Sprintf(ConsoleMessages["probe_testing"], url))
+	probe := git.ProbeRemote(ctx, url, git.RemoteProbeTimeout)
+
+	switch probe.Status {
+	case git.ProbeOK:
+		branch := probe.DefaultBranch
+		if branch == "" {
+			branch = "(not advertised)"
+		}
+		git.Log(fmt.Sprintf(ConsoleMessages["probe_ok"], branch))
+		return ""
+	case git.ProbeEmpty:
+		git.Log(ConsoleMessages["probe_empty"])
+		return ""
+	}
+
+	for _, line := range strings.Split(probe.Detail, "\n") {
+		if line != "" {
+			git.Warn(line)
+		}
+	}
+
+	diagnosis := ConsoleMessages["probe_"+string(probe.Status)]
+	switch probe.Status {
+	case git.ProbeHostKey:
+		target := url
+		if parsed, err := git.ParseRemoteURL(url); err == nil {
+			target = parsed.Host
+			if parsed.User != "" {
+				target = parsed.User + "@" + parsed.Host
+			}
+		}
+		diagnosis = fmt.Sprintf(diagnosis, target)
+	case git.ProbeTimeout:
+		diagnosis = fmt.Sprintf(diagnosis, git.RemoteProbeTimeout)
+	}
+	return diagnosis + "\n" + ConsoleMessages["probe_not_saved"]
+}
+
 // cmdFetchRemote fetches from origin (step 2 of 3-step chain)
 func (a *Application) cmdFetchRemote() tea.Cmd {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -107,3 +160,39 @@ func (a *Application) cmdSetUpstream(branchName string) tea.Cmd {
 		}
 	}
 }
+
+// deepenCommits is how many older commits one "Deepen history" fetch adds
+const deepenCommits = 100
+
+// cmdDeepenHistory extends a shallow clone by deepenCommits past its boundary
+func (a *Application) cmdDeepenHistory() tea.Cmd {
+	return a.cmdShallowFetch(OpDeepenHistory, fmt.Sprintf("--deepen=%d", deepenCommits),
+		fmt.Sprintf("Fetched up to %d older commits", deepenCommits))
+}
+
+// cmdUnshallow converts a shallow clone into a complete one
+func (a *Application) cmdUnshallow() tea.Cmd {
+	return a.cmdShallowFetch(OpUnshallow, "--unshallow", "Fetched full history")
+}
+
+// cmdShallowFetch runs `git fetch --progress <flag>` with streaming output
+func (a *Application) cmdShallowFetch(step, flag, output string) tea.Cmd {
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancelContext = cancel
+	return func() tea.Msg {
+		result := git.ExecuteWithStreaming(ctx, "fetch", "--progress", flag)
+		if !result.Success {
+			return GitOperationMsg{
+				Step:    step,
+				Success: false,
+				Error:   result.Stderr,
+			}
+		}
+
+		return GitOperationMsg{
+			Step:    step,
+			Success: true,
+			Output:  output,
+		}
+	}
+}
@@ -34,6 +34,10 @@ const (
 	OpSetUpstream = "set_upstream"
 	OpCheckout    = "checkout"
 
+	// Shallow clone history operations
+	OpDeepenHistory = "deepen_history"
+	OpUnshallow     = "unshallow"
+
 	// Reset/discard operations
 	OpHardReset = "hard_reset"
 
@@ -54,12 +58,22 @@ const (
 	OpTimeTravelReturn     = "time_travel_return"
 	OpFinalizeTravelReturn = "finalize_time_travel_return"
 
+	// Repository command (build, test, ... from .tit.toml) at a time travel commit
+	OpRepoCommand = "repo_command"
+
+	// Bisect operations
+	OpBisectStart = "bisect_start"
+	OpBisect      = "bisect" // good/bad/skip verdict or bisect run
+
 	// Branch operations
 	OpBranchCreate = "branch_create"
 
 	OpMergeBranch         = "merge_branch"
 	OpFinalizeBranchMerge = "finalize_branch_merge"
 
+	// Canon/working workflow
+	OpDeliver = "deliver"
+
 	// Dirty merge (save changes before merge) operation phases
 	OpDirtyMergeSnapshot      = "dirty_merge_snapshot"
 	OpDirtyMerge              = "dirty_merge"
@@ -68,6 +82,12 @@ const (
 	OpDirtyMergeAbort         = "dirty_merge_abort"
 	OpFinalizeDirtyMerge      = "finalize_dirty_merge"
 
+	// LFS operations
+	OpLFSMigrate = "lfs_migrate"
+
+	// Sparse checkout operations
+	OpSparseCheckout = "sparse_checkout"
+
 	// Rebase operations
 	OpRebase         = "rebase"
 	OpRebaseContinue = "rebase_continue"
@@ -2,12 +2,17 @@ package app
 
 import "github.com/jrengmusic/tit/internal/ui"
 
-// PickerState manages all picker mode states (history, file history, branch picker).
+// PickerState manages all picker mode states (history, file history, compare, branch picker, untracked triage, LFS, sparse checkout, project switcher).
 // These share a common pattern: list pane + details pane with coordinated scrolling.
 type PickerState struct {
 	History      *ui.HistoryState
 	FileHistory  *ui.FileHistoryState
+	Compare      *ui.CompareState
 	BranchPicker *ui.BranchPickerState
+	Untracked    *ui.UntrackedTriageState
+	LFS          *ui.LFSState
+	Sparse       *ui.SparseCheckoutState
+	Projects     *ui.ProjectSwitcherState
 }
 
 // NewPickerState creates a new PickerState with nil states.
@@ -25,14 +30,44 @@ func (p *PickerState) ResetFileHistory() {
 	p.FileHistory = nil
 }
 
+// ResetCompare clears the compare state.
+func (p *PickerState) ResetCompare() {
+	p.Compare = nil
+}
+
 // ResetBranchPicker clears the branch picker state.
 func (p *PickerState) ResetBranchPicker() {
 	p.BranchPicker = nil
 }
 
+// ResetUntracked clears the untracked triage state.
+func (p *PickerState) ResetUntracked() {
+	p.Untracked = nil
+}
+
+// ResetLFS clears the LFS state.
+func (p *PickerState) ResetLFS() {
+	p.LFS = nil
+}
+
+// ResetSparse clears the sparse checkout state.
+func (p *PickerState) ResetSparse() {
+	p.Sparse = nil
+}
+
+// ResetProjects clears the project switcher state.
+func (p *PickerState) ResetProjects() {
+	p.Projects = nil
+}
+
 // ResetAll clears all picker states.
 func (p *PickerState) ResetAll() {
 	p.History = nil
 	p.FileHistory = nil
+	p.Compare = nil
 	p.BranchPicker = nil
+	p.Untracked = nil
+	p.LFS = nil
+	p.Sparse = nil
+	p.Projects = nil
 }
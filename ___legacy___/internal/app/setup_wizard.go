@@ -16,12 +16,21 @@ func (a *Application) renderSetupWizard() string {
 		content = a.renderSetupWelcome()
 	case SetupStepPrerequisites:
 		content = a.renderSetupPrerequisites()
-	case SetupStepEmail:
-		content = a.renderSetupEmail()
+	case SetupStepProvider:
+		content = a.renderSetupChoice("Choose your git host",
+			"TIT writes a ~/.ssh/config entry for it. Each account gets its own entry and key.")
+	case SetupStepKeyChoice:
+		content = a.renderSetupChoice("SSH Key",
+			fmt.Sprintf("Reuse a key from ~/.ssh for %s, or generate a new one.", a.environmentState.SetupAlias))
+	case SetupStepKeyType:
+		content = a.renderSetupChoice("Key Type",
+			"ed25519 keys are short and fast. Choose RSA only for servers that do not support ed25519.")
 	case SetupStepGenerate:
 		content = a.renderSetupGenerate()
 	case SetupStepDisplayKey:
 		content = a.renderSetupDisplayKey()
+	case SetupStepVerify:
+		content = a.renderSetupVerify()
 	case SetupStepComplete:
 		content = a.renderSetupComplete()
 	case SetupStepError:
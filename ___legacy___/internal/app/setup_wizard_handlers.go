@@ -1,8 +1,11 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/jrengmusic/tit/internal/git"
@@ -22,20 +25,153 @@ type SetupCompleteMsg struct {
 	Step string
 }
 
+// SetupVerifyMsg carries the result of the ssh -T verification
+type SetupVerifyMsg struct {
+	Result git.SSHVerifyResult
+}
+
+// setupInputSteps are the wizard steps that take typed input (full-screen input, like ModeInput)
+var setupInputSteps = map[SetupWizardStep]bool{
+	SetupStepHostAlias:  true,
+	SetupStepEmail:      true,
+	SetupStepPassphrase: true,
+
+	SetupStepIdentityName:  true,
+	SetupStepIdentityEmail: true,
+}
+
+// setupAliasPattern limits Host aliases to characters safe in ~/.ssh/config and key file names
+var setupAliasPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// setupChoices returns the options of the current choice step, nil for other steps
+func (a *Application) setupChoices() []string {
+	env := &a.environmentState
+	switch env.SetupWizardStep {
+	case SetupStepProvider:
+		var choices []string
+		for _, p := range git.SSHProviders {
+			choices = append(choices, fmt.Sprintf("%s (%s)", p.Name, p.Host))
+		}
+		return append(choices, "Other host")
+	case SetupStepKeyChoice:
+		var choices []string
+		for _, k := range env.SetupKeys {
+			label := fmt.Sprintf("%s  %s", filepath.Base(k.Path), k.Type)
+			if k.Comment != "" {
+				label += "  " + k.Comment
+			}
+			choices = append(choices, label)
+		}
+		return append(choices, "Generate a new key")
+	case SetupStepKeyType:
+		return []string{"ed25519 (recommended)", "RSA 4096"}
+	case SetupStepVerify:
+		if env.SetupVerify != nil && !env.SetupVerify.OK {
+			return []string{"Retry", "Finish anyway"}
+		}
+	}
+	return nil
+}
+
+// enterSetupChoice moves the wizard to a choice step with the cursor on the first option
+func (a *Application) enterSetupChoice(step SetupWizardStep) {
+	a.environmentState.SetupWizardStep = step
+	a.environmentState.SetupSelectedIdx = 0
+}
+
+// enterSetupInput moves the wizard to an input step prefilled with value
+func (a *Application) enterSetupInput(step SetupWizardStep, value string) {
+	a.environmentState.SetupWizardStep = step
+	a.inputState.ReplaceValue(value)
+}
+
+// handleSetupWizardUp moves the cursor up in choice steps
+func (a *Application) handleSetupWizardUp(app *Application) (tea.Model, tea.Cmd) {
+	if a.environmentState.SetupSelectedIdx > 0 {
+		a.environmentState.SetupSelectedIdx--
+	}
+	return a, nil
+}
+
+// handleSetupWizardDown moves the cursor down in choice steps
+func (a *Application) handleSetupWizardDown(app *Application) (tea.Model, tea.Cmd) {
+	if a.environmentState.SetupSelectedIdx < len(a.setupChoices())-1 {
+		a.environmentState.SetupSelectedIdx++
+	}
+	return a, nil
+}
+
 // handleSetupWizardEnter handles ENTER key in setup wizard
 func (a *Application) handleSetupWizardEnter(app *Application) (tea.Model, tea.Cmd) {
-	switch a.environmentState.SetupWizardStep {
+	env := &a.environmentState
+	switch env.SetupWizardStep {
 	case SetupStepWelcome:
-		a.environmentState.SetupWizardStep = SetupStepPrerequisites
+		env.SetupWizardStep = SetupStepPrerequisites
 	case SetupStepPrerequisites:
 		// Re-check prerequisites
-		env := git.DetectGitEnvironment()
-		if env == git.MissingGit || env == git.MissingSSH {
+		gitEnv := git.DetectGitEnvironment()
+		if gitEnv == git.MissingGit || gitEnv == git.MissingSSH {
 			// Still missing, stay on this step
 			return a, nil
 		}
-		// Prerequisites OK, advance to email
-		a.environmentState.SetupWizardStep = SetupStepEmail
+		// Prerequisites OK, advance to provider
+		a.enterSetupChoice(SetupStepProvider)
+	case SetupStepProvider:
+		env.SetupProvider = env.SetupSelectedIdx
+		alias := ""
+		if env.SetupProvider < len(git.SSHProviders) {
+			alias = git.SSHProviders[env.SetupProvider].Host
+		}
+		a.enterSetupInput(SetupStepHostAlias, alias)
+	case SetupStepHostAlias:
+		alias := strings.TrimSpace(a.inputState.Value)
+		if !setupAliasPattern.MatchString(alias) {
+			a.footerHint = ErrorMessages["ssh_alias_invalid"]
+			return a, nil
+		}
+		env.SetupAlias = alias
+		env.SetupHostName = alias
+		if env.SetupProvider < len(git.SSHProviders) {
+			env.SetupHostName = git.SSHProviders[env.SetupProvider].Host
+		}
+		a.inputState.Value = ""
+		env.SetupKeys = git.FindSSHKeys()
+		if len(env.SetupKeys) == 0 {
+			a.enterSetupChoice(SetupStepKeyType)
+		} else {
+			a.enterSetupChoice(SetupStepKeyChoice)
+		}
+	case SetupStepKeyChoice:
+		if env.SetupSelectedIdx < len(env.SetupKeys) {
+			// Reuse: an encrypted key needs its passphrase to be loaded into the agent
+			env.SetupKeyPath = env.SetupKeys[env.SetupSelectedIdx].Path
+			env.SetupNewKey = false
+			if git.SSHKeyEncrypted(env.SetupKeyPath) {
+				a.enterSetupInput(SetupStepPassphrase, "")
+				return a, nil
+			}
+			env.SetupWizardStep = SetupStepGenerate
+			return a, a.cmdGenerateSSHKey()
+		}
+		a.enterSetupChoice(SetupStepKeyType)
+	case SetupStepKeyType:
+		env.SetupKeyType = git.SSHKeyEd25519
+		if env.SetupSelectedIdx == 1 {
+			env.SetupKeyType = git.SSHKeyRSA
+		}
+		keyPath, err := git.NewSSHKeyPath(env.SetupKeyType, env.SetupAlias)
+		if err != nil {
+			env.SetupWizardError = err.Error()
+			env.SetupWizardStep = SetupStepError
+			return a, nil
+		}
+		env.SetupKeyPath = keyPath
+		env.SetupNewKey = true
+		email := env.SetupEmail
+		if email == "" {
+			email = git.Execute("config", "--get", "user.email").Stdout
+		}
+		a.enterSetupInput(SetupStepEmail, email)
 	case SetupStepEmail:
 		// Validate email input
 		email := strings.TrimSpace(a.inputState.Value)
@@ -50,24 +186,76 @@ func (a *Application) handleSetupWizardEnter(app *Application) (tea.Model, tea.C
 			return a, nil
 		}
 
-		// Store email and advance to generate step
-		a.environmentState.SetupEmail = email
+		// Store email and advance to the (optional) passphrase
+		env.SetupEmail = email
+		a.enterSetupInput(SetupStepPassphrase, "")
+	case SetupStepPassphrase:
+		// Empty = no passphrase for a new key, or don't load an encrypted key into the agent
+		env.SetupPassphrase = a.inputState.Value
 		a.inputState.Value = ""
-		a.environmentState.SetupWizardStep = SetupStepGenerate
+		env.SetupWizardStep = SetupStepGenerate
 		return a, a.cmdGenerateSSHKey()
 	case SetupStepGenerate:
 		// Will be handled in Phase 7
-		a.environmentState.SetupWizardStep = SetupStepDisplayKey
+		env.SetupWizardStep = SetupStepDisplayKey
 	case SetupStepDisplayKey:
-		a.environmentState.SetupWizardStep = SetupStepComplete
+		a.enterSetupChoice(SetupStepVerify)
+		env.SetupVerify = nil
+		return a, a.cmdVerifySSH()
+	case SetupStepVerify:
+		switch {
+		case env.SetupVerify == nil:
+			// Still connecting
+			return a, nil
+		case !env.SetupVerify.OK && env.SetupSelectedIdx == 0:
+			env.SetupVerify = nil
+			return a, a.cmdVerifySSH()
+		}
+		a.enterSetupIdentity()
+	case SetupStepIdentityName:
+		name := strings.TrimSpace(a.inputState.Value)
+		if name == "" {
+			return a, nil
+		}
+		env.SetupIdentity.Name = name
+		email := env.SetupIdentity.Email
+		if email == "" {
+			email = env.SetupEmail
+		}
+		a.enterSetupInput(SetupStepIdentityEmail, email)
+	case SetupStepIdentityEmail:
+		email := strings.TrimSpace(a.inputState.Value)
+		if !strings.Contains(email, "@") {
+			a.footerHint = ErrorMessages["identity_email_invalid"]
+			return a, nil
+		}
+		env.SetupIdentity.Email = email
+		if err := git.SetIdentity(env.SetupIdentity, false); err != nil {
+			env.SetupWizardError = err.Error()
+			env.SetupWizardStep = SetupStepError
+			return a, nil
+		}
+		a.inputState.Value = ""
+		env.SetupWizardStep = SetupStepComplete
 	case SetupStepError:
-		// Go back to previous step (Generate step)
-		a.environmentState.SetupWizardError = ""
-		a.environmentState.SetupWizardStep = SetupStepGenerate
+		env.SetupWizardError = ""
+		if env.SetupIdentity.Name != "" {
+			// Writing the identity failed: edit it again
+			a.enterSetupInput(SetupStepIdentityName, env.SetupIdentity.Name)
+			return a, nil
+		}
+		// Go back to the key choice and try again
+		if len(env.SetupKeys) > 0 {
+			a.enterSetupChoice(SetupStepKeyChoice)
+		} else {
+			a.enterSetupChoice(SetupStepKeyType)
+		}
 		return a, nil
 	case SetupStepComplete:
 		// Setup complete - transition to normal TIT operation
-		a.environmentState.GitEnvironment = git.Ready
+		// Opened from an auth failure, TIT was already running (auto-update included)
+		firstRun := env.GitEnvironment != git.Ready
+		env.GitEnvironment = git.Ready
 
 		// Try to find and cd into git repository (same as normal NewApplication)
 		isRepo, repoPath := git.IsInitializedRepo()
@@ -92,45 +280,78 @@ func (a *Application) handleSetupWizardEnter(app *Application) (tea.Model, tea.C
 			a.gitState = &git.State{Operation: git.NotRepo}
 		}
 
+		a.applyIdentityProfile()
 		a.mode = ModeMenu
 		a.menuItems = a.GenerateMenu()
+		if !firstRun {
+			return a, nil
+		}
 		return a, a.startAutoUpdate()
 	}
 	return a, nil
 }
 
-// cmdGenerateSSHKey generates SSH key and configures SSH
+// enterSetupIdentity asks for user.name / user.email when git has no identity yet
+// (git refuses to commit without one), otherwise finishes the wizard
+func (a *Application) enterSetupIdentity() {
+	id := git.CurrentIdentity()
+	if id.Complete() {
+		a.environmentState.SetupWizardStep = SetupStepComplete
+		return
+	}
+	a.environmentState.SetupIdentity = id
+	a.enterSetupInput(SetupStepIdentityName, id.Name)
+}
+
+// cmdGenerateSSHKey generates (or reuses) the SSH key, loads it into the agent
+// and writes the Host block for the chosen alias
 func (a *Application) cmdGenerateSSHKey() tea.Cmd {
-	email := a.environmentState.SetupEmail
+	env := a.environmentState
+	passphrase := env.SetupPassphrase
+	a.environmentState.SetupPassphrase = "" // Kept only in the closure
+	a.environmentState.SetupKeyCopied = false
 
 	return func() tea.Msg {
 		buffer := ui.GetBuffer()
 		buffer.Clear()
 
-		// Generate SSH key
-		buffer.Append("Generating SSH key...", ui.TypeStatus)
-		if err := git.GenerateSSHKey(email); err != nil {
-			buffer.Append(fmt.Sprintf("✗ Failed to generate SSH key: %s", err.Error()), ui.TypeStderr)
-			return SetupErrorMsg{Step: "keygen", Error: err.Error()}
+		keyName := "~/.ssh/" + filepath.Base(env.SetupKeyPath)
+		if env.SetupNewKey {
+			buffer.Append(fmt.Sprintf("Generating %s SSH key...", env.SetupKeyType), ui.TypeStatus)
+			if err := git.GenerateSSHKey(env.SetupKeyType, env.SetupKeyPath, env.SetupEmail, passphrase); err != nil {
+				buffer.Append(fmt.Sprintf("✗ Failed to generate SSH key: %s", err.Error()), ui.TypeStderr)
+				return SetupErrorMsg{Step: "keygen", Error: err.Error()}
+			}
+			buffer.Append("✓ Created "+keyName, ui.TypeStdout)
+		} else {
+			buffer.Append("✓ Using "+keyName, ui.TypeStdout)
 		}
-		buffer.Append("✓ Created ~/.ssh/TIT_id_rsa", ui.TypeStdout)
 
 		// Add key to SSH agent
 		buffer.Append("Adding to SSH agent...", ui.TypeStatus)
-		if err := git.AddKeyToAgent(); err != nil {
-			buffer.Append("⚠ Could not add to agent (add manually)", ui.TypeWarning)
+		if err := git.AddKeyToAgent(env.SetupKeyPath, passphrase); err != nil {
+			buffer.Append(fmt.Sprintf("⚠ Could not add to agent (add manually): %s", err.Error()), ui.TypeWarning)
 		} else {
 			buffer.Append("✓ Added to SSH agent", ui.TypeStdout)
 		}
 
 		// Configure SSH
 		buffer.Append("Configuring ~/.ssh/config...", ui.TypeStatus)
-		if err := git.WriteSSHConfig(); err != nil {
+		host := git.SSHHost{Alias: env.SetupAlias, HostName: env.SetupHostName, IdentityFile: env.SetupKeyPath}
+		if err := git.UpsertSSHHost(host); err != nil {
 			buffer.Append("⚠ Could not write config (configure manually)", ui.TypeWarning)
 		} else {
-			buffer.Append("✓ Configured ~/.ssh/config", ui.TypeStdout)
+			buffer.Append(fmt.Sprintf("✓ Configured ~/.ssh/config (Host %s)", env.SetupAlias), ui.TypeStdout)
 		}
 
 		return SetupCompleteMsg{Step: "generate"}
 	}
 }
+
+// cmdVerifySSH checks the key end-to-end with `ssh -T git@<alias>`
+func (a *Application) cmdVerifySSH() tea.Cmd {
+	alias := a.environmentState.SetupAlias
+	return func() tea.Msg {
+		return SetupVerifyMsg{Result: git.VerifySSH(context.Background(), alias)}
+	}
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/jrengmusic/tit/internal/git"
@@ -20,7 +21,7 @@ func (a *Application) renderSetupWelcome() string {
 
 	body := lipgloss.NewStyle().
 		Foreground(lipgloss.Color(a.theme.ContentTextColor)).
-		Render("We'll set up SSH authentication for git.\nThis only needs to be done once per machine.")
+		Render("We'll set up SSH authentication for git.\nRun it again to add another account on the same host.")
 
 	button := renderButton("Continue", true, a.theme)
 
@@ -60,17 +61,76 @@ func (a *Application) renderSetupPrerequisites() string {
 	return content + button
 }
 
-// renderSetupEmail renders the email input step
-func (a *Application) renderSetupEmail() string {
+// renderSetupChoice renders a choice step: title, explanation and the options of setupChoices
+func (a *Application) renderSetupChoice(title, body string) string {
+	titleText := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color(a.theme.LabelTextColor)).
+		Render(title)
+
+	bodyText := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(a.theme.DimmedTextColor)).
+		Width(a.sizing.ContentInnerWidth - 4).
+		Align(lipgloss.Center).
+		Render(body)
+
+	return lipgloss.JoinVertical(lipgloss.Center, titleText, "", bodyText, "", a.renderSetupOptions())
+}
+
+// renderSetupOptions renders the options of setupChoices, the selected one marked with ▸
+func (a *Application) renderSetupOptions() string {
+	var options []string
+	for i, choice := range a.setupChoices() {
+		style := lipgloss.NewStyle().Foreground(lipgloss.Color(a.theme.ContentTextColor))
+		marker := "  "
+		if i == a.environmentState.SetupSelectedIdx {
+			style = style.Bold(true).Foreground(lipgloss.Color(a.theme.AccentTextColor))
+			marker = "▸ "
+		}
+		options = append(options, style.Render(marker+choice))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, options...)
+}
+
+// setupInputPrompt returns the prompt of the current input step
+func (a *Application) setupInputPrompt() string {
+	env := a.environmentState
+	switch env.SetupWizardStep {
+	case SetupStepHostAlias:
+		if env.SetupProvider < len(git.SSHProviders) {
+			p := git.SSHProviders[env.SetupProvider]
+			return fmt.Sprintf("Host alias for %s (keep %s, or e.g. %s-work for a second account):", p.Name, p.Host, p.Host)
+		}
+		return "SSH host name (e.g. git.example.com):"
+	case SetupStepPassphrase:
+		if env.SetupNewKey {
+			return "Passphrase (optional, Enter for none):"
+		}
+		return fmt.Sprintf("Passphrase for %s (loads it into ssh-agent, Enter to skip):", filepath.Base(env.SetupKeyPath))
+	case SetupStepIdentityName:
+		return "Your name (git user.name, recorded in every commit):"
+	case SetupStepIdentityEmail:
+		return "Your email (git user.email, recorded in every commit):"
+	default:
+		return "Email (for SSH key comment):"
+	}
+}
+
+// renderSetupInput renders the alias, email, passphrase and identity input steps
+func (a *Application) renderSetupInput() string {
+	value := a.inputState.Value
+	if a.environmentState.SetupWizardStep == SetupStepPassphrase {
+		value = strings.Repeat("*", len(value))
+	}
 	textInputState := ui.TextInputState{
-		Value:     a.inputState.Value,
+		Value:     value,
 		CursorPos: a.inputState.CursorPosition,
 		Height:    4,
 	}
 
 	// Render input component
 	inputContent := ui.RenderTextInput(
-		"Email (for SSH key comment):",
+		a.setupInputPrompt(),
 		textInputState,
 		a.theme,
 		a.sizing.ContentInnerWidth,
@@ -134,17 +194,22 @@ func (a *Application) renderSetupGenerate() string {
 
 // renderSetupDisplayKey renders the public key display step
 func (a *Application) renderSetupDisplayKey() string {
+	env := a.environmentState
+	heading := "SSH Key Ready"
+	if env.SetupNewKey {
+		heading = "SSH Key Generated"
+	}
 	title := lipgloss.NewStyle().
 		Bold(true).
 		Foreground(lipgloss.Color(a.theme.LabelTextColor)).
-		Render("SSH Key Generated")
+		Render(heading)
 
 	subtitle := lipgloss.NewStyle().
 		Foreground(lipgloss.Color(a.theme.ContentTextColor)).
 		Render("Your public key has been copied to clipboard")
 
 	// Get public key
-	pubKey, err := git.GetPublicKey()
+	pubKey, err := git.GetPublicKey(env.SetupKeyPath)
 	if err != nil {
 		return lipgloss.JoinVertical(lipgloss.Center,
 			title, "",
@@ -175,13 +240,16 @@ func (a *Application) renderSetupDisplayKey() string {
 
 	keyContent := keyBox.Render(wrappedKey)
 
+	steps := "Add this key to your git provider's SSH key settings."
+	if env.SetupProvider < len(git.SSHProviders) {
+		steps = "Add this key to your account: " + git.SSHProviders[env.SetupProvider].KeysURL
+	}
+	if env.SetupAlias != env.SetupHostName {
+		steps += fmt.Sprintf("\nUse git@%s:owner/repo.git as the remote URL for this account.", env.SetupAlias)
+	}
 	instructions := lipgloss.NewStyle().
 		Foreground(lipgloss.Color(a.theme.DimmedTextColor)).
-		Render(
-			"Add this key to your git provider:\n" +
-				"  • GitHub:    github.com/settings/ssh/new\n" +
-				"  • GitLab:    gitlab.com/-/user_settings/ssh_keys\n" +
-				"  • Bitbucket: bitbucket.org/account/settings/ssh-keys")
+		Render(steps)
 
 	button := renderButton("Continue", true, a.theme)
 
@@ -193,6 +261,48 @@ func (a *Application) renderSetupDisplayKey() string {
 		button)
 }
 
+// renderSetupVerify renders the ssh -T check: connecting, the host's greeting, or the failure
+func (a *Application) renderSetupVerify() string {
+	env := a.environmentState
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color(a.theme.LabelTextColor)).
+		Render("Verifying SSH Access")
+
+	command := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(a.theme.DimmedTextColor)).
+		Render("ssh -T git@" + env.SetupAlias)
+
+	result := env.SetupVerify
+	if result == nil {
+		connecting := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(a.theme.ContentTextColor)).
+			Render("Connecting...")
+		return lipgloss.JoinVertical(lipgloss.Center, title, "", command, "", connecting)
+	}
+
+	if result.OK {
+		greeting := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(a.theme.ContentTextColor)).
+			Width(a.sizing.ContentInnerWidth - 4).
+			Align(lipgloss.Center).
+			Render("✓ " + result.Message)
+		button := renderButton("Continue", true, a.theme)
+		return lipgloss.JoinVertical(lipgloss.Center, title, "", command, "", greeting, "", "", button)
+	}
+
+	message := "✗ " + result.Message
+	if hint, ok := ConsoleMessages["ssh_verify_"+string(result.Problem)]; ok && result.Problem != git.AuthNone {
+		message += "\n\n" + hint
+	}
+	failure := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(a.theme.ContentTextColor)).
+		Width(a.sizing.ContentInnerWidth - 4).
+		Align(lipgloss.Center).
+		Render(message)
+	return lipgloss.JoinVertical(lipgloss.Center, title, "", command, "", failure, "", a.renderSetupOptions())
+}
+
 // renderSetupError renders the error step
 func (a *Application) renderSetupError() string {
 	title := lipgloss.NewStyle().
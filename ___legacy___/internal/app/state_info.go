@@ -128,6 +128,14 @@ func BuildStateInfo(theme ui.Theme) (map[git.WorkingTree]StateInfo, map[git.Time
 				return StateDescriptions["operation_time_travel"]
 			},
 		},
+		git.Bisecting: {
+			Label: "BISECTING",
+			Emoji: "🔍",
+			Color: theme.OperationTimeTravel,
+			Description: func(ahead, behind int) string {
+				return StateDescriptions["operation_bisecting"]
+			},
+		},
 	}
 
 	return workingTreeInfo, timelineInfo, operationInfo
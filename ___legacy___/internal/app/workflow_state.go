@@ -1,13 +1,16 @@
 package app
 
+import "github.com/jrengmusic/tit/internal/git"
+
 // WorkflowState manages transient state for multi-step workflows (clone, init).
 // All fields reset when workflow completes or is cancelled.
 type WorkflowState struct {
 	// Clone workflow
 	CloneURL      string
 	ClonePath     string
-	CloneMode     string   // "here" or "subdir"
-	CloneBranches []string // Available branches after clone
+	CloneMode     string           // "here" or "subdir"
+	CloneBranches []string         // Available branches after clone
+	CloneOptions  git.CloneOptions // Depth, branch, filter and sparse cone chosen before cloning
 
 	// Mode restoration (for ESC handling)
 	PreviousMode      AppMode
@@ -27,6 +30,27 @@ type WorkflowState struct {
 
 	// BranchPickerReturnAfterCreate — when true, successful branch create returns to branch picker
 	BranchPickerReturnAfterCreate bool
+
+	// Canon branch chosen in the first step of init or the canon/working config flow
+	WorkflowCanon string
+
+	// Destructive action waiting for its protected branch name to be typed
+	PolicyAction string
+
+	// Commit the bookmark name input applies to; return to the history browser after naming it
+	BookmarkHash            string
+	BookmarkReturnToHistory bool
+
+	// Bisect ends marked in the history browser (full hashes), cleared once bisect starts
+	BisectGood string
+	BisectBad  string
+
+	// First side marked for compare (ref to diff, label to show), cleared once the compare view opens
+	CompareRef   string
+	CompareLabel string
+
+	// Mode the compare view returns to on ESC (history or branch picker)
+	CompareReturnMode AppMode
 }
 
 // NewWorkflowState creates a new WorkflowState with defaults.
@@ -44,6 +68,7 @@ func (w *WorkflowState) ResetClone() {
 	w.ClonePath = ""
 	w.CloneMode = "here"
 	w.CloneBranches = nil
+	w.CloneOptions = git.CloneOptions{}
 }
 
 // SaveMode stores current mode and index for ESC restoration.
@@ -0,0 +1,31 @@
+package banner
+
+import "image"
+
+// ImageToBrailleArray converts a raster image to braille characters, scaled
+// down to fit maxCols × maxRows characters (never enlarged, aspect kept)
+// Braille dots are roughly square: 2 dots per column, 4 per row
+func ImageToBrailleArray(img image.Image, maxCols, maxRows int) [][]BrailleChar {
+	bounds := img.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	if srcW <= 0 || srcH <= 0 || maxCols <= 0 || maxRows <= 0 {
+		return nil
+	}
+
+	scale := min(float64(maxCols*2)/float64(srcW), float64(maxRows*4)/float64(srcH), 1)
+	width := max(1, int(float64(srcW)*scale))
+	height := max(1, int(float64(srcH)*scale))
+
+	// Nearest-neighbour sampling; RGBA is alpha-premultiplied, so transparent
+	// pixels come out black and stay unfilled
+	canvas := make([][]PixelColor, height)
+	for y := range canvas {
+		canvas[y] = make([]PixelColor, width)
+		for x := range canvas[y] {
+			r, g, b, _ := img.At(bounds.Min.X+x*srcW/width, bounds.Min.Y+y*srcH/height).RGBA()
+			canvas[y][x] = PixelColor{int(r >> 8), int(g >> 8), int(b >> 8)}
+		}
+	}
+
+	return CanvasToBrailleArray(canvas, width, height)
+}
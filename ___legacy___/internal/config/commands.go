@@ -0,0 +1,62 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// RepoCommand is a named shell command from the repository's .tit.toml, run
+// from the time travel menu against the checked-out commit:
+//
+//	[[command]]
+//	name = "build"
+//	run = "go build ./..."
+type RepoCommand struct {
+	Name string `toml:"name"`
+	Run  string `toml:"run"` // Passed to sh -c from the repository root
+}
+
+// repoCommandsFile is the [[command]] part of .tit.toml
+type repoCommandsFile struct {
+	Commands []RepoCommand `toml:"command"`
+}
+
+// ParseRepoCommands reads [[command]] entries from .tit.toml content; every
+// command needs a name (one line, no tabs) and a run line, and names must be unique
+func ParseRepoCommands(data []byte) ([]RepoCommand, error) {
+	var file repoCommandsFile
+	if err := toml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("%s: %w", RepoConfigFile, err)
+	}
+	seen := make(map[string]bool, len(file.Commands))
+	for _, cmd := range file.Commands {
+		switch {
+		case cmd.Name == "":
+			return nil, fmt.Errorf("%s: command without name", RepoConfigFile)
+		case strings.ContainsAny(cmd.Name, "\t\n"):
+			return nil, fmt.Errorf("%s: command %q: name must be a single line without tabs", RepoConfigFile, cmd.Name)
+		case cmd.Run == "":
+			return nil, fmt.Errorf("%s: command %q: run is empty", RepoConfigFile, cmd.Name)
+		case seen[cmd.Name]:
+			return nil, fmt.Errorf("%s: command %q defined twice", RepoConfigFile, cmd.Name)
+		}
+		seen[cmd.Name] = true
+	}
+	return file.Commands, nil
+}
+
+// LoadRepoCommands reads [[command]] entries from .tit.toml under root. A missing file means no commands.
+func LoadRepoCommands(root string) ([]RepoCommand, error) {
+	data, err := os.ReadFile(filepath.Join(root, RepoConfigFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ParseRepoCommands(data)
+}
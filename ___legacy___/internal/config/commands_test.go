@@ -0,0 +1,35 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseRepoCommands(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []RepoCommand
+		wantErr bool
+	}{
+		{name: "none", content: "[policy]\nmax_discard_files = 5\n"},
+		{name: "two", content: "[[command]]\nname = \"build\"\nrun = \"make\"\n\n[[command]]\nname = \"test\"\nrun = \"make test\"\n",
+			want: []RepoCommand{{Name: "build", Run: "make"}, {Name: "test", Run: "make test"}}},
+		{name: "no name", content: "[[command]]\nrun = \"make\"\n", wantErr: true},
+		{name: "tab in name", content: "[[command]]\nname = \"a\\tb\"\nrun = \"make\"\n", wantErr: true},
+		{name: "no run", content: "[[command]]\nname = \"build\"\n", wantErr: true},
+		{name: "duplicate", content: "[[command]]\nname = \"build\"\nrun = \"a\"\n[[command]]\nname = \"build\"\nrun = \"b\"\n", wantErr: true},
+		{name: "syntax", content: "[[command]\n", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseRepoCommands([]byte(tc.content))
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("ParseRepoCommands() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
@@ -19,12 +19,36 @@ interval_minutes = 5
 
 [appearance]
 theme = "gfx"
+diff_layout = "unified"   # "unified" or "split" (side by side); toggle with s in diff panes
+syntax_highlighting = true   # syntax colors in diff and conflict panes (skipped for huge files)
+
+# Identity profiles: user.name / user.email written to a repository's own config
+# when it is opened, cloned or gets a remote. The first matching profile wins.
+# [[identity]]
+# name = "work"
+# user_name = "Jane Doe"
+# user_email = "jane@company.com"
+# hosts = ["github.com-work", "gitlab.company.com"]   # remote host or ~/.ssh/config alias
+# paths = ["~/work"]                                 # repositories under these directories
+
+# Destructive-action policy. A repository's .tit.toml [policy] adds to this one;
+# the stricter setting wins. Covers force push, replace local and rewind.
+# [policy]
+# max_rewind_commits = 20   # refuse to rewind further back (0 = unlimited)
+# max_discard_files = 50    # refuse to discard more changed files (0 = unlimited)
+#
+# [[policy.protected]]
+# pattern = "main"          # glob, e.g. "release/*"
+# mode = "confirm"          # "confirm" (type the branch name) or "hide"
+# actions = ["force_push", "replace_local", "rewind"]   # default: all three
 `
 
 // Config represents the application configuration
 type Config struct {
-	AutoUpdate AutoUpdateConfig `toml:"auto_update"`
-	Appearance AppearanceConfig `toml:"appearance"`
+	AutoUpdate AutoUpdateConfig  `toml:"auto_update"`
+	Appearance AppearanceConfig  `toml:"appearance"`
+	Identities []IdentityProfile `toml:"identity,omitempty"`
+	Policy     PolicyConfig      `toml:"policy,omitempty"`
 }
 
 // AutoUpdateConfig contains settings for background sync
@@ -35,7 +59,69 @@ type AutoUpdateConfig struct {
 
 // AppearanceConfig contains visual settings
 type AppearanceConfig struct {
-	Theme string `toml:"theme"`
+	Theme      string `toml:"theme"`
+	DiffLayout string `toml:"diff_layout"` // DiffLayoutUnified or DiffLayoutSplit
+
+	SyntaxHighlighting bool `toml:"syntax_highlighting"` // Syntax colors in diff and conflict panes
+}
+
+// Diff layouts for file history, compare and conflict resolver diff panes
+const (
+	DiffLayoutUnified = "unified"
+	DiffLayoutSplit   = "split"
+)
+
+// RepoConfigFile is the per-repository settings file ([policy], [[command]]),
+// read from the repository root
+const RepoConfigFile = ".tit.toml"
+
+// IdentityProfile is a git identity applied to repositories matching its hosts or paths
+type IdentityProfile struct {
+	Name      string   `toml:"name"`
+	UserName  string   `toml:"user_name"`
+	UserEmail string   `toml:"user_email"`
+	Hosts     []string `toml:"hosts"`
+	Paths     []string `toml:"paths"`
+}
+
+// MatchIdentity returns the first profile whose paths contain repoPath or whose hosts
+// include remoteHost (case-insensitive), nil when none matches
+func (c *Config) MatchIdentity(repoPath, remoteHost string) *IdentityProfile {
+	for i := range c.Identities {
+		profile := &c.Identities[i]
+		for _, host := range profile.Hosts {
+			if remoteHost != "" && strings.EqualFold(host, remoteHost) {
+				return profile
+			}
+		}
+		for _, dir := range profile.Paths {
+			if pathWithin(repoPath, expandHome(dir)) {
+				return profile
+			}
+		}
+	}
+	return nil
+}
+
+// pathWithin reports whether path is dir or lies below it
+func pathWithin(path, dir string) bool {
+	if path == "" || dir == "" {
+		return false
+	}
+	rel, err := filepath.Rel(filepath.Clean(dir), filepath.Clean(path))
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// expandHome replaces a leading ~ with the user's home directory
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(homeDir, strings.TrimPrefix(path, "~"))
 }
 
 // GetConfigPath returns the path to the config file
@@ -76,7 +162,9 @@ func Load() (*Config, error) {
 				IntervalMinutes: 5,
 			},
 			Appearance: AppearanceConfig{
-				Theme: "gfx",
+				Theme:              "gfx",
+				DiffLayout:         DiffLayoutUnified,
+				SyntaxHighlighting: true,
 			},
 		}
 		// Attempt to save; if it fails, still return config but surface error
@@ -94,12 +182,15 @@ func Load() (*Config, error) {
 		return nil, err
 	}
 
-	// Parse TOML
-	var config Config
+	// Parse TOML over defaults for keys older config files lack
+	config := Config{Appearance: AppearanceConfig{SyntaxHighlighting: true}}
 	if err := toml.Unmarshal(data, &config); err != nil {
 		// FAIL-FAST: propagate parse error
 		return nil, err
 	}
+	if err := config.Policy.Validate(); err != nil {
+		return nil, err
+	}
 
 	// Apply defaults for missing fields
 	if config.AutoUpdate.IntervalMinutes == 0 {
@@ -108,6 +199,9 @@ func Load() (*Config, error) {
 	if config.Appearance.Theme == "" {
 		config.Appearance.Theme = "gfx"
 	}
+	if config.Appearance.DiffLayout != DiffLayoutSplit {
+		config.Appearance.DiffLayout = DiffLayoutUnified
+	}
 
 	return &config, nil
 }
@@ -161,6 +255,18 @@ func (c *Config) SetTheme(theme string) error {
 	return Save(c)
 }
 
+// SetDiffLayout sets the diff layout and persists
+func (c *Config) SetDiffLayout(layout string) error {
+	c.Appearance.DiffLayout = layout
+	return Save(c)
+}
+
+// SetSyntaxHighlighting turns syntax colors on or off and persists
+func (c *Config) SetSyntaxHighlighting(enabled bool) error {
+	c.Appearance.SyntaxHighlighting = enabled
+	return Save(c)
+}
+
 // GetAvailableThemes returns list of available theme names
 func GetAvailableThemes() []string {
 	themesDir := filepath.Join(os.Getenv("HOME"), ".config", "tit", "themes")
@@ -0,0 +1,125 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMatchIdentity(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	cfg := &Config{Identities: []IdentityProfile{
+		{Name: "work", Hosts: []string{"github.com-work", "gitlab.company.com"}, Paths: []string{"~/work"}},
+		{Name: "personal", Hosts: []string{"github.com"}, Paths: []string{filepath.Join(home, "src")}},
+	}}
+	tests := []struct {
+		repoPath string
+		host     string
+		want     string
+	}{
+		{filepath.Join(home, "work", "api"), "github.com", "work"}, // First matching profile wins
+		{filepath.Join(home, "work"), "", "work"},
+		{filepath.Join(home, "workshop"), "", ""}, // Prefix without a separator boundary
+		{filepath.Join(home, "src", "tit"), "", "personal"},
+		{"/elsewhere", "GitLab.Company.com", "work"},
+		{"/elsewhere", "github.com", "personal"},
+		{"/elsewhere", "bitbucket.org", ""},
+		{"", "", ""},
+	}
+	for _, tc := range tests {
+		got := ""
+		if profile := cfg.MatchIdentity(tc.repoPath, tc.host); profile != nil {
+			got = profile.Name
+		}
+		if got != tc.want {
+			t.Errorf("MatchIdentity(%q, %q) = %q, want %q", tc.repoPath, tc.host, got, tc.want)
+		}
+	}
+}
+
+func TestRecordRecentRepo(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	for _, path := range []string{"/a", "/b", "/a", "/c"} {
+		if err := RecordRecentRepo(path); err != nil {
+			t.Fatalf("RecordRecentRepo(%q): %v", path, err)
+		}
+	}
+	var got []string
+	for _, repo := range LoadRecentRepos() {
+		got = append(got, repo.Path)
+	}
+	want := []string{"/c", "/a", "/b"} // Most recent first, reopening moves to front
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("recent repos: got %v, want %v", got, want)
+	}
+
+	for i := 0; i < MaxRecentRepos+5; i++ {
+		if err := RecordRecentRepo(fmt.Sprintf("/repo%d", i)); err != nil {
+			t.Fatalf("RecordRecentRepo(/repo%d): %v", i, err)
+		}
+	}
+	if n := len(LoadRecentRepos()); n != MaxRecentRepos {
+		t.Errorf("recent list length: got %d, want %d", n, MaxRecentRepos)
+	}
+}
+
+func TestLoadDiffLayout(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	tests := []struct {
+		layout string
+		want   string
+	}{
+		{"", DiffLayoutUnified}, // Missing key
+		{DiffLayoutSplit, DiffLayoutSplit},
+		{"sideways", DiffLayoutUnified}, // Unknown layout
+	}
+	for _, tc := range tests {
+		if err := Save(&Config{Appearance: AppearanceConfig{Theme: "gfx", DiffLayout: tc.layout}}); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load: %v", err)
+		}
+		if cfg.Appearance.DiffLayout != tc.want {
+			t.Errorf("diff_layout %q: got %q, want %q", tc.layout, cfg.Appearance.DiffLayout, tc.want)
+		}
+	}
+}
+
+func TestLoadSyntaxHighlighting(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	configPath := filepath.Join(home, ".config", "tit", "config.toml")
+
+	tests := []struct {
+		appearance string
+		want       bool
+	}{
+		{`theme = "gfx"`, true}, // Older config without the key
+		{"syntax_highlighting = false", false},
+		{"syntax_highlighting = true", true},
+	}
+	for _, tc := range tests {
+		if err := os.MkdirAll(filepath.Dir(configPath), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(configPath, []byte("[appearance]\n"+tc.appearance+"\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load: %v", err)
+		}
+		if cfg.Appearance.SyntaxHighlighting != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.appearance, cfg.Appearance.SyntaxHighlighting, tc.want)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// Destructive actions a protected branch rule can cover
+const (
+	ActionForcePush    = "force_push"
+	ActionReplaceLocal = "replace_local"
+	ActionRewind       = "rewind"
+)
+
+// IsDestructiveAction reports whether action is one protected branch rules can cover
+func IsDestructiveAction(action string) bool {
+	return action == ActionForcePush || action == ActionReplaceLocal || action == ActionRewind
+}
+
+// ProtectMode is how a protected branch restricts a destructive action
+type ProtectMode string
+
+const (
+	ProtectNone    ProtectMode = ""        // Action allowed as usual
+	ProtectConfirm ProtectMode = "confirm" // Action needs the branch name typed
+	ProtectHide    ProtectMode = "hide"    // Action not offered at all
+)
+
+// strength orders modes so the strictest matching rule wins
+func (m ProtectMode) strength() int {
+	switch m {
+	case ProtectHide:
+		return 2
+	case ProtectConfirm:
+		return 1
+	}
+	return 0
+}
+
+// PolicyConfig restricts destructive actions. It lives under [policy] in the
+// global config and in the repository's .tit.toml; see Merge for how both combine.
+type PolicyConfig struct {
+	Protected        []ProtectedBranch `toml:"protected,omitempty"`
+	MaxRewindCommits int               `toml:"max_rewind_commits,omitempty"` // 0 = unlimited
+	MaxDiscardFiles  int               `toml:"max_discard_files,omitempty"`  // 0 = unlimited
+}
+
+// ProtectedBranch protects branches matching Pattern (path.Match glob, e.g. "release/*")
+type ProtectedBranch struct {
+	Pattern string      `toml:"pattern"`
+	Mode    ProtectMode `toml:"mode,omitempty"`    // Defaults to confirm
+	Actions []string    `toml:"actions,omitempty"` // Defaults to all destructive actions
+}
+
+// repoPolicyFile is the shape of .tit.toml
+type repoPolicyFile struct {
+	Policy PolicyConfig `toml:"policy"`
+}
+
+// Validate checks patterns, modes, actions and limits
+func (p PolicyConfig) Validate() error {
+	for _, rule := range p.Protected {
+		if rule.Pattern == "" {
+			return fmt.Errorf("policy: protected branch without pattern")
+		}
+		if _, err := path.Match(rule.Pattern, ""); err != nil {
+			return fmt.Errorf("policy: bad pattern %q: %w", rule.Pattern, err)
+		}
+		if rule.Mode != ProtectNone && rule.Mode != ProtectConfirm && rule.Mode != ProtectHide {
+			return fmt.Errorf("policy: %q: mode must be %q or %q, got %q", rule.Pattern, ProtectConfirm, ProtectHide, rule.Mode)
+		}
+		for _, action := range rule.Actions {
+			if !IsDestructiveAction(action) {
+				return fmt.Errorf("policy: %q: unknown action %q", rule.Pattern, action)
+			}
+		}
+	}
+	if p.MaxRewindCommits < 0 || p.MaxDiscardFiles < 0 {
+		return fmt.Errorf("policy: limits must not be negative")
+	}
+	return nil
+}
+
+// Merge combines two policies into the stricter of both: rules add up and
+// each limit takes the lower non-zero value, so a repository can tighten the
+// global policy but never loosen it
+func (p PolicyConfig) Merge(other PolicyConfig) PolicyConfig {
+	return PolicyConfig{
+		Protected:        append(append([]ProtectedBranch{}, p.Protected...), other.Protected...),
+		MaxRewindCommits: stricterLimit(p.MaxRewindCommits, other.MaxRewindCommits),
+		MaxDiscardFiles:  stricterLimit(p.MaxDiscardFiles, other.MaxDiscardFiles),
+	}
+}
+
+// stricterLimit returns the lower of two limits where 0 means unlimited
+func stricterLimit(a, b int) int {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// Protection returns how action is restricted on branch, the strictest matching rule winning
+func (p PolicyConfig) Protection(branch, action string) ProtectMode {
+	mode := ProtectNone
+	if branch == "" {
+		return mode
+	}
+	for _, rule := range p.Protected {
+		if matched, _ := path.Match(rule.Pattern, branch); !matched || !rule.covers(action) {
+			continue
+		}
+		ruleMode := rule.Mode
+		if ruleMode == ProtectNone {
+			ruleMode = ProtectConfirm
+		}
+		if ruleMode.strength() > mode.strength() {
+			mode = ruleMode
+		}
+	}
+	return mode
+}
+
+// covers reports whether the rule applies to action
+func (r ProtectedBranch) covers(action string) bool {
+	if len(r.Actions) == 0 {
+		return true
+	}
+	for _, a := range r.Actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadRepoPolicy reads [policy] from .tit.toml under root. A missing file is an empty policy.
+func LoadRepoPolicy(root string) (PolicyConfig, error) {
+	data, err := os.ReadFile(filepath.Join(root, RepoConfigFile))
+	if os.IsNotExist(err) {
+		return PolicyConfig{}, nil
+	}
+	if err != nil {
+		return PolicyConfig{}, err
+	}
+
+	var file repoPolicyFile
+	if err := toml.Unmarshal(data, &file); err != nil {
+		return PolicyConfig{}, fmt.Errorf("%s: %w", RepoConfigFile, err)
+	}
+	if err := file.Policy.Validate(); err != nil {
+		return PolicyConfig{}, fmt.Errorf("%s: %w", RepoConfigFile, err)
+	}
+	return file.Policy, nil
+}
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPolicyProtection(t *testing.T) {
+	policy := PolicyConfig{Protected: []ProtectedBranch{
+		{Pattern: "main"},
+		{Pattern: "release/*", Mode: ProtectHide},
+		{Pattern: "main", Mode: ProtectHide, Actions: []string{ActionForcePush}},
+		{Pattern: "dev", Actions: []string{ActionRewind}},
+	}}
+	tests := []struct {
+		branch string
+		action string
+		want   ProtectMode
+	}{
+		{"main", ActionReplaceLocal, ProtectConfirm}, // Mode defaults to confirm
+		{"main", ActionForcePush, ProtectHide},       // Strictest matching rule wins
+		{"release/1.0", ActionRewind, ProtectHide},
+		{"release/1.0/hotfix", ActionRewind, ProtectNone}, // * stops at /
+		{"dev", ActionRewind, ProtectConfirm},
+		{"dev", ActionForcePush, ProtectNone},
+		{"feature", ActionForcePush, ProtectNone},
+		{"", ActionForcePush, ProtectNone}, // Detached HEAD
+	}
+	for _, tc := range tests {
+		if got := policy.Protection(tc.branch, tc.action); got != tc.want {
+			t.Errorf("Protection(%q, %q) = %q, want %q", tc.branch, tc.action, got, tc.want)
+		}
+	}
+}
+
+func TestPolicyMerge(t *testing.T) {
+	global := PolicyConfig{Protected: []ProtectedBranch{{Pattern: "main"}}, MaxRewindCommits: 20}
+	repo := PolicyConfig{Protected: []ProtectedBranch{{Pattern: "dev"}}, MaxRewindCommits: 50, MaxDiscardFiles: 10}
+
+	merged := global.Merge(repo)
+	if len(merged.Protected) != 2 {
+		t.Errorf("protected rules: got %d, want 2", len(merged.Protected))
+	}
+	if merged.MaxRewindCommits != 20 {
+		t.Errorf("max_rewind_commits: got %d, want 20 (repo cannot loosen)", merged.MaxRewindCommits)
+	}
+	if merged.MaxDiscardFiles != 10 {
+		t.Errorf("max_discard_files: got %d, want 10 (unset global is unlimited)", merged.MaxDiscardFiles)
+	}
+	if len(global.Protected) != 1 {
+		t.Errorf("Merge modified its receiver")
+	}
+}
+
+func TestLoadRepoPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string // Empty: no file
+		wantErr bool
+		want    ProtectMode // Protection("main", force_push)
+	}{
+		{name: "missing file", want: ProtectNone},
+		{name: "other sections only", content: "[other]\nkey = 1\n", want: ProtectNone},
+		{name: "hide", content: "[[policy.protected]]\npattern = \"main\"\nmode = \"hide\"\n", want: ProtectHide},
+		{name: "bad mode", content: "[[policy.protected]]\npattern = \"main\"\nmode = \"never\"\n", wantErr: true},
+		{name: "bad action", content: "[[policy.protected]]\npattern = \"main\"\nactions = [\"push\"]\n", wantErr: true},
+		{name: "bad pattern", content: "[[policy.protected]]\npattern = \"[main\"\n", wantErr: true},
+		{name: "negative limit", content: "[policy]\nmax_discard_files = -1\n", wantErr: true},
+		{name: "syntax", content: "[policy\n", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			root := t.TempDir()
+			if tc.content != "" {
+				if err := os.WriteFile(filepath.Join(root, RepoConfigFile), []byte(tc.content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			policy, err := LoadRepoPolicy(root)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got := policy.Protection("main", ActionForcePush); got != tc.want {
+				t.Errorf("Protection(main, force_push) = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
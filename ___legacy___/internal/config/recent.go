@@ -0,0 +1,72 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jrengmusic/tit/internal"
+
+	"github.com/BurntSushi/toml"
+)
+
+// MaxRecentRepos caps the recent repositories list (oldest dropped first)
+const MaxRecentRepos = 30
+
+// RecentRepo is one repository TIT has opened
+type RecentRepo struct {
+	Path     string    `toml:"path"`      // Absolute repository root
+	OpenedAt time.Time `toml:"opened_at"` // Last time TIT started in it
+}
+
+// RecentList holds recently opened repositories, most recent first
+type RecentList struct {
+	Repo []RecentRepo `toml:"repo"`
+}
+
+// getRecentFilePath returns absolute path to the recent repositories TOML
+func getRecentFilePath() (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "recent.toml"), nil
+}
+
+// LoadRecentRepos returns recently opened repositories, most recent first.
+// Returns an empty list if the file doesn't exist or is corrupted (first run).
+func LoadRecentRepos() []RecentRepo {
+	recentFile, err := getRecentFilePath()
+	if err != nil {
+		return nil
+	}
+	var list RecentList
+	if _, err := toml.DecodeFile(recentFile, &list); err != nil {
+		return nil
+	}
+	return list.Repo
+}
+
+// RecordRecentRepo moves path to the front of the recent list, capped at MaxRecentRepos
+func RecordRecentRepo(path string) error {
+	recentFile, err := getRecentFilePath()
+	if err != nil {
+		return err
+	}
+
+	list := RecentList{Repo: []RecentRepo{{Path: path, OpenedAt: time.Now()}}}
+	for _, repo := range LoadRecentRepos() {
+		if repo.Path != path && len(list.Repo) < MaxRecentRepos {
+			list.Repo = append(list.Repo, repo)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(recentFile), internal.StateDirPerms); err != nil {
+		return err
+	}
+	data, err := toml.Marshal(list)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(recentFile, data, internal.StateFilePerms)
+}
@@ -41,6 +41,8 @@ const (
 	ConfigFilePerms = 0600 // rw------- - Config file (owner only)
 	GitignorePerms  = 0644 // rw-r--r-- - .gitignore file (owner read/write, others read)
 	StashDirPerms   = 0755 // rwxr-xr-x - Stash directory (owner rwx, group/others rx)
+	StateFilePerms  = 0644 // rw-r--r-- - TIT state files (.git/TIT_*, recent repositories)
+	StateDirPerms   = 0755 // rwxr-xr-x - Directories holding TIT state files
 )
 
 // Timestamp formats for git and display
@@ -53,6 +55,13 @@ const (
 const (
 	BezierCurveResolution = 20              // Resolution for cubic bezier curve approximation
 	QuitConfirmTimeout    = 2 * time.Second // Timeout for quit/clear confirmation dialogs
+
+	SyntaxHighlightMaxBytes = 256 * 1024 // Larger diffs and file versions are shown without syntax colors
+
+	ImagePreviewMaxBytes  = 4 * 1024 * 1024 // Larger images are summarized without dimensions or thumbnail
+	ImagePreviewMaxPixels = 4096 * 4096     // Larger declared sizes show dimensions only, never decoded
+	ImageThumbnailCols    = 32              // Thumbnail width in braille characters (2 dots each)
+	ImageThumbnailRows    = 8               // Thumbnail height in braille characters (4 dots each)
 )
 
 // Git directory name
@@ -0,0 +1,96 @@
+package git
+
+import (
+	"runtime"
+	"strings"
+)
+
+// AuthProblem classifies why git could not authenticate against a remote
+type AuthProblem string
+
+const (
+	AuthNone               AuthProblem = ""
+	AuthHostKeyUnknown     AuthProblem = "host_key_unknown"    // Host not in known_hosts; ssh cannot prompt without a terminal
+	AuthHostKeyChanged     AuthProblem = "host_key_changed"    // known_hosts entry does not match the server
+	AuthPublicKeyDenied    AuthProblem = "publickey_denied"    // Server rejected every offered SSH key
+	AuthCredentialsMissing AuthProblem = "credentials_missing" // HTTPS needs a username/password and none is stored
+	AuthTokenRequired      AuthProblem = "token_required"      // Password auth refused (2FA or token-only host)
+	AuthAccessDenied       AuthProblem = "access_denied"       // Authenticated, but the account lacks access
+	AuthRepoNotFound       AuthProblem = "repo_not_found"      // No such repository (or hidden from this account)
+)
+
+// authStderr maps git/ssh/curl error text to a problem, checked in order.
+// Host key changes also print "Host key verification failed", so they come first;
+// token hints come before the generic HTTPS authentication failure they accompany.
+var authStderr = []struct {
+	needle  string
+	problem AuthProblem
+}{
+	{"remote host identification has changed", AuthHostKeyChanged},
+	{"host key verification failed", AuthHostKeyUnknown},
+	{"authenticity of host", AuthHostKeyUnknown},
+	{"permission denied (publickey", AuthPublicKeyDenied},
+	{"support for password authentication was removed", AuthTokenRequired},
+	{"http basic: access denied", AuthTokenRequired},
+	{"personal access token", AuthTokenRequired},
+	{"two-factor", AuthTokenRequired},
+	{"could not read username", AuthCredentialsMissing},
+	{"could not read password", AuthCredentialsMissing},
+	{"terminal prompts disabled", AuthCredentialsMissing},
+	{"authentication failed", AuthCredentialsMissing},
+	{"the requested url returned error: 401", AuthCredentialsMissing},
+	{"write access to repository not granted", AuthAccessDenied},
+	{"the requested url returned error: 403", AuthAccessDenied},
+	{"permission to ", AuthAccessDenied}, // "Permission to user/repo.git denied to other"
+	{"repository not found", AuthRepoNotFound},
+	{"project you were looking for could not be found", AuthRepoNotFound},
+	{"does not appear to be a git repository", AuthRepoNotFound},
+	{"the requested url returned error: 404", AuthRepoNotFound},
+}
+
+// DiagnoseAuth classifies stderr from clone, fetch, pull or push and returns the line
+// that matched. Returns AuthNone for failures unrelated to reaching or authenticating
+// to the remote. Rules are tried before lines, so rule order decides between several
+// matching lines (a changed host key wins over the verification failure that follows).
+func DiagnoseAuth(stderr string) (AuthProblem, string) {
+	lines := strings.Split(stderr, "\n")
+	for _, rule := range authStderr {
+		for _, line := range lines {
+			if strings.Contains(strings.ToLower(line), rule.needle) {
+				return rule.problem, strings.TrimSpace(line)
+			}
+		}
+	}
+	return AuthNone, ""
+}
+
+// CredentialHelper returns the configured credential.helper, empty when none is set
+func CredentialHelper() string {
+	result := Execute("config", "--get", "credential.helper")
+	if !result.Success {
+		return ""
+	}
+	return result.Stdout
+}
+
+// SetCredentialHelper sets credential.helper in the global git config
+func SetCredentialHelper(helper string) error {
+	result := Execute("config", "--global", "credential.helper", helper)
+	if !result.Success {
+		return resultError(result)
+	}
+	return nil
+}
+
+// DefaultCredentialHelper suggests the platform's credential store:
+// the keychain on macOS, Git Credential Manager on Windows, an in-memory cache elsewhere
+func DefaultCredentialHelper() string {
+	switch runtime.GOOS {
+	case "darwin":
+		return "osxkeychain"
+	case "windows":
+		return "manager"
+	default:
+		return "cache --timeout=3600"
+	}
+}
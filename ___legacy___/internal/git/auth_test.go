@@ -0,0 +1,66 @@
+package git
+
+import "testing"
+
+func TestDiagnoseAuth(t *testing.T) {
+	tests := []struct {
+		name     string
+		stderr   string
+		want     AuthProblem
+		wantLine string
+	}{
+		{
+			name:     "unknown host key",
+			stderr:   "No ED25519 host key is known for example.com and you have requested strict checking.\nHost key verification failed.\nfatal: Could not read from remote repository.",
+			want:     AuthHostKeyUnknown,
+			wantLine: "Host key verification failed.",
+		},
+		{
+			name:     "changed host key wins over verification failure",
+			stderr:   "@    WARNING: REMOTE HOST IDENTIFICATION HAS CHANGED!     @\nHost key verification failed.",
+			want:     AuthHostKeyChanged,
+			wantLine: "@    WARNING: REMOTE HOST IDENTIFICATION HAS CHANGED!     @",
+		},
+		{
+			name:     "public key denied",
+			stderr:   "git@github.com: Permission denied (publickey).\nfatal: Could not read from remote repository.",
+			want:     AuthPublicKeyDenied,
+			wantLine: "git@github.com: Permission denied (publickey).",
+		},
+		{
+			name:     "no stored credentials",
+			stderr:   "fatal: could not read Username for 'https://github.com': terminal prompts disabled",
+			want:     AuthCredentialsMissing,
+			wantLine: "fatal: could not read Username for 'https://github.com': terminal prompts disabled",
+		},
+		{
+			name:     "token required",
+			stderr:   "remote: Support for password authentication was removed on August 13, 2021.\nfatal: Authentication failed for 'https://github.com/user/repo.git/'",
+			want:     AuthTokenRequired,
+			wantLine: "remote: Support for password authentication was removed on August 13, 2021.",
+		},
+		{
+			name:     "access denied",
+			stderr:   "remote: Permission to owner/repo.git denied to someone.\nfatal: unable to access 'https://github.com/owner/repo.git/': The requested URL returned error: 403",
+			want:     AuthAccessDenied,
+			wantLine: "fatal: unable to access 'https://github.com/owner/repo.git/': The requested URL returned error: 403",
+		},
+		{
+			name:     "repository not found",
+			stderr:   "ERROR: Repository not found.\nfatal: Could not read from remote repository.",
+			want:     AuthRepoNotFound,
+			wantLine: "ERROR: Repository not found.",
+		},
+		{
+			name:   "unrelated failure",
+			stderr: "! [rejected]        main -> main (non-fast-forward)\nerror: failed to push some refs",
+			want:   AuthNone,
+		},
+	}
+	for _, tc := range tests {
+		got, line := DiagnoseAuth(tc.stderr)
+		if got != tc.want || line != tc.wantLine {
+			t.Errorf("%s: DiagnoseAuth() = %q, %q; want %q, %q", tc.name, got, line, tc.want, tc.wantLine)
+		}
+	}
+}
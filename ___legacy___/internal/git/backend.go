@@ -0,0 +1,255 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Backend executes git commands on behalf of the git package.
+// Every git invocation in TIT goes through the active backend so it can be
+// swapped for a fake in tests or a faster implementation later.
+type Backend interface {
+	// Run executes git with args and captures trimmed stdout/stderr
+	Run(args ...string) CommandResult
+	// RunContext is Run, killing git when ctx is cancelled or its deadline passes
+	RunContext(ctx context.Context, args ...string) CommandResult
+	// RunRaw is Run with stdout kept byte for byte (file contents, not messages)
+	RunRaw(args ...string) CommandResult
+	// Stream executes git with args, emitting output line by line to the package Logger.
+	// Stdout/Stderr of the result are empty: output has already been streamed.
+	Stream(ctx context.Context, args ...string) CommandResult
+}
+
+// Package-level backend (set by application at startup)
+var (
+	packageBackend      Backend = NewExecBackend()
+	packageBackendMutex sync.RWMutex
+)
+
+// SetBackend configures the backend used by all git package functions.
+// Passing nil restores the exec backend.
+func SetBackend(b Backend) {
+	if b == nil {
+		b = NewExecBackend()
+	}
+	packageBackendMutex.Lock()
+	defer packageBackendMutex.Unlock()
+	packageBackend = b
+}
+
+// CurrentBackend returns the backend used by git package functions.
+func CurrentBackend() Backend {
+	packageBackendMutex.RLock()
+	defer packageBackendMutex.RUnlock()
+	return packageBackend
+}
+
+// ExecBackend runs the git binary found in PATH in the process working directory.
+type ExecBackend struct{}
+
+// NewExecBackend creates the default subprocess-based backend.
+func NewExecBackend() *ExecBackend {
+	return &ExecBackend{}
+}
+
+// Run executes git and captures its output.
+func (b *ExecBackend) Run(args ...string) CommandResult {
+	return b.RunContext(context.Background(), args...)
+}
+
+// RunContext executes git and captures its output, killing it when ctx ends.
+// Output is collected by exec's own copiers so WaitDelay can close the pipes
+// even if a child (ssh, credential helper) still holds them.
+func (b *ExecBackend) RunContext(ctx context.Context, args ...string) CommandResult {
+	result := b.runCaptured(ctx, args...)
+	result.Stdout = strings.TrimSpace(result.Stdout)
+	return result
+}
+
+// RunRaw executes git and captures its output without trimming stdout.
+func (b *ExecBackend) RunRaw(args ...string) CommandResult {
+	return b.runCaptured(context.Background(), args...)
+}
+
+// runCaptured executes git and collects stdout as is and stderr trimmed.
+func (b *ExecBackend) runCaptured(ctx context.Context, args ...string) CommandResult {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.WaitDelay = time.Second
+
+	// CRITICAL: Disable interactive prompts - fail fast instead of hanging
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+
+	// Capture stdout and stderr separately for better error diagnostics
+	var stdoutBuf, stderrBuf strings.Builder
+	cmd.Stdout = &stdoutBuf
+	cmd.Stderr = &stderrBuf
+
+	if err := cmd.Start(); err != nil {
+		return CommandResult{
+			Stdout:   "",
+			Stderr:   fmt.Sprintf("Failed to start command: %v", err),
+			ExitCode: 1,
+			Success:  false,
+		}
+	}
+
+	err := cmd.Wait()
+	exitCode := 0
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			exitCode = exitError.ExitCode()
+		} else {
+			exitCode = 1
+		}
+	}
+	if exitCode == -1 {
+		exitCode = 1 // Killed by ctx: ExitCode() reports -1 for signals
+	}
+
+	return CommandResult{
+		Stdout:   stdoutBuf.String(),
+		Stderr:   strings.TrimSpace(stderrBuf.String()),
+		ExitCode: exitCode,
+		Success:  exitCode == 0,
+	}
+}
+
+// Stream executes git and forwards output to the package Logger as it arrives.
+func (b *ExecBackend) Stream(ctx context.Context, args ...string) CommandResult {
+	cmd := exec.CommandContext(ctx, "git", args...)
+
+	// CRITICAL: Disable interactive prompts - fail fast instead of hanging
+	// This prevents git from waiting for SSH passphrase, HTTP auth, etc.
+	// User must have SSH keys or credential helpers configured
+	cmd.Env = append(os.Environ(),
+		"GIT_TERMINAL_PROMPT=0",
+		"GIT_PROGRESS_DELAY=0", // Show progress immediately, no initial delay
+	)
+	return streamCommand(ctx, cmd)
+}
+
+// streamCommand runs cmd, forwarding stdout to Log and stderr to Error line by line.
+// Stdout/Stderr of the result are empty: output has already been streamed.
+func streamCommand(ctx context.Context, cmd *exec.Cmd) CommandResult {
+	// Create pipes for stdout and stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		Error(fmt.Sprintf("Error creating stdout pipe: %v", err))
+		return CommandResult{Success: false, ExitCode: 1, Stderr: err.Error()}
+	}
+
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		Error(fmt.Sprintf("Error creating stderr pipe: %v", err))
+		return CommandResult{Success: false, ExitCode: 1, Stderr: err.Error()}
+	}
+
+	// Start the command
+	if err := cmd.Start(); err != nil {
+		Error(fmt.Sprintf("Error starting command: %v", err))
+		return CommandResult{Success: false, ExitCode: 1, Stderr: err.Error()}
+	}
+
+	// WaitGroup to ensure all output is captured before completion message
+	var wg sync.WaitGroup
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		streamLines(stdout, Log, LogReplace)
+	}()
+	go func() {
+		defer wg.Done()
+		streamLines(stderr, Error, ErrorReplace)
+	}()
+
+	// Wait for all output to be read from pipes before calling Wait()
+	// CRITICAL: Must drain pipes before cmd.Wait() or output may be lost
+	wg.Wait()
+
+	// Wait for command to complete
+	err = cmd.Wait()
+
+	// Check if context was cancelled
+	if ctx.Err() == context.Canceled {
+		return CommandResult{
+			Stdout:   "",
+			Stderr:   "aborted",
+			ExitCode: 1,
+			Success:  false,
+		}
+	}
+
+	// Determine exit code
+	exitCode := 0
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			exitCode = exitError.ExitCode()
+		} else {
+			exitCode = 1
+		}
+	}
+
+	return CommandResult{
+		Stdout:   "", // Output already streamed to buffer
+		Stderr:   "",
+		ExitCode: exitCode,
+		Success:  exitCode == 0,
+	}
+}
+
+// streamLines reads r byte-by-byte and emits complete lines.
+// CRITICAL: git progress uses \r without \n; such lines replace the previous one.
+func streamLines(r io.Reader, emit func(string), replace func(string)) {
+	var currentLine strings.Builder
+	isProgressLine := false // Track if last output was a \r-terminated progress line
+	oneByte := make([]byte, 1)
+
+	flush := func() {
+		line := strings.TrimSpace(currentLine.String())
+		if line != "" {
+			if isProgressLine {
+				replace(line)
+			} else {
+				emit(line)
+			}
+		}
+		currentLine.Reset()
+	}
+
+	for {
+		n, err := r.Read(oneByte)
+		if n > 0 {
+			ch := oneByte[0]
+			switch ch {
+			case '\n':
+				flush()
+				isProgressLine = false
+			case '\r':
+				line := strings.TrimSpace(currentLine.String())
+				if line != "" && !isProgressLine {
+					emit(line)
+					isProgressLine = true
+					currentLine.Reset()
+				} else {
+					flush()
+				}
+			default:
+				currentLine.WriteByte(ch)
+			}
+		}
+		if err == io.EOF {
+			flush()
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
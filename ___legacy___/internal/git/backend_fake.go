@@ -0,0 +1,99 @@
+package git
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// FakeBackend is an in-memory Backend that replays scripted results.
+// Commands are matched by their space-joined arguments ("status --porcelain=v2").
+// Unscripted commands return the fallback result (exit 1 by default).
+type FakeBackend struct {
+	mu        sync.Mutex
+	responses map[string]CommandResult
+	fallback  CommandResult
+	calls     []string
+}
+
+// NewFakeBackend creates a fake backend with no scripted commands
+func NewFakeBackend() *FakeBackend {
+	return &FakeBackend{
+		responses: make(map[string]CommandResult),
+		fallback:  CommandResult{ExitCode: 1, Success: false},
+	}
+}
+
+// On scripts the result returned for the given argument line
+func (f *FakeBackend) On(args string, result CommandResult) *FakeBackend {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.responses[args] = result
+	return f
+}
+
+// OnOutput scripts a successful command with the given stdout
+func (f *FakeBackend) OnOutput(args string, stdout string) *FakeBackend {
+	return f.On(args, CommandResult{Stdout: stdout, Success: true})
+}
+
+// SetFallback sets the result for commands that were not scripted
+func (f *FakeBackend) SetFallback(result CommandResult) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.fallback = result
+}
+
+// Calls returns the argument lines of every command run so far, in order
+func (f *FakeBackend) Calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	calls := make([]string, len(f.calls))
+	copy(calls, f.calls)
+	return calls
+}
+
+// Run records the command and returns its scripted result
+func (f *FakeBackend) Run(args ...string) CommandResult {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key := strings.Join(args, " ")
+	f.calls = append(f.calls, key)
+	if result, ok := f.responses[key]; ok {
+		return result
+	}
+	return f.fallback
+}
+
+// RunContext records the command and returns its scripted result, or an abort if ctx ended
+func (f *FakeBackend) RunContext(ctx context.Context, args ...string) CommandResult {
+	if ctx.Err() != nil {
+		return CommandResult{Stderr: "aborted", ExitCode: 1, Success: false}
+	}
+	return f.Run(args...)
+}
+
+// RunRaw records the command and returns its scripted result; scripted
+// stdout is already exact, so it is the same as Run
+func (f *FakeBackend) RunRaw(args ...string) CommandResult {
+	return f.Run(args...)
+}
+
+// Stream records the command and emits its scripted output to the package Logger
+func (f *FakeBackend) Stream(ctx context.Context, args ...string) CommandResult {
+	if ctx.Err() != nil {
+		return CommandResult{Stderr: "aborted", ExitCode: 1, Success: false}
+	}
+	result := f.Run(args...)
+	for _, line := range strings.Split(result.Stdout, "\n") {
+		if line != "" {
+			Log(line)
+		}
+	}
+	for _, line := range strings.Split(result.Stderr, "\n") {
+		if line != "" {
+			Error(line)
+		}
+	}
+	return CommandResult{ExitCode: result.ExitCode, Success: result.Success}
+}
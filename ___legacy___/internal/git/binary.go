@@ -0,0 +1,218 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Binary files: git decides what is binary (numstat "-", or the binary/-diff
+// attributes) and TIT shows blob hashes and sizes instead of contents.
+// Contents are only read on request, e.g. to preview small images.
+
+// Blob is one side of a binary file, identified without reading it
+type Blob struct {
+	Hash string // Object id ("" = the file does not exist on this side)
+	Size int64
+	File string // Working tree path when this side is the working tree copy
+}
+
+// Exists reports whether the file exists on this side
+func (b Blob) Exists() bool {
+	return b.Hash != ""
+}
+
+// BinaryDiff is a binary file change: the blobs before and after
+type BinaryDiff struct {
+	Path     string
+	Old, New Blob
+}
+
+// splitNumstatPatch splits `git diff --numstat --patch` output for one path
+// into its numstat line and the patch that follows the blank separator line
+func splitNumstatPatch(output string) (numstat, patch string) {
+	numstat, patch, _ = strings.Cut(output, "\n\n")
+	return numstat, patch
+}
+
+// isBinaryNumstat reports whether a numstat line marks its file binary ("-\t-\tpath")
+func isBinaryNumstat(line string) bool {
+	return strings.HasPrefix(line, "-\t-\t")
+}
+
+// IsBinaryPath reports whether gitattributes mark path binary: "binary" set,
+// or "diff" unset as in `*.png -diff`
+func IsBinaryPath(path string) bool {
+	output, err := executeGitCommand("check-attr", "-z", "binary", "diff", "--", path)
+	if err != nil {
+		return false
+	}
+	fields := strings.Split(output, "\x00")
+	for i := 0; i+2 < len(fields); i += 3 {
+		attr, value := fields[i+1], fields[i+2]
+		if attr == "binary" && value == "set" || attr == "diff" && value == "unset" {
+			return true
+		}
+	}
+	return false
+}
+
+// BlobAt returns the blob of path at rev (commit, branch, tag or ":N" index stage).
+// A path missing at rev returns a zero Blob.
+func BlobAt(rev, path string) Blob {
+	hash, err := executeGitCommand("rev-parse", "--verify", "--quiet", rev+":"+path)
+	if err != nil {
+		return Blob{}
+	}
+	return blobWithSize(hash)
+}
+
+// WorkTreeBlob returns the working tree copy of path, hashed without writing
+// it to the object store. A missing file returns a zero Blob.
+func WorkTreeBlob(path string) Blob {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return Blob{}
+	}
+	hash, err := executeGitCommand("hash-object", "--", path)
+	if err != nil {
+		return Blob{}
+	}
+	return Blob{Hash: hash, Size: info.Size(), File: path}
+}
+
+// blobWithSize looks up the size of an object
+func blobWithSize(hash string) Blob {
+	blob := Blob{Hash: hash}
+	if size, err := executeGitCommand("cat-file", "-s", hash); err == nil {
+		blob.Size, _ = strconv.ParseInt(size, 10, 64)
+	}
+	return blob
+}
+
+// ReadBlob returns the contents of a blob. Objects are read with
+// `git cat-file blob` through RunRaw, since Run trims output and would cut bytes.
+func ReadBlob(blob Blob) ([]byte, error) {
+	if !blob.Exists() {
+		return nil, fmt.Errorf("blob does not exist")
+	}
+	if blob.File != "" {
+		return os.ReadFile(blob.File)
+	}
+	return catBlob(blob.Hash)
+}
+
+// catBlob returns the exact contents of the blob with the given object id
+func catBlob(hash string) ([]byte, error) {
+	result := CurrentBackend().RunRaw("cat-file", "blob", hash)
+	if !result.Success {
+		return nil, fmt.Errorf("failed to read blob %s: %w", hash, resultError(result))
+	}
+	return []byte(result.Stdout), nil
+}
+
+// commitDiffSides returns the blobs a commit diff compares for path
+// version: "parent" (parent vs commit) or "wip" (commit vs working tree)
+func commitDiffSides(hash, path, version string) BinaryDiff {
+	if version == "wip" {
+		return BinaryDiff{Path: path, Old: BlobAt(hash, path), New: WorkTreeBlob(path)}
+	}
+	return BinaryDiff{Path: path, Old: BlobAt(hash+"^", path), New: BlobAt(hash, path)}
+}
+
+// GetCompareBinaryDiff returns the blobs of a binary file on both sides of a compare.
+// mode: CompareThreeDot (merge base vs right) or CompareTwoDot (left vs right)
+func GetCompareBinaryDiff(left, right, path, mode string) (BinaryDiff, error) {
+	if _, err := compareRange(left, right, mode); err != nil {
+		return BinaryDiff{}, err
+	}
+	oldRev := left
+	if mode == CompareThreeDot {
+		base, err := executeGitCommand("merge-base", left, right)
+		if err != nil {
+			return BinaryDiff{}, fmt.Errorf("failed to find merge base of %s and %s: %w", left, right, err)
+		}
+		oldRev = base
+	}
+	return BinaryDiff{Path: path, Old: BlobAt(oldRev, path), New: BlobAt(right, path)}, nil
+}
+
+// ConflictStageBlobs returns the BASE, LOCAL and REMOTE stages (1-3) of a
+// conflicted path from the index; stages missing from the index are zero Blobs
+func ConflictStageBlobs(path string) ([3]Blob, error) {
+	var stages [3]Blob
+	output, err := executeGitCommand("ls-files", "-u", "--", path)
+	if err != nil {
+		return stages, fmt.Errorf("failed to list stages of %s: %w", path, err)
+	}
+	// Format: <mode> <hash> <stage>\t<path>
+	for _, line := range strings.Split(output, "\n") {
+		meta, _, ok := strings.Cut(line, "\t")
+		fields := strings.Fields(meta)
+		if !ok || len(fields) != 3 {
+			continue
+		}
+		stage, err := strconv.Atoi(fields[2])
+		if err != nil || stage < 1 || stage > 3 {
+			continue
+		}
+		stages[stage-1] = blobWithSize(fields[1])
+	}
+	return stages, nil
+}
+
+// IsBinaryConflict reports whether a conflicted path is binary: by attributes,
+// or by numstat between two of its stages. A lone stage is compared with the
+// empty blob, so git sniffs it and TIT never loads its contents.
+func IsBinaryConflict(path string, stages [3]Blob) bool {
+	if IsBinaryPath(path) {
+		return true
+	}
+	var present []string
+	for _, blob := range stages {
+		if blob.Exists() {
+			present = append(present, blob.Hash)
+		}
+	}
+	if len(present) == 1 {
+		// Stdin is empty: this writes the empty blob (diff needs it stored) and
+		// returns its id in the repository's hash format
+		empty, err := executeGitCommand("hash-object", "-w", "-t", "blob", "--stdin")
+		if err != nil {
+			return false
+		}
+		present = append([]string{empty}, present...)
+	}
+	if len(present) < 2 {
+		return false
+	}
+	output, err := executeGitCommand("diff", "--numstat", present[0], present[len(present)-1])
+	return err == nil && isBinaryNumstat(output)
+}
+
+// CheckoutConflictStage resolves a conflicted path to one stage (1-3) without
+// reading it: git writes that stage's blob to the working tree and stages it.
+// A stage missing from the index resolves the conflict as a deletion.
+func CheckoutConflictStage(path string, stage int) error {
+	if stage < 1 || stage > 3 {
+		return fmt.Errorf("invalid stage: %d (must be 1-3)", stage)
+	}
+	stages, err := ConflictStageBlobs(path)
+	if err != nil {
+		return err
+	}
+	if !stages[stage-1].Exists() {
+		if _, err := executeGitCommand("rm", "--quiet", "--", path); err != nil {
+			return fmt.Errorf("failed to remove %s: %w", path, err)
+		}
+		return nil
+	}
+	if _, err := executeGitCommand("checkout-index", "--force", "--stage="+strconv.Itoa(stage), "--", path); err != nil {
+		return fmt.Errorf("failed to check out stage %d of %s: %w", stage, path, err)
+	}
+	if _, err := executeGitCommand("add", "--", path); err != nil {
+		return fmt.Errorf("failed to stage %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package git
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBinaryFiles(t *testing.T) {
+	root := newGitTestRepo(t)
+	t.Chdir(root)
+	write := func(name string, data []byte) {
+		t.Helper()
+		if err := os.WriteFile(name, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	base := []byte("\x00\x01base\n\n")
+	ours := []byte("\x00\x02ours, ending in whitespace \n\t")
+	theirs := []byte("\x00\x03theirs")
+	gitRun(t, root, "init", "-q", "-b", "main")
+	write("data.bin", base)
+	write("notes.txt", []byte("one\n"))
+	write("scan.dat", []byte("plain text, but marked binary\n"))
+	write(".gitattributes", []byte("*.dat -diff\n"))
+	gitRun(t, root, "add", ".")
+	gitRun(t, root, "commit", "-q", "-m", "base")
+	gitRun(t, root, "switch", "-q", "-c", "theirs")
+	write("data.bin", theirs)
+	gitRun(t, root, "commit", "-q", "-am", "theirs")
+	gitRun(t, root, "switch", "-q", "main")
+	write("data.bin", ours)
+	write("notes.txt", []byte("one\ntwo\n"))
+	gitRun(t, root, "commit", "-q", "-am", "ours")
+
+	if !IsBinaryPath("scan.dat") || IsBinaryPath("notes.txt") {
+		t.Errorf("IsBinaryPath: scan.dat = %v, notes.txt = %v; want true, false", IsBinaryPath("scan.dat"), IsBinaryPath("notes.txt"))
+	}
+
+	diff, binary, err := GetCommitDiff("HEAD", "notes.txt", "parent")
+	if err != nil || binary != nil || !strings.HasPrefix(diff, "diff --git") || !strings.Contains(diff, "+two") {
+		t.Errorf("GetCommitDiff(notes.txt) = %q, %+v, %v; want a text patch", diff, binary, err)
+	}
+	diff, binary, err = GetCommitDiff("HEAD", "data.bin", "parent")
+	if err != nil || diff != "" || binary == nil {
+		t.Fatalf("GetCommitDiff(data.bin) = %q, %+v, %v; want binary sides", diff, binary, err)
+	}
+	if binary.Old.Size != int64(len(base)) || binary.New.Size != int64(len(ours)) || binary.Old.Hash == binary.New.Hash {
+		t.Errorf("binary sides = %+v, want sizes %d and %d", binary, len(base), len(ours))
+	}
+	if data, err := ReadBlob(binary.New); err != nil || !bytes.Equal(data, ours) {
+		t.Errorf("ReadBlob() = %q, %v; want %q byte for byte", data, err, ours)
+	}
+
+	// Conflicts: merge exits 1 and leaves the stages in the index
+	exec.Command("git", "merge", "-q", "theirs").Run()
+	stages, err := ConflictStageBlobs("data.bin")
+	if err != nil || !stages[0].Exists() || !stages[1].Exists() || stages[2].Size != int64(len(theirs)) {
+		t.Fatalf("ConflictStageBlobs() = %+v, %v", stages, err)
+	}
+	if !IsBinaryConflict("data.bin", stages) {
+		t.Error("IsBinaryConflict(data.bin) = false, want true")
+	}
+	if !IsBinaryConflict("data.bin", [3]Blob{1: stages[1]}) {
+		t.Error("IsBinaryConflict(data.bin, one stage) = false, want true")
+	}
+	if leftovers, _ := filepath.Glob(".merge_file_*"); len(leftovers) > 0 {
+		t.Errorf("reading blobs left files in the repository: %v", leftovers)
+	}
+	if err := CheckoutConflictStage("data.bin", 3); err != nil {
+		t.Fatalf("CheckoutConflictStage() = %v", err)
+	}
+	if data, _ := os.ReadFile("data.bin"); !bytes.Equal(data, theirs) {
+		t.Errorf("data.bin = %q, want theirs %q", data, theirs)
+	}
+	if unmerged := gitRun(t, root, "ls-files", "-u"); unmerged != "" {
+		t.Errorf("still unmerged after resolving: %q", unmerged)
+	}
+}
+
+// A lone stage is sniffed by git against the empty blob, never read by TIT
+func TestIsBinaryConflictLoneStage(t *testing.T) {
+	fake := useFakeBackend(t)
+	fake.OnOutput("check-attr -z binary diff -- big.psd", "big.psd\x00binary\x00unspecified\x00big.psd\x00diff\x00unspecified\x00")
+	fake.OnOutput("hash-object -w -t blob --stdin", "e69de29bb2d1d6434b8b29ae775ad8c2e48c5391")
+	fake.OnOutput("diff --numstat e69de29bb2d1d6434b8b29ae775ad8c2e48c5391 3b18e512dba79e4c8300dd08aeb37f8e728b8dad", "-\t-\t3b18e512dba79e4c8300dd08aeb37f8e728b8dad")
+
+	lone := Blob{Hash: "3b18e512dba79e4c8300dd08aeb37f8e728b8dad", Size: 4 << 30}
+	if !IsBinaryConflict("big.psd", [3]Blob{2: lone}) {
+		t.Error("IsBinaryConflict(lone stage) = false, want true")
+	}
+	for _, call := range fake.Calls() {
+		if strings.HasPrefix(call, "cat-file") || strings.HasPrefix(call, "unpack-file") {
+			t.Errorf("read the blob to sniff it: %q", call)
+		}
+	}
+}
@@ -1,9 +1,7 @@
 package git
 
 import (
-	"bytes"
 	"fmt"
-	"os/exec"
 	"strconv"
 	"strings"
 	"time"
@@ -21,23 +19,23 @@ type BranchDetails struct {
 	TrackingRemote string // e.g., "origin/main", or "" if local only
 	Ahead          int
 	Behind         int
+	Gone           bool // Upstream configured but deleted on the remote (pruned by fetch --prune)
+	Merged         bool // Tip reachable from the canon branch (see MarkMerged)
+	IsRemote       bool // Remote-tracking branch; Name is e.g. "origin/feature"
+	HasLocal       bool // Remote branch already tracked by a local branch
+	IsTag          bool // Tag (listed for compare only); LastCommit* describe the tagged commit
 }
 
 // ListBranchesWithDetails returns all local branches with metadata
 func ListBranchesWithDetails() ([]BranchDetails, error) {
-	cmd := exec.Command("git", "for-each-ref", "--sort=-committerdate", "refs/heads",
+	output, err := executeGitCommand("for-each-ref", "--sort=-committerdate", "refs/heads",
 		"--format=%(refname:short)%09%(HEAD)%09%(committerdate:iso)%09%(objectname:short)%09%(subject)%09%(committerdate:short)%09%(authorname)%09%(upstream:short)%09%(upstream:track)")
-
-	var out bytes.Buffer
-	cmd.Stdout = &out
-	cmd.Stderr = &bytes.Buffer{}
-
-	if err := cmd.Run(); err != nil {
+	if err != nil {
 		return nil, fmt.Errorf("failed to list branches: %w", err)
 	}
 
 	branches := []BranchDetails{}
-	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	lines := strings.Split(output, "\n")
 	for _, line := range lines {
 		if line == "" {
 			continue
@@ -62,6 +60,7 @@ func ListBranchesWithDetails() ([]BranchDetails, error) {
 
 		// Parse ahead/behind from track string (format: "[ahead 5, behind 2]")
 		ahead, behind := 0, 0
+		gone := len(parts) > 8 && parts[8] == "[gone]"
 		if len(parts) > 8 && parts[8] != "" {
 			trackStr := parts[8]
 			if strings.Contains(trackStr, "ahead") {
@@ -87,6 +86,7 @@ func ListBranchesWithDetails() ([]BranchDetails, error) {
 			TrackingRemote: trackingRemote,
 			Ahead:          ahead,
 			Behind:         behind,
+			Gone:           gone,
 		})
 	}
 
@@ -107,38 +107,206 @@ func ListBranchesWithDetails() ([]BranchDetails, error) {
 	return branches, nil
 }
 
+// ListRemoteBranchesWithDetails returns remote-tracking branches (symbolic refs like origin/HEAD excluded),
+// newest first. HasLocal is set for those a local branch in locals tracks.
+func ListRemoteBranchesWithDetails(locals []BranchDetails) ([]BranchDetails, error) {
+	output, err := executeGitCommand("for-each-ref", "--sort=-committerdate", "refs/remotes",
+		"--format=%(refname:short)%09%(symref)%09%(committerdate:iso)%09%(objectname:short)%09%(subject)%09%(authorname)")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remote branches: %w", err)
+	}
+
+	tracked := make(map[string]bool, len(locals))
+	for _, local := range locals {
+		if local.TrackingRemote != "" {
+			tracked[local.TrackingRemote] = true
+		}
+	}
+
+	branches := []BranchDetails{}
+	for _, line := range strings.Split(output, "\n") {
+		parts := strings.Split(line, "\t")
+		if len(parts) < 6 || parts[1] != "" {
+			continue
+		}
+		commitTime, _ := time.Parse(internal.GitTimestampFormat, parts[2])
+		branches = append(branches, BranchDetails{
+			Name:           parts[0],
+			LastCommitTime: commitTime,
+			LastCommitHash: parts[3],
+			LastCommitSubj: parts[4],
+			Author:         parts[5],
+			IsRemote:       true,
+			HasLocal:       tracked[parts[0]],
+		})
+	}
+	return branches, nil
+}
+
+// ListTagsWithDetails returns tags, newest first. Annotated tags are peeled to
+// the commit they tag; tags on other objects (trees, blobs) are skipped.
+func ListTagsWithDetails() ([]BranchDetails, error) {
+	output, err := executeGitCommand("for-each-ref", "--sort=-creatordate", "refs/tags",
+		"--format=%(refname:short)%09%(objecttype)%09%(*objecttype)%09%(committerdate:iso)%09%(*committerdate:iso)%09%(objectname:short)%09%(*objectname:short)%09%(subject)%09%(*subject)%09%(authorname)%09%(*authorname)")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	tags := []BranchDetails{}
+	for _, line := range strings.Split(output, "\n") {
+		parts := strings.Split(line, "\t")
+		if len(parts) < 11 {
+			continue
+		}
+		// Lightweight tags carry the commit fields directly, annotated ones in the peeled (*) fields
+		date, hash, subj, author := parts[3], parts[5], parts[7], parts[9]
+		switch {
+		case parts[1] == "tag" && parts[2] == "commit":
+			date, hash, subj, author = parts[4], parts[6], parts[8], parts[10]
+		case parts[1] != "commit":
+			continue
+		}
+		commitTime, _ := time.Parse(internal.GitTimestampFormat, date)
+		tags = append(tags, BranchDetails{
+			Name:           parts[0],
+			LastCommitTime: commitTime,
+			LastCommitHash: hash,
+			LastCommitSubj: subj,
+			Author:         author,
+			IsTag:          true,
+		})
+	}
+	return tags, nil
+}
+
+// CanonBranch returns the branch merged status is measured against: tit.canon when set,
+// else the branch origin/HEAD points to, else main or master when one exists locally.
+// Empty when none can be determined.
+func CanonBranch() string {
+	if canon := LoadWorkflow().Canon; canon != "" {
+		return canon
+	}
+	if ref, err := executeGitCommand("symbolic-ref", "--short", "refs/remotes/origin/HEAD"); err == nil && ref != "" {
+		return strings.TrimPrefix(ref, "origin/")
+	}
+	for _, name := range []string{"main", "master"} {
+		if Execute("rev-parse", "--verify", "--quiet", "refs/heads/"+name).Success {
+			return name
+		}
+	}
+	return ""
+}
+
+// MarkMerged sets Merged on every branch whose tip is reachable from canon
+// (the local branch, or origin/<canon> when there is no local one). The canon branch itself is never marked.
+func MarkMerged(branches []BranchDetails, canon string) {
+	if canon == "" {
+		return
+	}
+	canonRef := "refs/heads/" + canon
+	if !Execute("rev-parse", "--verify", "--quiet", canonRef).Success {
+		canonRef = "refs/remotes/origin/" + canon
+	}
+	output, err := executeGitCommand("for-each-ref", "--merged="+canonRef, "--format=%(refname:short)", "refs/heads", "refs/remotes")
+	if err != nil {
+		return
+	}
+
+	merged := make(map[string]bool)
+	for _, name := range strings.Split(output, "\n") {
+		merged[name] = true
+	}
+	for i := range branches {
+		name := branches[i].Name
+		branches[i].Merged = merged[name] && name != canon && name != "origin/"+canon
+	}
+}
+
+// PruneCandidates returns the local branches safe to clean up in bulk: merged into canon or
+// whose upstream is gone. The current branch and canon are never included.
+func PruneCandidates(branches []BranchDetails, canon string) []string {
+	var names []string
+	for _, b := range branches {
+		if b.IsRemote || b.IsCurrent || b.Name == canon {
+			continue
+		}
+		if b.Merged || b.Gone {
+			names = append(names, b.Name)
+		}
+	}
+	return names
+}
+
+// LocalNameForRemote strips the remote from a remote-tracking branch ("origin/feat/x" -> "feat/x")
+func LocalNameForRemote(remoteBranch string) string {
+	if _, name, found := strings.Cut(remoteBranch, "/"); found {
+		return name
+	}
+	return remoteBranch
+}
+
+// TrackRemoteBranch creates a local branch tracking remoteBranch without switching to it.
+// Returns the local branch name.
+func TrackRemoteBranch(remoteBranch string) (string, error) {
+	local := LocalNameForRemote(remoteBranch)
+	if result := Execute("branch", "--track", local, remoteBranch); !result.Success {
+		return "", fmt.Errorf("failed to track %s: %v", remoteBranch, resultError(result))
+	}
+	return local, nil
+}
+
+// RenameBranch renames a local branch (git branch -m); works for the current branch too
+func RenameBranch(oldName, newName string) error {
+	if result := Execute("branch", "-m", oldName, newName); !result.Success {
+		return fmt.Errorf("failed to rename %s: %v", oldName, resultError(result))
+	}
+	return nil
+}
+
+// SetUpstream makes branch track upstream (e.g. "origin/feature")
+func SetUpstream(branch, upstream string) error {
+	if result := Execute("branch", "--set-upstream-to="+upstream, branch); !result.Success {
+		return fmt.Errorf("failed to set upstream of %s: %v", branch, resultError(result))
+	}
+	return nil
+}
+
+// UnsetUpstream removes branch's upstream configuration
+func UnsetUpstream(branch string) error {
+	if result := Execute("branch", "--unset-upstream", branch); !result.Success {
+		return fmt.Errorf("failed to unset upstream of %s: %v", branch, resultError(result))
+	}
+	return nil
+}
+
+// DeleteBranches force-deletes local branches (callers confirm first: gone branches may be unmerged)
+func DeleteBranches(names []string) error {
+	if result := Execute(append([]string{"branch", "-D"}, names...)...); !result.Success {
+		return fmt.Errorf("failed to delete branches: %v", resultError(result))
+	}
+	return nil
+}
+
 // SwitchBranch performs git switch to target branch
 func SwitchBranch(branchName string) error {
-	cmd := exec.Command("git", "switch", branchName)
-	var errBuf bytes.Buffer
-	cmd.Stderr = &errBuf
-
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("failed to switch to branch %s: %v", branchName, err)
+	if result := Execute("switch", branchName); !result.Success {
+		return fmt.Errorf("failed to switch to branch %s: %v", branchName, resultError(result))
 	}
 	return nil
 }
 
 // StashChanges stashes current changes
 func StashChanges() error {
-	cmd := exec.Command("git", "stash", "push", "-u")
-	var errBuf bytes.Buffer
-	cmd.Stderr = &errBuf
-
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("failed to stash changes: %w", err)
+	if result := Execute("stash", "push", "-u"); !result.Success {
+		return fmt.Errorf("failed to stash changes: %w", resultError(result))
 	}
 	return nil
 }
 
 // PopStash applies stashed changes
 func PopStash() error {
-	cmd := exec.Command("git", "stash", "pop")
-	var errBuf bytes.Buffer
-	cmd.Stderr = &errBuf
-
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("failed to pop stash: %w", err)
+	if result := Execute("stash", "pop"); !result.Success {
+		return fmt.Errorf("failed to pop stash: %w", resultError(result))
 	}
 	return nil
 }
@@ -0,0 +1,105 @@
+package git
+
+import (
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestBranchCleanup(t *testing.T) {
+	root := newGitTestRepo(t)
+
+	// merged: contained in main; gone: pushed, then deleted on the remote and pruned;
+	// wip: unmerged and local; feature: exists only on the remote
+	remote := filepath.Join(root, "remote.git")
+	work := filepath.Join(root, "work")
+	peer := filepath.Join(root, "peer")
+	gitRun(t, root, "init", "-q", "--bare", "-b", "main", remote)
+	gitRun(t, root, "clone", "-q", remote, work)
+	gitRun(t, work, "commit", "-q", "--allow-empty", "-m", "initial")
+	gitRun(t, work, "push", "-q", "-u", "origin", "main")
+	gitRun(t, work, "branch", "merged")
+	gitRun(t, work, "switch", "-q", "-c", "gone")
+	gitRun(t, work, "commit", "-q", "--allow-empty", "-m", "gone work")
+	gitRun(t, work, "push", "-q", "-u", "origin", "gone")
+	gitRun(t, work, "switch", "-q", "-c", "wip", "main")
+	gitRun(t, work, "commit", "-q", "--allow-empty", "-m", "wip")
+	gitRun(t, work, "switch", "-q", "main")
+	gitRun(t, root, "clone", "-q", remote, peer)
+	gitRun(t, peer, "switch", "-q", "-c", "feature")
+	gitRun(t, peer, "commit", "-q", "--allow-empty", "-m", "feature")
+	gitRun(t, peer, "push", "-q", "origin", "feature")
+	gitRun(t, peer, "push", "-q", "origin", "--delete", "gone")
+	gitRun(t, work, "fetch", "-q", "--prune", "origin")
+	gitRun(t, work, "remote", "set-head", "origin", "main")
+	t.Chdir(work)
+
+	locals, err := ListBranchesWithDetails()
+	if err != nil {
+		t.Fatal(err)
+	}
+	canon := CanonBranch()
+	if canon != "main" {
+		t.Fatalf("CanonBranch() = %q, want main", canon)
+	}
+	MarkMerged(locals, canon)
+	byName := make(map[string]BranchDetails)
+	for _, b := range locals {
+		byName[b.Name] = b
+	}
+	if !byName["gone"].Gone || byName["wip"].Gone {
+		t.Errorf("Gone: gone=%v wip=%v, want true false", byName["gone"].Gone, byName["wip"].Gone)
+	}
+	if !byName["merged"].Merged || byName["wip"].Merged || byName["main"].Merged {
+		t.Errorf("Merged: merged=%v wip=%v main=%v, want true false false", byName["merged"].Merged, byName["wip"].Merged, byName["main"].Merged)
+	}
+
+	candidates := PruneCandidates(locals, canon)
+	sorted := append([]string(nil), candidates...)
+	sort.Strings(sorted) // Order follows commit dates, which may tie
+	if want := []string{"gone", "merged"}; !reflect.DeepEqual(sorted, want) {
+		t.Errorf("PruneCandidates() = %v, want %v", sorted, want)
+	}
+
+	remotes, err := ListRemoteBranchesWithDetails(locals)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, b := range remotes {
+		names = append(names, b.Name)
+		if b.Name == "origin/main" && !b.HasLocal {
+			t.Errorf("origin/main should be tracked by main")
+		}
+	}
+	if want := []string{"origin/feature", "origin/main"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("remote branches = %v, want %v", names, want)
+	}
+
+	local, err := TrackRemoteBranch("origin/feature")
+	if err != nil || local != "feature" {
+		t.Fatalf("TrackRemoteBranch() = %q, %v", local, err)
+	}
+	if err := RenameBranch("feature", "feature-2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := UnsetUpstream("feature-2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetUpstream("feature-2", "origin/feature"); err != nil {
+		t.Fatal(err)
+	}
+	if err := DeleteBranches(candidates); err != nil {
+		t.Fatal(err)
+	}
+	after, _ := ListBranchesWithDetails()
+	var left []string
+	for _, b := range after {
+		left = append(left, b.Name+"→"+b.TrackingRemote)
+	}
+	if got := strings.Join(left, " "); !strings.Contains(got, "feature-2→origin/feature") || strings.Contains(got, "merged") || strings.Contains(got, "gone") {
+		t.Errorf("branches after cleanup: %s", got)
+	}
+}
@@ -0,0 +1,124 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jrengmusic/tit/internal"
+)
+
+// Repository commands (build, test, ... from .tit.toml) run at time travel commits.
+// The latest result per commit and command is kept in .git/TIT_RUNS, one
+// "<hash>\t<command>\t<pass|fail>\t<duration ms>\t<unix time>" line each.
+
+const (
+	runsFile   = "TIT_RUNS"
+	runPassed  = "pass"
+	runFailed  = "fail"
+	runsFields = 5
+)
+
+// RunRecord is the recorded outcome of a repository command at a commit
+type RunRecord struct {
+	Hash     string // Full commit hash the command ran at
+	Command  string // Command name from .tit.toml
+	Passed   bool   // Exit status 0
+	Duration time.Duration
+	At       time.Time // When the run finished
+}
+
+// StreamShell runs command with sh -c in the working directory, streaming its
+// output like Stream does for git. Killed when ctx is cancelled.
+func StreamShell(ctx context.Context, command string) CommandResult {
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	return streamCommand(ctx, cmd)
+}
+
+// LoadRunRecords reads the recorded command results. A missing file means none.
+func LoadRunRecords() ([]RunRecord, error) {
+	content, err := os.ReadFile(filepath.Join(internal.GitDirectoryName, runsFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read command results: %w", err)
+	}
+	return parseRunRecords(string(content)), nil
+}
+
+// parseRunRecords parses TIT_RUNS lines, skipping malformed ones
+func parseRunRecords(content string) []RunRecord {
+	var records []RunRecord
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Split(line, "\t")
+		if len(fields) != runsFields || fields[0] == "" || fields[1] == "" {
+			continue
+		}
+		if fields[2] != runPassed && fields[2] != runFailed {
+			continue
+		}
+		ms, err := strconv.ParseInt(fields[3], 10, 64)
+		if err != nil {
+			continue
+		}
+		at, err := strconv.ParseInt(fields[4], 10, 64)
+		if err != nil {
+			continue
+		}
+		records = append(records, RunRecord{
+			Hash:     fields[0],
+			Command:  fields[1],
+			Passed:   fields[2] == runPassed,
+			Duration: time.Duration(ms) * time.Millisecond,
+			At:       time.Unix(at, 0),
+		})
+	}
+	return records
+}
+
+// SaveRunRecord records record, replacing an earlier result of the same command at the same commit
+func SaveRunRecord(record RunRecord) error {
+	records, err := LoadRunRecords()
+	if err != nil {
+		return err
+	}
+	kept := records[:0]
+	for _, r := range records {
+		if r.Hash != record.Hash || r.Command != record.Command {
+			kept = append(kept, r)
+		}
+	}
+	return writeRunRecords(append(kept, record))
+}
+
+// writeRunRecords replaces .git/TIT_RUNS
+func writeRunRecords(records []RunRecord) error {
+	var b strings.Builder
+	for _, r := range records {
+		status := runFailed
+		if r.Passed {
+			status = runPassed
+		}
+		fmt.Fprintf(&b, "%s\t%s\t%s\t%d\t%d\n", r.Hash, r.Command, status, r.Duration.Milliseconds(), r.At.Unix())
+	}
+	path := filepath.Join(internal.GitDirectoryName, runsFile)
+	if err := os.WriteFile(path, []byte(b.String()), internal.StateFilePerms); err != nil {
+		return fmt.Errorf("failed to write command results: %w", err)
+	}
+	return nil
+}
+
+// RunRecordsByHash groups records by commit, in the order they were recorded
+func RunRecordsByHash(records []RunRecord) map[string][]RunRecord {
+	byHash := make(map[string][]RunRecord)
+	for _, r := range records {
+		byHash[r.Hash] = append(byHash[r.Hash], r)
+	}
+	return byHash
+}
@@ -0,0 +1,27 @@
+package git
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseRunRecords(t *testing.T) {
+	content := "aaa\tbuild\tpass\t1500\t1700000000\n" +
+		"aaa\ttest\tfail\t20\t1700000100\n" +
+		"\n" +
+		"bbb\tbuild\tmaybe\t1\t1\n" + // Unknown status
+		"ccc\tbuild\tpass\tfast\t1\n" + // Bad duration
+		"ddd\tbuild\tpass\t1\n" // Missing field
+	want := []RunRecord{
+		{Hash: "aaa", Command: "build", Passed: true, Duration: 1500 * time.Millisecond, At: time.Unix(1700000000, 0)},
+		{Hash: "aaa", Command: "test", Passed: false, Duration: 20 * time.Millisecond, At: time.Unix(1700000100, 0)},
+	}
+	got := parseRunRecords(content)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseRunRecords() = %+v, want %+v", got, want)
+	}
+	if byHash := RunRecordsByHash(got); len(byHash) != 1 || len(byHash["aaa"]) != 2 {
+		t.Errorf("RunRecordsByHash() = %+v, want both records under aaa", byHash)
+	}
+}
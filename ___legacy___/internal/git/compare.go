@@ -0,0 +1,130 @@
+package git
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Compare: two commits, branches or tags side by side. Commits unique to each
+// side come from `git log A..B` both ways; files and diffs use either range form.
+
+// Compare range forms (the separator between the two refs)
+const (
+	CompareThreeDot = "..." // Merge base vs right tip: what the right side added since the sides split
+	CompareTwoDot   = ".."  // Left tip vs right tip directly
+)
+
+// CompareFile is a file changed between the two sides of a compare
+type CompareFile struct {
+	Path    string
+	Status  string // A, M, D, T (renames are shown as delete + add)
+	Added   int    // Lines added (0 for binary files)
+	Deleted int    // Lines deleted (0 for binary files)
+	Binary  bool   // numstat reports "-" for binary files
+}
+
+// CompareCommits returns up to limit commits reachable from each side but not the other
+func CompareCommits(left, right string, limit int) (leftOnly, rightOnly []CommitInfo, err error) {
+	leftOnly, err = commitsOnlyIn(left, right, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	rightOnly, err = commitsOnlyIn(right, left, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	return leftOnly, rightOnly, nil
+}
+
+// commitsOnlyIn lists commits in ref that are not in other, newest first
+func commitsOnlyIn(ref, other string, limit int) ([]CommitInfo, error) {
+	result := Execute("log", fmt.Sprintf("-%d", limit), "--pretty=%H%n%s%n%ai", other+".."+ref, "--")
+	if !result.Success {
+		return nil, fmt.Errorf("failed to list commits in %s: %s", ref, result.Stderr)
+	}
+	return parseCommitLog(result.Stdout), nil
+}
+
+// CompareFiles lists files changed between left and right with line stats.
+// mode: CompareThreeDot or CompareTwoDot
+func CompareFiles(left, right, mode string) ([]CompareFile, error) {
+	spec, err := compareRange(left, right, mode)
+	if err != nil {
+		return nil, err
+	}
+	status := Execute("diff", "--no-renames", "--name-status", spec, "--")
+	if !status.Success {
+		return nil, fmt.Errorf("failed to compare %s: %s", spec, status.Stderr)
+	}
+	numstat := Execute("diff", "--no-renames", "--numstat", spec, "--")
+	if !numstat.Success {
+		return nil, fmt.Errorf("failed to compare %s: %s", spec, numstat.Stderr)
+	}
+	return parseCompareFiles(status.Stdout, numstat.Stdout), nil
+}
+
+// parseCompareFiles joins `--name-status` ("M\tpath") and `--numstat`
+// ("12\t3\tpath", "-\t-\tpath" for binary) output by path
+func parseCompareFiles(nameStatus, numstat string) []CompareFile {
+	type stat struct {
+		added, deleted int
+		binary         bool
+	}
+	stats := make(map[string]stat)
+	for _, line := range strings.Split(numstat, "\n") {
+		parts := strings.SplitN(line, "\t", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		if parts[0] == "-" && parts[1] == "-" {
+			stats[parts[2]] = stat{binary: true}
+			continue
+		}
+		added, errA := strconv.Atoi(parts[0])
+		deleted, errD := strconv.Atoi(parts[1])
+		if errA != nil || errD != nil {
+			continue
+		}
+		stats[parts[2]] = stat{added: added, deleted: deleted}
+	}
+
+	var files []CompareFile
+	for _, line := range strings.Split(nameStatus, "\n") {
+		parts := strings.SplitN(line, "\t", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			continue
+		}
+		s := stats[parts[1]]
+		files = append(files, CompareFile{
+			Path:    parts[1],
+			Status:  parts[0][:1],
+			Added:   s.added,
+			Deleted: s.deleted,
+			Binary:  s.binary,
+		})
+	}
+	return files
+}
+
+// GetCompareDiff fetches the diff of one file between left and right
+// mode: CompareThreeDot or CompareTwoDot
+func GetCompareDiff(left, right, path, mode string) (string, error) {
+	spec, err := compareRange(left, right, mode)
+	if err != nil {
+		return "", err
+	}
+	result := Execute("diff", "--no-renames", spec, "--", path)
+	if !result.Success {
+		return "", fmt.Errorf("failed to get diff for %s: %s", path, result.Stderr)
+	}
+	return result.Stdout, nil
+}
+
+// compareRange builds the revision range for mode
+func compareRange(left, right, mode string) (string, error) {
+	if mode != CompareThreeDot && mode != CompareTwoDot {
+		return "", fmt.Errorf("invalid compare mode: %s (must be %q or %q)", mode, CompareThreeDot, CompareTwoDot)
+	}
+	return left + mode + right, nil
+}
@@ -0,0 +1,50 @@
+package git
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCompareFiles(t *testing.T) {
+	nameStatus := "M\tsrc/main.go\nA\tlogo.png\nD\told.txt\n\nbogus\n"
+	numstat := "12\t3\tsrc/main.go\n-\t-\tlogo.png\n0\t4\told.txt\n"
+	want := []CompareFile{
+		{Path: "src/main.go", Status: "M", Added: 12, Deleted: 3},
+		{Path: "logo.png", Status: "A", Binary: true},
+		{Path: "old.txt", Status: "D", Deleted: 4},
+	}
+	if got := parseCompareFiles(nameStatus, numstat); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseCompareFiles() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCompareRange(t *testing.T) {
+	tests := []struct {
+		mode    string
+		want    string
+		wantErr bool
+	}{
+		{CompareThreeDot, "main...feature", false},
+		{CompareTwoDot, "main..feature", false},
+		{"parent", "", true},
+	}
+	for _, tc := range tests {
+		got, err := compareRange("main", "feature", tc.mode)
+		if (err != nil) != tc.wantErr || got != tc.want {
+			t.Errorf("compareRange(%q) = %q, %v; want %q, wantErr %v", tc.mode, got, err, tc.want, tc.wantErr)
+		}
+	}
+}
+
+// A ref named like a file is still read as a revision
+func TestCompareFilesRevisionsOnly(t *testing.T) {
+	fake := useFakeBackend(t)
+	fake.OnOutput("diff --no-renames --name-status main...notes.txt --", "M\tnotes.txt")
+	fake.OnOutput("diff --no-renames --numstat main...notes.txt --", "1\t0\tnotes.txt")
+
+	got, err := CompareFiles("main", "notes.txt", CompareThreeDot)
+	want := []CompareFile{{Path: "notes.txt", Status: "M", Added: 1}}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("CompareFiles() = %+v, %v; want %+v", got, err, want)
+	}
+}
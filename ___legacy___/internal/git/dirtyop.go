@@ -37,7 +37,7 @@ func (s *DirtyOperationSnapshot) Save(branchName, headHash string) error {
 	filePath := s.FilePath()
 	content := fmt.Sprintf("%s\n%s\n", branchName, headHash)
 
-	if err := os.WriteFile(filePath, []byte(content), internal.GitignorePerms); err != nil {
+	if err := os.WriteFile(filePath, []byte(content), internal.StateFilePerms); err != nil {
 		return fmt.Errorf("failed to save dirty operation snapshot: %w", err)
 	}
 
@@ -85,8 +85,12 @@ func (s *DirtyOperationSnapshot) Delete() error {
 // IsDirtyOperationActive checks if a dirty operation is currently in progress
 // by looking for the snapshot file
 func IsDirtyOperationActive() bool {
-	filePath := filepath.Join(internal.GitDirectoryName, "TIT_DIRTY_OP")
-	_, err := os.Stat(filePath)
+	return currentRepo.isDirtyOperationActive()
+}
+
+// isDirtyOperationActive reports whether the repository at d has a TIT_DIRTY_OP snapshot
+func (d repoDir) isDirtyOperationActive() bool {
+	_, err := os.Stat(d.gitPath("TIT_DIRTY_OP"))
 	return err == nil
 }
 
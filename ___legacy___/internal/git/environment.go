@@ -3,13 +3,11 @@ package git
 import (
 	"os"
 	"os/exec"
-	"path/filepath"
-	"strings"
 )
 
 // DetectGitEnvironment checks if the machine is ready for git+SSH operations
 // This is checked BEFORE any git state detection
-// Priority: MissingGit > MissingSSH > NeedsSetup > Ready
+// Priority: MissingGit > MissingSSH > NeedsSetup > NeedsIdentity > Ready
 //
 // For testing: Set TIT_TEST_SETUP=1 to force NeedsSetup state
 func DetectGitEnvironment() GitEnvironment {
@@ -30,6 +28,10 @@ func DetectGitEnvironment() GitEnvironment {
 		return NeedsSetup
 	}
 
+	if !CurrentIdentity().Complete() {
+		return NeedsIdentity
+	}
+
 	return Ready
 }
 
@@ -39,44 +41,9 @@ func commandExists(cmd string) bool {
 	return err == nil
 }
 
-// sshKeyExists checks if any SSH private key exists in ~/.ssh
-// Checks both default names (id_rsa, id_ed25519) and custom names (*_rsa, *_ed25519)
+// sshKeyExists checks if any SSH key pair exists in ~/.ssh (see FindSSHKeys)
 func sshKeyExists() bool {
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return false
-	}
-
-	sshDir := filepath.Join(home, ".ssh")
-
-	// Check if .ssh directory exists
-	if _, err := os.Stat(sshDir); os.IsNotExist(err) {
-		return false
-	}
-
-	// Read directory and look for any private key file
-	entries, err := os.ReadDir(sshDir)
-	if err != nil {
-		return false
-	}
-
-	for _, entry := range entries {
-		if entry.IsDir() {
-			continue
-		}
-		name := entry.Name()
-		// Skip public keys and known_hosts
-		if filepath.Ext(name) == ".pub" || name == "known_hosts" || name == "known_hosts.old" || name == "config" {
-			continue
-		}
-		// Check for common private key patterns
-		// id_rsa, id_ed25519, id_ecdsa, github_rsa, bitbucket_rsa, etc.
-		if strings.Contains(name, "_rsa") || strings.Contains(name, "_ed25519") || strings.Contains(name, "_ecdsa") || strings.Contains(name, "_dsa") {
-			return true
-		}
-	}
-
-	return false
+	return len(FindSSHKeys()) > 0
 }
 
 // fileExists checks if a file exists and is not a directory
@@ -90,12 +57,11 @@ func fileExists(path string) bool {
 
 // GetGitVersion returns git version string or empty if not installed
 func GetGitVersion() string {
-	cmd := exec.Command("git", "--version")
-	output, err := cmd.Output()
-	if err != nil {
+	result := Execute("--version")
+	if !result.Success {
 		return ""
 	}
-	return string(output)
+	return result.Stdout
 }
 
 // GetSSHVersion returns ssh version string or empty if not installed
@@ -0,0 +1,172 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jrengmusic/tit/internal/config"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Bisect: binary search between a good and a bad commit for the commit that
+// introduced a regression. git keeps the session in .git/BISECT_* (BISECT_START
+// holds the branch to return to); TIT only adds the departure stash, tracked
+// under "time_travel" so ExecuteTimeTravelReturn restores it on the way back.
+
+const (
+	bisectStartFile = "BISECT_START"
+	bisectLogFile   = "BISECT_LOG"
+	bisectStashMsg  = "TIT_BISECT"
+)
+
+// Bisect verdicts (git bisect subcommands)
+const (
+	BisectGood = "good"
+	BisectBad  = "bad"
+	BisectSkip = "skip"
+)
+
+// BisectProgress summarises the running bisect session
+type BisectProgress struct {
+	OriginalBranch string // Branch to return to (from BISECT_START)
+	Good           string // Newest commit marked good
+	Bad            string // Commit currently marked bad
+	Remaining      int    // Commits still suspect
+	Steps          int    // Rough number of verdicts left
+	Culprit        string // First bad commit once found, "" until then
+}
+
+// bisectLog is what TIT needs from .git/BISECT_LOG
+type bisectLog struct {
+	Goods   []string // Every commit marked good, in order
+	Bad     string
+	Culprit string
+}
+
+// parseBisectLog reads "# good: [hash] subject", "# bad: [hash] subject" and
+// "# first bad commit: [hash] subject" lines; commands and other comments are ignored
+func parseBisectLog(content string) bisectLog {
+	var log bisectLog
+	for _, line := range strings.Split(content, "\n") {
+		label, rest, ok := strings.Cut(line, ": [")
+		if !ok {
+			continue
+		}
+		hash, _, ok := strings.Cut(rest, "]")
+		if !ok || hash == "" {
+			continue
+		}
+		switch label {
+		case "# good":
+			log.Goods = append(log.Goods, hash)
+		case "# bad":
+			log.Bad = hash
+		case "# first bad commit":
+			log.Culprit = hash
+		}
+	}
+	return log
+}
+
+// parseBisectVars reads bisect_all and bisect_steps from `git rev-list --bisect-vars`
+func parseBisectVars(output string) (remaining, steps int) {
+	for _, line := range strings.Split(output, "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), "=")
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(strings.Trim(value, "'"))
+		if err != nil {
+			continue
+		}
+		switch key {
+		case "bisect_all":
+			remaining = n
+		case "bisect_steps":
+			steps = n
+		}
+	}
+	return remaining, steps
+}
+
+// isBisecting reports whether a bisect session is running (file read, no subprocess)
+func (d repoDir) isBisecting() bool {
+	_, err := os.Stat(d.gitPath(bisectStartFile))
+	return err == nil
+}
+
+// readBisect loads the running session; range counts need one rev-list once both ends are known
+func (d repoDir) readBisect() *BisectProgress {
+	progress := &BisectProgress{}
+	if data, err := os.ReadFile(d.gitPath(bisectStartFile)); err == nil {
+		progress.OriginalBranch = strings.TrimSpace(string(data))
+	}
+	data, err := os.ReadFile(d.gitPath(bisectLogFile))
+	if err != nil {
+		return progress
+	}
+	log := parseBisectLog(string(data))
+	progress.Bad = log.Bad
+	progress.Culprit = log.Culprit
+	if len(log.Goods) > 0 {
+		progress.Good = log.Goods[len(log.Goods)-1]
+	}
+	if log.Bad == "" || len(log.Goods) == 0 || log.Culprit != "" {
+		return progress
+	}
+	args := append([]string{"rev-list", "--bisect-vars", log.Bad, "--not"}, log.Goods...)
+	if output, err := d.execute(args...); err == nil {
+		progress.Remaining, progress.Steps = parseBisectVars(output)
+	}
+	return progress
+}
+
+// StashBisectDeparture stashes uncommitted work (untracked files included) before
+// bisecting and records it for ExecuteTimeTravelReturn. Call only with a dirty tree.
+func StashBisectDeparture(originalBranch string) error {
+	result := Execute("stash", "push", "-u", "-m", bisectStashMsg)
+	if !result.Success {
+		return fmt.Errorf("stash push: %s", strings.TrimSpace(result.Stderr))
+	}
+	hash := Execute("rev-parse", "stash@{0}")
+	if !hash.Success {
+		return fmt.Errorf("rev-parse stash: %s", strings.TrimSpace(hash.Stderr))
+	}
+	repoPath, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	head := strings.TrimSpace(Execute("rev-parse", "HEAD").Stdout)
+	if _, exists := config.GetStashEntry("time_travel", repoPath); exists {
+		// Stale entry from an earlier session
+		config.RemoveStashEntry("time_travel", repoPath)
+	}
+	config.AddStashEntry("time_travel", strings.TrimSpace(hash.Stdout), repoPath, originalBranch, head)
+	return nil
+}
+
+// ExecuteBisectFinish ends the bisect session: `git bisect reset` returns to the
+// original branch, then the departure stash is restored as on time travel return
+// Returns: TimeTravelReturnMsg
+func ExecuteBisectFinish() func() tea.Msg {
+	return func() tea.Msg {
+		originalBranch := currentRepo.readBisect().OriginalBranch
+		Log("Ending bisect session...")
+		reset := Execute("bisect", "reset")
+		if !reset.Success {
+			Error(fmt.Sprintf("Error ending bisect: %s", reset.Stderr))
+			return TimeTravelReturnMsg{
+				Success:        false,
+				OriginalBranch: originalBranch,
+				Error:          fmt.Sprintf("Failed to end bisect: %s", strings.TrimSpace(reset.Stderr)),
+			}
+		}
+		if originalBranch == "" {
+			originalBranch = strings.TrimSpace(Execute("rev-parse", "--abbrev-ref", "HEAD").Stdout)
+		}
+		return ExecuteTimeTravelReturn(originalBranch)()
+	}
+}
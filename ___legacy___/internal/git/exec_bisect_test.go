@@ -0,0 +1,48 @@
+package git
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseBisectLog(t *testing.T) {
+	content := `git bisect start 'HEAD' 'HEAD~7'
+# status: waiting for both good and bad commits
+# bad: [acc91cd] c8
+# good: [1a7dfae] c1
+# good: [d973a5b] c4
+git bisect good d973a5b
+# bad: [bc1a05e] c6
+# skip: [2aa0b37] c5
+# bad: [2ff0b37] c5
+# first bad commit: [2ff0b37] c5
+`
+	want := bisectLog{Goods: []string{"1a7dfae", "d973a5b"}, Bad: "2ff0b37", Culprit: "2ff0b37"}
+	if got := parseBisectLog(content); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseBisectLog() = %+v, want %+v", got, want)
+	}
+	if got := parseBisectLog(""); !reflect.DeepEqual(got, bisectLog{}) {
+		t.Errorf("parseBisectLog(empty) = %+v, want zero", got)
+	}
+}
+
+func TestParseBisectVars(t *testing.T) {
+	tests := []struct {
+		name                     string
+		output                   string
+		wantRemaining, wantSteps int
+	}{
+		{name: "rev-list output", output: "bisect_rev='d973a5b'\nbisect_nr=3\nbisect_good=3\nbisect_bad=2\nbisect_all=7\nbisect_steps=2\n",
+			wantRemaining: 7, wantSteps: 2},
+		{name: "single suspect", output: "bisect_all=1\nbisect_steps=0\n", wantRemaining: 1},
+		{name: "garbage", output: "fatal: bad revision\n"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			remaining, steps := parseBisectVars(tc.output)
+			if remaining != tc.wantRemaining || steps != tc.wantSteps {
+				t.Errorf("parseBisectVars() = %d, %d, want %d, %d", remaining, steps, tc.wantRemaining, tc.wantSteps)
+			}
+		})
+	}
+}
@@ -1,4 +1,113 @@
 package git
 
-// Clone operations
-// TODO: Move clone-related functions here when they are created
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jrengmusic/tit/internal"
+)
+
+// CloneOptions narrows what a clone downloads and checks out.
+// The zero value is a plain full clone.
+type CloneOptions struct {
+	Depth          int      // Commits of history to fetch, 0 = full history
+	SingleBranch   bool     // Fetch only the checked-out branch
+	Branch         string   // Branch to check out, empty = remote default
+	BlobFilter     bool     // Partial clone (--filter=blob:none): file contents fetched on demand
+	SparsePatterns []string // Cone-mode sparse-checkout directories, empty = full checkout
+}
+
+// IsZero reports whether no option is set (plain full clone)
+func (o CloneOptions) IsZero() bool {
+	return o.Depth == 0 && !o.SingleBranch && o.Branch == "" && !o.BlobFilter && len(o.SparsePatterns) == 0
+}
+
+// CloneArgs returns the `git clone` arguments for cloning url into a new subdirectory.
+// --depth implies --single-branch in git, so a shallow multi-branch clone passes
+// --no-single-branch explicitly. Local clones ignore --depth and --filter, so local
+// paths are rewritten to file:// URLs when either is set. Sparse clones start with
+// only root files checked out; SparseCheckoutArgs widens the cone afterwards.
+func (o CloneOptions) CloneArgs(url string) []string {
+	args := []string{"clone", "--progress"}
+	if o.Depth > 0 {
+		args = append(args, "--depth", strconv.Itoa(o.Depth))
+	}
+	if o.SingleBranch {
+		args = append(args, "--single-branch")
+	} else if o.Depth > 0 {
+		args = append(args, "--no-single-branch")
+	}
+	if o.Branch != "" {
+		args = append(args, "--branch", o.Branch)
+	}
+	if o.BlobFilter {
+		args = append(args, "--filter=blob:none")
+	}
+	if len(o.SparsePatterns) > 0 {
+		args = append(args, "--sparse")
+	}
+	if (o.Depth > 0 || o.BlobFilter) && strings.HasPrefix(url, "/") {
+		url = "file://" + url
+	}
+	return append(args, url)
+}
+
+// FetchArgs returns the `git fetch` arguments used when cloning into the current
+// directory (init + remote add + fetch). Single-branch is applied beforehand with
+// `remote set-branches`; fetch then records the partial clone filter on origin itself.
+func (o CloneOptions) FetchArgs() []string {
+	args := []string{"fetch", "--progress"}
+	if o.Depth > 0 {
+		args = append(args, "--depth="+strconv.Itoa(o.Depth))
+	}
+	if o.BlobFilter {
+		args = append(args, "--filter=blob:none")
+	}
+	return append(args, "origin")
+}
+
+// SparseCheckoutArgs returns the `git sparse-checkout set` arguments, or nil for a full checkout
+func (o CloneOptions) SparseCheckoutArgs() []string {
+	if len(o.SparsePatterns) == 0 {
+		return nil
+	}
+	return SparseSetArgs(o.SparsePatterns)
+}
+
+// shallowFile lists the boundary commits of a shallow repository
+func shallowFile() string {
+	return filepath.Join(internal.GitDirectoryName, "shallow")
+}
+
+// IsShallow reports whether the repository has truncated history (.git/shallow exists)
+func IsShallow() bool {
+	return currentRepo.isShallow()
+}
+
+// isShallow reports whether the repository at d has a .git/shallow file
+func (d repoDir) isShallow() bool {
+	_, err := os.Stat(d.gitPath("shallow"))
+	return err == nil
+}
+
+// ShallowBoundaries returns the full hashes of commits whose parents were not fetched.
+// Returns an empty set for a complete repository.
+func ShallowBoundaries() map[string]bool {
+	boundaries := make(map[string]bool)
+	f, err := os.Open(shallowFile())
+	if err != nil {
+		return boundaries
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if hash := strings.TrimSpace(scanner.Text()); hash != "" {
+			boundaries[hash] = true
+		}
+	}
+	return boundaries
+}
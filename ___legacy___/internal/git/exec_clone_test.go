@@ -0,0 +1,87 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestCloneOptionsArgs(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   CloneOptions
+		clone  []string
+		fetch  []string
+		sparse []string
+	}{
+		{
+			name:  "plain",
+			clone: []string{"clone", "--progress", "/srv/repo.git"},
+			fetch: []string{"fetch", "--progress", "origin"},
+		},
+		{
+			name:  "shallow keeps every branch",
+			opts:  CloneOptions{Depth: 1},
+			clone: []string{"clone", "--progress", "--depth", "1", "--no-single-branch", "file:///srv/repo.git"},
+			fetch: []string{"fetch", "--progress", "--depth=1", "origin"},
+		},
+		{
+			name:  "single branch",
+			opts:  CloneOptions{SingleBranch: true, Branch: "dev"},
+			clone: []string{"clone", "--progress", "--single-branch", "--branch", "dev", "/srv/repo.git"},
+			fetch: []string{"fetch", "--progress", "origin"},
+		},
+		{
+			name:   "partial sparse",
+			opts:   CloneOptions{BlobFilter: true, SparsePatterns: []string{"src", "docs"}},
+			clone:  []string{"clone", "--progress", "--filter=blob:none", "--sparse", "file:///srv/repo.git"},
+			fetch:  []string{"fetch", "--progress", "--filter=blob:none", "origin"},
+			sparse: []string{"sparse-checkout", "set", "--cone", "src", "docs"},
+		},
+	}
+	for _, tc := range tests {
+		if got := tc.opts.CloneArgs("/srv/repo.git"); !reflect.DeepEqual(got, tc.clone) {
+			t.Errorf("%s: CloneArgs() = %q, want %q", tc.name, got, tc.clone)
+		}
+		if got := tc.opts.FetchArgs(); !reflect.DeepEqual(got, tc.fetch) {
+			t.Errorf("%s: FetchArgs() = %q, want %q", tc.name, got, tc.fetch)
+		}
+		if got := tc.opts.SparseCheckoutArgs(); !reflect.DeepEqual(got, tc.sparse) {
+			t.Errorf("%s: SparseCheckoutArgs() = %q, want %q", tc.name, got, tc.sparse)
+		}
+	}
+
+	// Remote URLs are never rewritten
+	got := CloneOptions{Depth: 1}.CloneArgs("git@github.com:user/repo.git")
+	if url := got[len(got)-1]; url != "git@github.com:user/repo.git" {
+		t.Errorf("CloneArgs(ssh) url = %q", url)
+	}
+}
+
+func TestShallowBoundaries(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := os.Mkdir(".git", 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if IsShallow() || len(ShallowBoundaries()) != 0 {
+		t.Fatalf("complete repository reported as shallow")
+	}
+
+	content := "1111111111111111111111111111111111111111\n2222222222222222222222222222222222222222\n"
+	if err := os.WriteFile(filepath.Join(".git", "shallow"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]bool{
+		"1111111111111111111111111111111111111111": true,
+		"2222222222222222222222222222222222222222": true,
+	}
+	if !IsShallow() {
+		t.Errorf("IsShallow() = false with .git/shallow present")
+	}
+	if got := ShallowBoundaries(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ShallowBoundaries() = %v, want %v", got, want)
+	}
+}
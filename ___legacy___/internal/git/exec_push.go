@@ -1,4 +1,94 @@
 package git
 
+import (
+	"fmt"
+	"strings"
+)
+
 // Push operations
-// TODO: Move push-related functions here when they are created
+
+// RemoteCommit is a remote commit a force push would drop
+type RemoteCommit struct {
+	Hash    string // Abbreviated hash
+	Author  string // Author name
+	Subject string // Commit message first line
+}
+
+// ForcePushTarget is the remote branch a force push overwrites and the lease it carries
+type ForcePushTarget struct {
+	Remote       string // Remote name (may contain "/")
+	RemoteBranch string // Branch name on the remote
+	Expected     string // Hash the remote branch must still point at ("" = must not exist yet)
+	SetUpstream  bool   // No tracking config: the push sets it (-u)
+}
+
+// Label returns the target as "remote/branch" for display
+func (t ForcePushTarget) Label() string {
+	return t.Remote + "/" + t.RemoteBranch
+}
+
+// ResolveForcePushTarget finds where a force push of branch goes and what its lease
+// expects. Remote and remote branch come from branch.<b>.remote and branch.<b>.merge,
+// falling back to origin and the same name when the branch tracks nothing. The lease
+// is the remote-tracking tip last fetched (refs/remotes/<remote>/<branch>), which also
+// exists for branches pushed without -u; it is empty ("must not exist") only when that
+// ref is missing too, e.g. before the first push.
+func ResolveForcePushTarget(branch string) ForcePushTarget {
+	target := ForcePushTarget{Remote: "origin", RemoteBranch: branch, SetUpstream: true}
+	remote, remoteErr := executeGitCommand("config", "--get", "branch."+branch+".remote")
+	merge, mergeErr := executeGitCommand("config", "--get", "branch."+branch+".merge")
+	if remoteErr == nil && mergeErr == nil && remote != "." && strings.HasPrefix(merge, "refs/heads/") {
+		target.Remote = remote
+		target.RemoteBranch = strings.TrimPrefix(merge, "refs/heads/")
+		target.SetUpstream = false
+	}
+	trackingRef := "refs/remotes/" + target.Label()
+	if hash, err := executeGitCommand("rev-parse", "--verify", "--quiet", trackingRef+"^{commit}"); err == nil {
+		target.Expected = hash
+	}
+	return target
+}
+
+// ForcePushArgs returns `git push` arguments that overwrite the target branch only
+// while it still points at target.Expected
+func ForcePushArgs(branch string, target ForcePushTarget) []string {
+	ref := "refs/heads/" + target.RemoteBranch
+	args := []string{"push", "--progress", "--force-with-lease=" + ref + ":" + target.Expected}
+	if target.SetUpstream {
+		args = append(args, "-u")
+	}
+	return append(args, target.Remote, branch+":"+ref)
+}
+
+// IsLeaseRejected reports whether push stderr is a --force-with-lease rejection
+// (the remote branch moved away from the expected hash)
+func IsLeaseRejected(stderr string) bool {
+	return strings.Contains(stderr, "(stale info)")
+}
+
+// LostRemoteCommits lists commits reachable from remoteHash but not from HEAD, newest
+// first: what a force push replacing remoteHash with HEAD destroys on the remote.
+// An empty remoteHash is an error: with no remote ref there is nothing to compare.
+func LostRemoteCommits(remoteHash string) ([]RemoteCommit, error) {
+	if remoteHash == "" {
+		return nil, fmt.Errorf("no remote-tracking ref to compare with")
+	}
+	result := Execute("log", "--format=%h%x1f%an%x1f%s", "HEAD.."+remoteHash)
+	if !result.Success {
+		return nil, fmt.Errorf("failed to list remote commits: %s", result.Stderr)
+	}
+	return parseRemoteCommits(result.Stdout), nil
+}
+
+// parseRemoteCommits parses `git log --format=%h%x1f%an%x1f%s` output
+func parseRemoteCommits(output string) []RemoteCommit {
+	var commits []RemoteCommit
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.SplitN(line, "\x1f", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		commits = append(commits, RemoteCommit{Hash: fields[0], Author: fields[1], Subject: fields[2]})
+	}
+	return commits
+}
@@ -0,0 +1,87 @@
+package git
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestForcePushArgs(t *testing.T) {
+	tests := []struct {
+		branch string
+		target ForcePushTarget
+		want   []string
+	}{
+		{"main", ForcePushTarget{Remote: "origin", RemoteBranch: "main", Expected: "abc123"},
+			[]string{"push", "--progress", "--force-with-lease=refs/heads/main:abc123", "origin", "main:refs/heads/main"}},
+		{"feature", ForcePushTarget{Remote: "team/fork", RemoteBranch: "topic/x", Expected: "abc123"},
+			[]string{"push", "--progress", "--force-with-lease=refs/heads/topic/x:abc123", "team/fork", "feature:refs/heads/topic/x"}},
+		{"feature", ForcePushTarget{Remote: "origin", RemoteBranch: "feature", SetUpstream: true}, // Never pushed: must not exist yet
+			[]string{"push", "--progress", "--force-with-lease=refs/heads/feature:", "-u", "origin", "feature:refs/heads/feature"}},
+	}
+	for _, tc := range tests {
+		if got := ForcePushArgs(tc.branch, tc.target); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("ForcePushArgs(%q, %+v) = %q, want %q", tc.branch, tc.target, got, tc.want)
+		}
+	}
+}
+
+func TestResolveForcePushTarget(t *testing.T) {
+	root := newGitTestRepo(t)
+
+	// pushed: pushed without -u, so only refs/remotes/origin/pushed knows its tip;
+	// tracked: tracks topic/x on a remote whose name contains "/"; fresh: never pushed
+	remote := filepath.Join(root, "remote.git")
+	work := filepath.Join(root, "work")
+	gitRun(t, root, "init", "-q", "--bare", "-b", "main", remote)
+	gitRun(t, root, "clone", "-q", remote, work)
+	gitRun(t, work, "commit", "-q", "--allow-empty", "-m", "initial")
+	gitRun(t, work, "switch", "-q", "-c", "pushed")
+	gitRun(t, work, "push", "-q", "origin", "pushed")
+	pushedTip := gitRun(t, work, "rev-parse", "HEAD")
+	gitRun(t, work, "remote", "add", "team/fork", remote)
+	gitRun(t, work, "fetch", "-q", "team/fork")
+	gitRun(t, work, "switch", "-q", "-c", "tracked")
+	gitRun(t, work, "push", "-q", "team/fork", "tracked:topic/x")
+	gitRun(t, work, "fetch", "-q", "team/fork")
+	gitRun(t, work, "branch", "--set-upstream-to=team/fork/topic/x")
+	gitRun(t, work, "switch", "-q", "-c", "fresh")
+	t.Chdir(work)
+
+	tests := []struct {
+		branch string
+		want   ForcePushTarget
+	}{
+		{"pushed", ForcePushTarget{Remote: "origin", RemoteBranch: "pushed", Expected: pushedTip, SetUpstream: true}},
+		{"tracked", ForcePushTarget{Remote: "team/fork", RemoteBranch: "topic/x", Expected: pushedTip}},
+		{"fresh", ForcePushTarget{Remote: "origin", RemoteBranch: "fresh", SetUpstream: true}},
+	}
+	for _, tc := range tests {
+		if got := ResolveForcePushTarget(tc.branch); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("ResolveForcePushTarget(%q) = %+v, want %+v", tc.branch, got, tc.want)
+		}
+	}
+
+	// The lease of a branch pushed without -u is accepted by git
+	gitRun(t, work, "switch", "-q", "pushed")
+	gitRun(t, work, "commit", "-q", "--amend", "--allow-empty", "-m", "rewritten")
+	gitRun(t, work, ForcePushArgs("pushed", ResolveForcePushTarget("pushed"))...)
+
+	if _, err := LostRemoteCommits(""); err == nil {
+		t.Error("LostRemoteCommits(\"\") = nil error, want an error")
+	}
+}
+
+func TestParseRemoteCommits(t *testing.T) {
+	output := "abc1234\x1fJane Doe\x1ffix: handle a|b\ndef5678\x1fJohn\x1f\n\nmalformed\n"
+	want := []RemoteCommit{
+		{Hash: "abc1234", Author: "Jane Doe", Subject: "fix: handle a|b"},
+		{Hash: "def5678", Author: "John", Subject: ""},
+	}
+	if got := parseRemoteCommits(output); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseRemoteCommits() = %+v, want %+v", got, want)
+	}
+	if got := parseRemoteCommits(""); got != nil {
+		t.Errorf("parseRemoteCommits(\"\") = %+v, want nil", got)
+	}
+}
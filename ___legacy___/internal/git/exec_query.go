@@ -22,7 +22,17 @@ func FetchRecentCommits(limit int, ref string) ([]CommitInfo, error) {
 		return nil, fmt.Errorf("failed to fetch recent commits: %s", result.Stderr)
 	}
 
-	lines := strings.Split(strings.TrimSpace(result.Stdout), "\n")
+	commits := parseCommitLog(result.Stdout)
+	if len(commits) == 0 {
+		return nil, fmt.Errorf("no commits found")
+	}
+
+	return commits, nil
+}
+
+// parseCommitLog parses `git log --pretty=%H%n%s%n%ai` output
+func parseCommitLog(output string) []CommitInfo {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
 	commits := make([]CommitInfo, 0)
 
 	// Parse output: hash, subject, iso-date on consecutive lines
@@ -48,12 +58,7 @@ func FetchRecentCommits(limit int, ref string) ([]CommitInfo, error) {
 			Time:    parsedTime,
 		})
 	}
-
-	if len(commits) == 0 {
-		return nil, fmt.Errorf("no commits found")
-	}
-
-	return commits, nil
+	return commits
 }
 
 // GetCommitDetails fetches full metadata for a commit
@@ -145,25 +150,31 @@ func GetFilesInCommit(hash string) ([]FileInfo, error) {
 
 // GetCommitDiff fetches diff for a file in a commit
 // version: "parent" (commit vs parent) or "wip" (commit vs working tree)
-// Returns: unified diff content (plain text)
+// Returns: unified diff content (plain text), or for files git sees as binary
+// (numstat "-") no diff and the blobs on both sides instead
 // Uses git diff with appropriate range based on version
-func GetCommitDiff(hash, path, version string) (string, error) {
+func GetCommitDiff(hash, path, version string) (string, *BinaryDiff, error) {
 	var result CommandResult
 
 	switch version {
 	case "parent":
 		// Compare commit with its parent
-		result = Execute("diff", hash+"^", hash, "--", path)
+		result = Execute("diff", "--numstat", "--patch", hash+"^", hash, "--", path)
 	case "wip":
 		// Compare commit with working tree
-		result = Execute("diff", hash, "--", path)
+		result = Execute("diff", "--numstat", "--patch", hash, "--", path)
 	default:
-		return "", fmt.Errorf("invalid diff version: %s (must be 'parent' or 'wip')", version)
+		return "", nil, fmt.Errorf("invalid diff version: %s (must be 'parent' or 'wip')", version)
 	}
 
 	if !result.Success {
-		return "", fmt.Errorf("failed to get diff for %s: %s", path, result.Stderr)
+		return "", nil, fmt.Errorf("failed to get diff for %s: %s", path, result.Stderr)
 	}
 
-	return result.Stdout, nil
+	numstat, patch := splitNumstatPatch(result.Stdout)
+	if isBinaryNumstat(numstat) {
+		binary := commitDiffSides(hash, path, version)
+		return "", &binary, nil
+	}
+	return patch, nil, nil
 }
@@ -2,12 +2,14 @@ package git
 
 import (
 	"fmt"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 // ExecuteTimeTravelCheckout performs a time travel checkout operation
-// Creates .git/TIT_TIME_TRAVEL file with original branch info
+// Creates .git/TIT_TIME_TRAVEL file with original branch info, or appends a hop
+// to the running session when already time traveling from originalBranch
 // Returns: TimeTravelCheckoutMsg
 func ExecuteTimeTravelCheckout(originalBranch, commitHash string) func() tea.Msg {
 	return func() tea.Msg {
@@ -25,6 +27,18 @@ func ExecuteTimeTravelCheckout(originalBranch, commitHash string) func() tea.Msg
 			}
 		}
 
+		// Continue the running session (keeps stash and trail) or start a new one
+		marker, err := ReadTimeTravelMarker()
+		if err != nil || marker.OriginalBranch != originalBranch {
+			marker = TimeTravelMarker{OriginalBranch: originalBranch}
+		}
+		if len(marker.Hops) == 0 && strings.TrimSpace(currentBranchResult.Stdout) == "HEAD" {
+			// Session started before hops were recorded: its commit is the first hop
+			if head := Execute("rev-parse", "HEAD"); head.Success {
+				marker.Hops = []string{strings.TrimSpace(head.Stdout)}
+			}
+		}
+
 		// Checkout the target commit
 		checkoutResult := Execute("checkout", commitHash)
 		if !checkoutResult.Success {
@@ -37,9 +51,11 @@ func ExecuteTimeTravelCheckout(originalBranch, commitHash string) func() tea.Msg
 			}
 		}
 
-		// Write time travel info file
-		err := WriteTimeTravelInfo(originalBranch, "")
-		if err != nil {
+		// Record the hop by full hash so the trail survives short-hash ambiguity
+		if head := Execute("rev-parse", "HEAD"); head.Success {
+			marker.Hops = append(marker.Hops, strings.TrimSpace(head.Stdout))
+		}
+		if err := WriteTimeTravelMarker(marker); err != nil {
 			Error(fmt.Sprintf("Error writing time travel info: %v", err))
 			// Try to checkout back to original branch
 			Execute("checkout", originalBranch)
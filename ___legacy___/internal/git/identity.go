@@ -0,0 +1,64 @@
+package git
+
+import (
+	"os"
+)
+
+// Identity is the author identity git records in commits (user.name / user.email)
+type Identity struct {
+	Name  string
+	Email string
+}
+
+// Complete reports whether both name and email are set (git refuses to commit otherwise)
+func (i Identity) Complete() bool {
+	return i.Name != "" && i.Email != ""
+}
+
+// CurrentIdentity returns the identity git commits with here: repository config over
+// global, overridden by GIT_AUTHOR_NAME / GIT_AUTHOR_EMAIL like git itself does
+func CurrentIdentity() Identity {
+	id := Identity{Name: readConfig("", "user.name"), Email: readConfig("", "user.email")}
+	if name := os.Getenv("GIT_AUTHOR_NAME"); name != "" {
+		id.Name = name
+	}
+	if email := os.Getenv("GIT_AUTHOR_EMAIL"); email != "" {
+		id.Email = email
+	}
+	return id
+}
+
+// LocalIdentity returns the identity set in the repository's own config only
+func LocalIdentity() Identity {
+	return Identity{Name: readConfig("--local", "user.name"), Email: readConfig("--local", "user.email")}
+}
+
+// SetIdentity writes user.name and user.email to the global config,
+// or to the current repository's config when local is true
+func SetIdentity(id Identity, local bool) error {
+	scope := "--global"
+	if local {
+		scope = "--local"
+	}
+	if result := Execute("config", scope, "user.name", id.Name); !result.Success {
+		return resultError(result)
+	}
+	if result := Execute("config", scope, "user.email", id.Email); !result.Success {
+		return resultError(result)
+	}
+	return nil
+}
+
+// readConfig returns a git config value, empty when unset.
+// scope narrows the lookup ("--local", "--global"); empty uses git's normal precedence.
+func readConfig(scope, key string) string {
+	args := []string{"config", "--get", key}
+	if scope != "" {
+		args = []string{"config", scope, "--get", key}
+	}
+	result := Execute(args...)
+	if !result.Success {
+		return ""
+	}
+	return result.Stdout
+}
@@ -0,0 +1,256 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jrengmusic/tit/internal"
+)
+
+// IgnoreTarget selects which file receives a new ignore pattern
+type IgnoreTarget int
+
+const (
+	IgnoreGitignore IgnoreTarget = iota // .gitignore at the repository root (shared)
+	IgnoreExclude                       // .git/info/exclude (local only, never committed)
+)
+
+// Path returns the file a pattern is appended to for this target
+func (t IgnoreTarget) Path() string {
+	if t == IgnoreExclude {
+		return filepath.Join(internal.GitDirectoryName, "info", "exclude")
+	}
+	return ".gitignore"
+}
+
+// UntrackedGroup is a set of untracked files sharing one candidate ignore pattern
+type UntrackedGroup struct {
+	Pattern string   // "/build/", "*.log", or "/notes.txt" for a lone file
+	Files   []string // Repository-relative paths, sorted
+}
+
+// IgnorePatterns are the candidate patterns offered for a single path
+type IgnorePatterns struct {
+	Exact     string // Anchored path ("/logs/app.log")
+	Extension string // "*.log", empty if the file has no extension
+	Directory string // Anchored parent directory ("/logs/"), empty for root files
+}
+
+// IgnoreMatch explains why a path is ignored (one `git check-ignore -v` record)
+type IgnoreMatch struct {
+	Path    string
+	Source  string // File that holds the pattern (".gitignore", ".git/info/exclude", ...)
+	Line    int
+	Pattern string
+}
+
+// ListUntracked returns every untracked, not-ignored file (directories expanded)
+func ListUntracked() ([]string, error) {
+	output, err := executeGitCommand("ls-files", "-z", "--others", "--exclude-standard")
+	if err != nil {
+		return nil, err
+	}
+	return splitNul(output), nil
+}
+
+// ListIgnored returns ignored untracked paths; fully ignored directories collapse to "dir/"
+func ListIgnored() ([]string, error) {
+	output, err := executeGitCommand("ls-files", "-z", "--others", "--ignored", "--exclude-standard", "--directory")
+	if err != nil {
+		return nil, err
+	}
+	return splitNul(output), nil
+}
+
+// PreviewIgnorePattern lists the untracked files a pattern would ignore,
+// using git's own matcher so the preview cannot drift from real behavior
+func PreviewIgnorePattern(pattern string) ([]string, error) {
+	output, err := executeGitCommand("ls-files", "-z", "--others", "--ignored", "--exclude="+pattern)
+	if err != nil {
+		return nil, err
+	}
+	return splitNul(output), nil
+}
+
+// CheckIgnore explains which pattern ignores each path.
+// Paths that are not ignored are omitted (git exits 1 when none are).
+func CheckIgnore(paths ...string) ([]IgnoreMatch, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	args := append([]string{"check-ignore", "-v", "--"}, paths...)
+	result := Execute(args...)
+	if result.ExitCode == 1 {
+		return nil, nil
+	}
+	if !result.Success {
+		return nil, resultError(result)
+	}
+	return parseCheckIgnore(result.Stdout), nil
+}
+
+// parseCheckIgnore parses `check-ignore -v` output: "<source>:<line>:<pattern><TAB><path>"
+func parseCheckIgnore(output string) []IgnoreMatch {
+	var matches []IgnoreMatch
+	for _, record := range strings.Split(output, "\n") {
+		rule, path, found := strings.Cut(record, "\t")
+		if !found {
+			continue
+		}
+		fields := strings.SplitN(rule, ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		line, _ := strconv.Atoi(fields[1])
+		matches = append(matches, IgnoreMatch{
+			Source:  fields[0],
+			Line:    line,
+			Pattern: fields[2],
+			Path:    path,
+		})
+	}
+	return matches
+}
+
+// AppendIgnorePattern adds pattern on its own line to the target file.
+// Creates the file (and .git/info) if needed; a pattern already present is not duplicated.
+func AppendIgnorePattern(target IgnoreTarget, pattern string) error {
+	pattern = trimIgnorePattern(pattern)
+	if pattern == "" {
+		return fmt.Errorf("empty ignore pattern")
+	}
+
+	file := target.Path()
+	existing, err := os.ReadFile(file)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read %s: %w", file, err)
+	}
+	for _, line := range strings.Split(string(existing), "\n") {
+		if trimIgnorePattern(line) == pattern {
+			return nil
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(file), err)
+	}
+
+	content := pattern + "\n"
+	if len(existing) > 0 && existing[len(existing)-1] != '\n' {
+		content = "\n" + content
+	}
+
+	f, err := os.OpenFile(file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", file, err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(content); err != nil {
+		return fmt.Errorf("failed to write %s: %w", file, err)
+	}
+	return nil
+}
+
+// AddPaths stages the given paths
+func AddPaths(paths ...string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+	_, err := executeGitCommand(append([]string{"add", "--"}, paths...)...)
+	return err
+}
+
+// PatternsFor returns the candidate ignore patterns for a repository-relative path
+func PatternsFor(p string) IgnorePatterns {
+	patterns := IgnorePatterns{Exact: "/" + escapeIgnorePath(p)}
+	if ext := path.Ext(path.Base(p)); ext != "" && ext != path.Base(p) {
+		patterns.Extension = "*" + escapeIgnorePath(ext)
+	}
+	if dir := path.Dir(p); dir != "." {
+		patterns.Directory = "/" + escapeIgnorePath(dir) + "/"
+	}
+	return patterns
+}
+
+// escapeIgnorePath backslash-escapes the gitignore metacharacters in a literal
+// path (wildcards, brackets, backslashes and trailing spaces, which git would
+// strip). A leading "!" or "#" needs no escape: patterns are anchored with "/".
+func escapeIgnorePath(p string) string {
+	trimmed := strings.TrimRight(p, " ")
+	var b strings.Builder
+	for _, r := range trimmed {
+		switch r {
+		case '\\', '*', '?', '[':
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteString(strings.Repeat("\\ ", len(p)-len(trimmed)))
+	return b.String()
+}
+
+// trimIgnorePattern strips surrounding whitespace from a pattern, keeping a
+// trailing space that is escaped ("name\ ")
+func trimIgnorePattern(pattern string) string {
+	pattern = strings.TrimLeft(pattern, " \t\r\n")
+	trimmed := strings.TrimRight(pattern, " \t\r\n")
+	backslashes := len(trimmed) - len(strings.TrimRight(trimmed, "\\"))
+	if backslashes%2 == 1 && len(trimmed) < len(pattern) {
+		trimmed = pattern[:len(trimmed)+1]
+	}
+	return trimmed
+}
+
+// GroupUntracked groups untracked files by the pattern most likely to cover them:
+// files under a top-level directory group by that directory, root files by
+// extension when several share it, and anything else stands alone.
+func GroupUntracked(files []string) []UntrackedGroup {
+	byPattern := make(map[string][]string)
+
+	extCount := make(map[string]int)
+	for _, f := range files {
+		if !strings.Contains(f, "/") {
+			if ext := PatternsFor(f).Extension; ext != "" {
+				extCount[ext]++
+			}
+		}
+	}
+
+	for _, f := range files {
+		var pattern string
+		if top, _, nested := strings.Cut(f, "/"); nested {
+			pattern = "/" + escapeIgnorePath(top) + "/"
+		} else if ext := PatternsFor(f).Extension; ext != "" && extCount[ext] > 1 {
+			pattern = ext
+		} else {
+			pattern = PatternsFor(f).Exact
+		}
+		byPattern[pattern] = append(byPattern[pattern], f)
+	}
+
+	groups := make([]UntrackedGroup, 0, len(byPattern))
+	for pattern, members := range byPattern {
+		sort.Strings(members)
+		groups = append(groups, UntrackedGroup{Pattern: pattern, Files: members})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Pattern < groups[j].Pattern
+	})
+	return groups
+}
+
+// splitNul splits NUL-separated git output, dropping empty records
+func splitNul(output string) []string {
+	var items []string
+	for _, item := range strings.Split(output, "\x00") {
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,122 @@
+package git
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestPatternsFor(t *testing.T) {
+	tests := []struct {
+		path string
+		want IgnorePatterns
+	}{
+		{"notes.txt", IgnorePatterns{Exact: "/notes.txt", Extension: "*.txt"}},
+		{"Makefile", IgnorePatterns{Exact: "/Makefile"}},
+		{".env", IgnorePatterns{Exact: "/.env"}},
+		{"logs/app.log", IgnorePatterns{Exact: "/logs/app.log", Extension: "*.log", Directory: "/logs/"}},
+		{"a/b/c", IgnorePatterns{Exact: "/a/b/c", Directory: "/a/b/"}},
+		{"[x]/a*b?.t[x]t", IgnorePatterns{Exact: `/\[x]/a\*b\?.t\[x]t`, Extension: `*.t\[x]t`, Directory: `/\[x]/`}},
+		{`back\slash`, IgnorePatterns{Exact: `/back\\slash`}},
+		{"trail  ", IgnorePatterns{Exact: `/trail\ \ `}},
+		{"!keep", IgnorePatterns{Exact: "/!keep"}},
+	}
+	for _, tc := range tests {
+		if got := PatternsFor(tc.path); got != tc.want {
+			t.Errorf("PatternsFor(%q) = %+v, want %+v", tc.path, got, tc.want)
+		}
+	}
+}
+
+// Exact patterns must match only their own file under git's matcher
+func TestPatternsForMatchLiterally(t *testing.T) {
+	root := newGitTestRepo(t)
+	t.Chdir(root)
+	gitRun(t, root, "init", "-q")
+	files := []string{"a*b", "axb", "q?", "qq", "[ab]", "a", "!keep", "#note", "trail ", "trail"}
+	for _, f := range files {
+		if err := os.WriteFile(f, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, f := range []string{"a*b", "q?", "[ab]", "!keep", "#note", "trail "} {
+		pattern := PatternsFor(f).Exact
+		got, err := PreviewIgnorePattern(pattern)
+		if err != nil || !reflect.DeepEqual(got, []string{f}) {
+			t.Errorf("PreviewIgnorePattern(%q) = %q, %v; want [%q]", pattern, got, err, f)
+		}
+	}
+
+	// The escaped trailing space survives the trip through the ignore file
+	if err := AppendIgnorePattern(IgnoreExclude, PatternsFor("trail ").Exact); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := ListIgnored(); err != nil || !reflect.DeepEqual(got, []string{"trail "}) {
+		t.Errorf("ListIgnored() = %q, %v; want [\"trail \"]", got, err)
+	}
+}
+
+func TestGroupUntracked(t *testing.T) {
+	files := []string{"b.log", "a.log", "notes.txt", "build/out.o", "build/sub/x.o", "Makefile"}
+	want := []UntrackedGroup{
+		{Pattern: "*.log", Files: []string{"a.log", "b.log"}},
+		{Pattern: "/Makefile", Files: []string{"Makefile"}},
+		{Pattern: "/build/", Files: []string{"build/out.o", "build/sub/x.o"}},
+		{Pattern: "/notes.txt", Files: []string{"notes.txt"}},
+	}
+	if got := GroupUntracked(files); !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupUntracked() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCheckIgnore(t *testing.T) {
+	fake := useFakeBackend(t)
+	fake.OnOutput("check-ignore -v -- a.log build/",
+		".gitignore:3:*.log\ta.log\n.git/info/exclude:1:/build/\tbuild/")
+
+	got, err := CheckIgnore("a.log", "build/")
+	want := []IgnoreMatch{
+		{Path: "a.log", Source: ".gitignore", Line: 3, Pattern: "*.log"},
+		{Path: "build/", Source: ".git/info/exclude", Line: 1, Pattern: "/build/"},
+	}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("CheckIgnore() = %+v, %v; want %+v", got, err, want)
+	}
+
+	// Exit 1 means nothing is ignored, not a failure
+	fake.On("check-ignore -v -- src/", CommandResult{ExitCode: 1})
+	if got, err := CheckIgnore("src/"); err != nil || got != nil {
+		t.Errorf("CheckIgnore(not ignored) = %+v, %v; want nil, nil", got, err)
+	}
+}
+
+func TestAppendIgnorePattern(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := os.WriteFile(".gitignore", []byte("*.tmp"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, pattern := range []string{"*.log", "*.tmp", "*.log"} {
+		if err := AppendIgnorePattern(IgnoreGitignore, pattern); err != nil {
+			t.Fatalf("AppendIgnorePattern(%q): %v", pattern, err)
+		}
+	}
+	if err := AppendIgnorePattern(IgnoreExclude, "/build/"); err != nil {
+		t.Fatalf("AppendIgnorePattern(exclude): %v", err)
+	}
+
+	tests := []struct {
+		file string
+		want string
+	}{
+		{".gitignore", "*.tmp\n*.log\n"},
+		{IgnoreExclude.Path(), "/build/\n"},
+	}
+	for _, tc := range tests {
+		data, err := os.ReadFile(tc.file)
+		if err != nil || string(data) != tc.want {
+			t.Errorf("%s = %q, %v; want %q", tc.file, data, err, tc.want)
+		}
+	}
+}
@@ -3,7 +3,6 @@ package git
 import (
 	"fmt"
 	"os"
-	"os/exec"
 	"strings"
 	"github.com/jrengmusic/tit/internal"
 )
@@ -62,9 +61,8 @@ func InitializeRepository(dirPath string) error {
 	defer os.Chdir(originalCwd)
 
 	// Run git init
-	cmd := exec.Command("git", "init")
-	if output, err := cmd.CombinedOutput(); err != nil {
-		return fmt.Errorf("git init failed: %w\n%s", err, string(output))
+	if result := Execute("init"); !result.Success {
+		return fmt.Errorf("git init failed: %w", resultError(result))
 	}
 
 	return nil
@@ -74,20 +72,17 @@ func InitializeRepository(dirPath string) error {
 // WORKER THREAD - called from git operations, must be in worker goroutine.
 func CreateBranch(branchName string) error {
 	// Check if branch already exists
-	cmd := exec.Command("git", "rev-parse", "--verify", branchName)
-	if err := cmd.Run(); err == nil {
+	if Execute("rev-parse", "--verify", branchName).Success {
 		// Branch exists, just checkout
-		checkoutCmd := exec.Command("git", "checkout", branchName)
-		if output, err := checkoutCmd.CombinedOutput(); err != nil {
-			return fmt.Errorf("failed to checkout branch %s: %w\n%s", branchName, err, string(output))
+		if result := Execute("checkout", branchName); !result.Success {
+			return fmt.Errorf("failed to checkout branch %s: %w", branchName, resultError(result))
 		}
 		return nil
 	}
 
 	// Branch doesn't exist, create it
-	createCmd := exec.Command("git", "checkout", "-b", branchName)
-	if output, err := createCmd.CombinedOutput(); err != nil {
-		return fmt.Errorf("failed to create branch %s: %w\n%s", branchName, err, string(output))
+	if result := Execute("checkout", "-b", branchName); !result.Success {
+		return fmt.Errorf("failed to create branch %s: %w", branchName, resultError(result))
 	}
 
 	return nil
@@ -96,22 +91,20 @@ func CreateBranch(branchName string) error {
 // CheckoutBranch checks out an existing branch.
 // WORKER THREAD - called from git operations, must be in worker goroutine.
 func CheckoutBranch(branchName string) error {
-	cmd := exec.Command("git", "checkout", branchName)
-	if output, err := cmd.CombinedOutput(); err != nil {
-		return fmt.Errorf("failed to checkout branch %s: %w\n%s", branchName, err, string(output))
+	if result := Execute("checkout", branchName); !result.Success {
+		return fmt.Errorf("failed to checkout branch %s: %w", branchName, resultError(result))
 	}
 	return nil
 }
 
 // ListBranches returns all local branches
 func ListBranches() ([]string, error) {
-	cmd := exec.Command("git", "branch", "--format=%(refname:short)")
-	output, err := cmd.Output()
+	output, err := executeGitCommand("branch", "--format=%(refname:short)")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list branches: %w", err)
 	}
 
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	lines := strings.Split(output, "\n")
 	var branches []string
 	for _, line := range lines {
 		line = strings.TrimSpace(line)
@@ -129,16 +122,16 @@ func ListBranches() ([]string, error) {
 func GetRemoteDefaultBranch() (string, error) {
 	// Use git ls-remote --symref to read origin/HEAD symref directly from remote
 	// Format: "ref: refs/heads/main	HEAD" or just "HEAD" without ref line
-	cmd := exec.Command("git", "ls-remote", "--symref", "origin", "HEAD")
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return "", fmt.Errorf("failed to query remote HEAD: %w (output: %s)", err, string(output))
+	result := Execute("ls-remote", "--symref", "origin", "HEAD")
+	if !result.Success {
+		return "", fmt.Errorf("failed to query remote HEAD: %w", resultError(result))
 	}
+	output := result.Stdout
 
 	// Parse the output
 	// Line 1 is either "ref: refs/heads/main\tHEAD" or empty
 	// Line 2 is "<hash>\tHEAD" (or the only line if no symref found)
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	lines := strings.Split(output, "\n")
 
 	// FAIL FAST: Must have at least one line
 	if len(lines) == 0 {
@@ -169,18 +162,17 @@ func GetRemoteDefaultBranch() (string, error) {
 	}
 
 	// FAIL FAST: If we get here, the remote doesn't have a symbolic HEAD
-	return "", fmt.Errorf("remote HEAD is not a symbolic ref - cannot determine default branch. Output was: %s", string(output))
+	return "", fmt.Errorf("remote HEAD is not a symbolic ref - cannot determine default branch. Output was: %s", output)
 }
 
 // ListRemoteBranches returns all remote branches (without remote prefix)
 func ListRemoteBranches() ([]string, error) {
-	cmd := exec.Command("git", "branch", "-r", "--format=%(refname:short)")
-	output, err := cmd.Output()
+	output, err := executeGitCommand("branch", "-r", "--format=%(refname:short)")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list remote branches: %w", err)
 	}
 
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	lines := strings.Split(output, "\n")
 	var branches []string
 	for _, line := range lines {
 		line = strings.TrimSpace(line)
@@ -1,29 +1,53 @@
 package git
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 )
 
 // IsRepoLFS checks if the repository uses Git LFS by scanning .gitattributes for filter=lfs entries.
 // Uses file read only — no subprocess. Returns false if file does not exist or cannot be read.
 func IsRepoLFS() bool {
-	data, err := os.ReadFile(".gitattributes")
-	hasLFS := err == nil && strings.Contains(string(data), "filter=lfs")
-	return hasLFS
+	return currentRepo.isRepoLFS()
+}
+
+// isRepoLFS checks the .gitattributes at the root of d for filter=lfs
+func (d repoDir) isRepoLFS() bool {
+	data, err := os.ReadFile(filepath.Join(string(d), ".gitattributes"))
+	return err == nil && strings.Contains(string(data), "filter=lfs")
 }
 
+// LFS filter registration lives in global git config and rarely changes, so a
+// registered result is remembered for the process. A missing one is queried again
+// on every state refresh: `git lfs install` run outside TIT shows up right away.
+var (
+	lfsFiltersMutex      sync.Mutex
+	lfsFiltersRegistered bool
+)
+
 // IsLFSInstalled checks if the git-lfs binary is in PATH and filters are registered in git config.
 func IsLFSInstalled() bool {
-	_, pathErr := exec.LookPath("git-lfs")
-	binaryExists := pathErr == nil
-
-	result := Execute("config", "--get", "filter.lfs.process")
-	filtersRegistered := result.Success && result.Stdout != ""
+	if _, err := exec.LookPath("git-lfs"); err != nil {
+		return false
+	}
 
-	return binaryExists && filtersRegistered
+	lfsFiltersMutex.Lock()
+	defer lfsFiltersMutex.Unlock()
+	if !lfsFiltersRegistered {
+		result := Execute("config", "--get", "filter.lfs.process")
+		lfsFiltersRegistered = result.Success && result.Stdout != ""
+	}
+	return lfsFiltersRegistered
 }
 
 // IsLFSBinaryAvailable checks if the git-lfs binary is in PATH (regardless of filter registration).
@@ -46,3 +70,204 @@ func FetchLFSObjects(ctx context.Context) CommandResult {
 func CheckoutLFSObjects(ctx context.Context) CommandResult {
 	return ExecuteWithStreaming(ctx, "lfs", "checkout")
 }
+
+// LFSLargeFileThreshold is the size above which an untracked binary should go through LFS
+const LFSLargeFileThreshold int64 = 10 * 1024 * 1024
+
+// binarySniffLength matches git's own heuristic: a NUL in the first 8000 bytes means binary
+const binarySniffLength = 8000
+
+// LFSFile is one file reported by `git lfs ls-files`
+type LFSFile struct {
+	Path         string
+	OID          string // Short object id
+	Materialized bool   // true = real content checked out, false = pointer file only
+}
+
+// LFSLock is one lock reported by `git lfs locks`
+type LFSLock struct {
+	ID       string `json:"id"`
+	Path     string `json:"path"`
+	LockedAt string `json:"locked_at"`
+	Owner    struct {
+		Name string `json:"name"`
+	} `json:"owner"`
+}
+
+// LargeFile is a file about to be committed that is too big to live outside LFS
+type LargeFile struct {
+	Path string
+	Size int64
+}
+
+// LFSTrackedPatterns returns the patterns routed through LFS in .gitattributes, in file order.
+// Uses file read only — no subprocess.
+func LFSTrackedPatterns() []string {
+	data, err := os.ReadFile(".gitattributes")
+	if err != nil {
+		return nil
+	}
+
+	var patterns []string
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		for _, attr := range fields[1:] {
+			if attr == "filter=lfs" {
+				patterns = append(patterns, fields[0])
+				break
+			}
+		}
+	}
+	return patterns
+}
+
+// TrackLFSPattern adds pattern to .gitattributes via "git lfs track"
+func TrackLFSPattern(pattern string) CommandResult {
+	return Execute("lfs", "track", pattern)
+}
+
+// UntrackLFSPattern removes pattern from .gitattributes via "git lfs untrack"
+func UntrackLFSPattern(pattern string) CommandResult {
+	return Execute("lfs", "untrack", pattern)
+}
+
+// ListLFSFiles lists LFS files in HEAD with their checkout state
+func ListLFSFiles() ([]LFSFile, error) {
+	output, err := executeGitCommand("lfs", "ls-files")
+	if err != nil {
+		return nil, err
+	}
+	return parseLFSFiles(output), nil
+}
+
+// parseLFSFiles parses `git lfs ls-files` lines: "<oid> <*|-> <path>"
+// "*" means the object is checked out, "-" means only the pointer is present
+func parseLFSFiles(output string) []LFSFile {
+	var files []LFSFile
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.SplitN(line, " ", 3)
+		if len(fields) != 3 || (fields[1] != "*" && fields[1] != "-") {
+			continue
+		}
+		files = append(files, LFSFile{Path: fields[2], OID: fields[0], Materialized: fields[1] == "*"})
+	}
+	return files
+}
+
+// LFSServerTimeout bounds one lock query or change on the LFS server
+const LFSServerTimeout = 30 * time.Second
+
+// runLFSServer runs a git lfs command that talks to the LFS server, giving up
+// after LFSServerTimeout so an unreachable server cannot hang the caller
+func runLFSServer(ctx context.Context, args ...string) CommandResult {
+	ctx, cancel := context.WithTimeout(ctx, LFSServerTimeout)
+	defer cancel()
+	result := CurrentBackend().RunContext(ctx, args...)
+	if ctx.Err() == context.DeadlineExceeded {
+		result.Stderr = fmt.Sprintf("no answer from the LFS server within %s", LFSServerTimeout)
+	}
+	return result
+}
+
+// ListLFSLocks asks the LFS server for current locks
+func ListLFSLocks(ctx context.Context) ([]LFSLock, error) {
+	result := runLFSServer(ctx, "lfs", "locks", "--json")
+	if !result.Success {
+		return nil, resultError(result)
+	}
+	var locks []LFSLock
+	if result.Stdout == "" {
+		return locks, nil
+	}
+	if err := json.Unmarshal([]byte(result.Stdout), &locks); err != nil {
+		return nil, fmt.Errorf("failed to parse lfs locks: %w", err)
+	}
+	return locks, nil
+}
+
+// LockLFSFile takes an exclusive lock on path on the LFS server
+func LockLFSFile(ctx context.Context, path string) CommandResult {
+	return runLFSServer(ctx, "lfs", "lock", path)
+}
+
+// UnlockLFSFile releases the lock on path
+func UnlockLFSFile(ctx context.Context, path string) CommandResult {
+	return runLFSServer(ctx, "lfs", "unlock", path)
+}
+
+// MigrateLFSImport rewrites the current branch so files matching pattern are stored in LFS.
+// Streams output to UI buffer. History changes: the branch must be force-pushed afterwards.
+func MigrateLFSImport(ctx context.Context, pattern string) CommandResult {
+	return ExecuteWithStreaming(ctx, "lfs", "migrate", "import", "--include="+pattern)
+}
+
+// FindLargeBinaries returns binaries of at least threshold bytes among paths that are
+// not covered by an LFS filter. Directory paths (untracked "dir/") are walked.
+func FindLargeBinaries(paths []string, threshold int64) ([]LargeFile, error) {
+	var candidates []LargeFile
+	for _, p := range paths {
+		err := filepath.WalkDir(p, func(file string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil || info.Size() < threshold || !isBinaryFile(file) {
+				return nil
+			}
+			candidates = append(candidates, LargeFile{Path: filepath.ToSlash(file), Size: info.Size()})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	paths = make([]string, len(candidates))
+	for i, c := range candidates {
+		paths[i] = c.Path
+	}
+	output, err := executeGitCommand(append([]string{"check-attr", "-z", "filter", "--"}, paths...)...)
+	if err != nil {
+		return nil, err
+	}
+	inLFS := parseLFSFilterAttrs(output)
+
+	var large []LargeFile
+	for _, c := range candidates {
+		if !inLFS[c.Path] {
+			large = append(large, c)
+		}
+	}
+	return large, nil
+}
+
+// parseLFSFilterAttrs parses `check-attr -z filter` records (path, attribute, value)
+// and returns the set of paths whose filter is lfs
+func parseLFSFilterAttrs(output string) map[string]bool {
+	inLFS := make(map[string]bool)
+	fields := strings.Split(output, "\x00")
+	for i := 0; i+2 < len(fields); i += 3 {
+		if fields[i+2] == "lfs" {
+			inLFS[fields[i]] = true
+		}
+	}
+	return inLFS
+}
+
+// isBinaryFile reports whether the start of the file contains a NUL byte
+func isBinaryFile(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	buf := make([]byte, binarySniffLength)
+	n, _ := io.ReadFull(f, buf)
+	return bytes.IndexByte(buf[:n], 0) >= 0
+}
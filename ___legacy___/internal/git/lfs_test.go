@@ -0,0 +1,100 @@
+package git
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLFSTrackedPatterns(t *testing.T) {
+	t.Chdir(t.TempDir())
+	attrs := "# assets\n*.psd filter=lfs diff=lfs merge=lfs -text\n*.txt text eol=lf\n" +
+		"assets/** filter=lfs diff=lfs merge=lfs -text\n#*.zip filter=lfs\n"
+	if err := os.WriteFile(".gitattributes", []byte(attrs), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"*.psd", "assets/**"}
+	if got := LFSTrackedPatterns(); !reflect.DeepEqual(got, want) {
+		t.Errorf("LFSTrackedPatterns() = %v, want %v", got, want)
+	}
+}
+
+func TestParseLFSFiles(t *testing.T) {
+	output := "4d7a214614 * art/cover art.psd\n9f86d08188 - video.mp4\nnot an lfs line"
+	want := []LFSFile{
+		{Path: "art/cover art.psd", OID: "4d7a214614", Materialized: true},
+		{Path: "video.mp4", OID: "9f86d08188", Materialized: false},
+	}
+	if got := parseLFSFiles(output); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseLFSFiles() = %+v, want %+v", got, want)
+	}
+}
+
+func TestListLFSLocks(t *testing.T) {
+	fake := useFakeBackend(t)
+	fake.OnOutput("lfs locks --json",
+		`[{"id":"3","path":"art/cover.psd","owner":{"name":"dana"},"locked_at":"2024-05-01T10:00:00Z"}]`)
+
+	locks, err := ListLFSLocks(context.Background())
+	if err != nil || len(locks) != 1 {
+		t.Fatalf("ListLFSLocks() = %+v, %v", locks, err)
+	}
+	if got := locks[0]; got.ID != "3" || got.Path != "art/cover.psd" || got.Owner.Name != "dana" {
+		t.Errorf("lock = %+v", got)
+	}
+}
+
+func TestFindLargeBinaries(t *testing.T) {
+	t.Chdir(t.TempDir())
+	binary := make([]byte, 64)
+	if err := os.MkdirAll("assets", 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"big.bin", "assets/tracked.psd"} {
+		if err := os.WriteFile(name, binary, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile("big.txt", []byte("large but text, not binary............................................."), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fake := useFakeBackend(t)
+	fake.OnOutput("check-attr -z filter -- assets/tracked.psd big.bin",
+		"assets/tracked.psd\x00filter\x00lfs\x00big.bin\x00filter\x00unspecified\x00")
+
+	got, err := FindLargeBinaries([]string{"assets/", "big.bin", "big.txt", "deleted.bin"}, 32)
+	want := []LargeFile{{Path: "big.bin", Size: 64}}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("FindLargeBinaries() = %+v, %v; want %+v", got, err, want)
+	}
+}
+
+func TestIsLFSInstalled(t *testing.T) {
+	bin := t.TempDir()
+	if err := os.WriteFile(filepath.Join(bin, "git-lfs"), []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", bin)
+	resetLFSFilterCache()
+	t.Cleanup(resetLFSFilterCache)
+
+	// Not registered yet: asked again on the next refresh, not cached
+	fake := useFakeBackend(t)
+	if IsLFSInstalled() {
+		t.Fatal("IsLFSInstalled() = true before `git lfs install`")
+	}
+	fake.OnOutput("config --get filter.lfs.process", "git-lfs filter-process")
+	if !IsLFSInstalled() {
+		t.Fatal("IsLFSInstalled() = false after `git lfs install` ran outside TIT")
+	}
+
+	// Registered: remembered without asking git again
+	calls := len(fake.Calls())
+	if !IsLFSInstalled() || len(fake.Calls()) != calls {
+		t.Errorf("IsLFSInstalled() queried git again after a positive result")
+	}
+}
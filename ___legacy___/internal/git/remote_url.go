@@ -0,0 +1,323 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RemoteURLKind classifies the transport a remote URL uses
+type RemoteURLKind string
+
+const (
+	RemoteSSH    RemoteURLKind = "ssh"    // ssh://, git+ssh:// or scp-style [user@]host:path
+	RemoteHTTP   RemoteURLKind = "http"   // http:// or https://
+	RemoteGit    RemoteURLKind = "git"    // git:// (unauthenticated daemon)
+	RemoteLocal  RemoteURLKind = "local"  // file:// or a filesystem path
+	RemoteHelper RemoteURLKind = "helper" // <transport>::<address>, handled by git-remote-<transport>
+)
+
+// RemoteURL is a parsed remote URL. Fields that do not apply to the kind are empty.
+type RemoteURL struct {
+	Kind   RemoteURLKind
+	Scheme string // As written ("https", "git+ssh", helper name); empty for scp-style and plain paths
+	User   string
+	Host   string // Without IPv6 brackets
+	Port   string
+	Path   string // Repository path, or the helper address
+}
+
+// remoteURLSchemes maps every URL scheme git accepts to its transport
+var remoteURLSchemes = map[string]RemoteURLKind{
+	"ssh":     RemoteSSH,
+	"git+ssh": RemoteSSH,
+	"ssh+git": RemoteSSH,
+	"git":     RemoteGit,
+	"http":    RemoteHTTP,
+	"https":   RemoteHTTP,
+	"file":    RemoteLocal,
+}
+
+// ParseRemoteURL parses the URL forms git accepts (see git-clone(1) "GIT URLS"):
+// scheme URLs (ssh, git, http[s], file), scp-style [user@]host:path,
+// local paths and <transport>::<address> remote helpers.
+// ftp[s]:// is rejected: git documents it as deprecated.
+// A bare word is rejected too: it is far more often a typo than a sibling
+// directory, which can still be given as ./name.
+func ParseRemoteURL(raw string) (RemoteURL, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return RemoteURL{}, errors.New("URL is empty")
+	}
+
+	if transport, address, found := strings.Cut(raw, "::"); found && isHelperName(transport) {
+		if address == "" {
+			return RemoteURL{}, fmt.Errorf("missing address after %s::", transport)
+		}
+		return RemoteURL{Kind: RemoteHelper, Scheme: transport, Path: address}, nil
+	}
+
+	if scheme, rest, found := strings.Cut(raw, "://"); found {
+		return parseSchemeURL(strings.ToLower(scheme), rest)
+	}
+
+	// scp-style: a colon before any slash ("host:path", "[::1]:path")
+	if colon := scpColon(raw); colon > 0 && !isDrivePath(raw) {
+		authority, path := raw[:colon], raw[colon+1:]
+		if path == "" {
+			return RemoteURL{}, errors.New("missing repository path after ':'")
+		}
+		user, host := splitUserHost(authority)
+		host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+		if host == "" {
+			return RemoteURL{}, errors.New("missing host before ':'")
+		}
+		return RemoteURL{Kind: RemoteSSH, User: user, Host: host, Path: path}, nil
+	}
+
+	return parseLocalPath(raw)
+}
+
+// parseSchemeURL parses "<scheme>://[user@]host[:port]/path"
+func parseSchemeURL(scheme, rest string) (RemoteURL, error) {
+	kind, ok := remoteURLSchemes[scheme]
+	if !ok {
+		if scheme == "ftp" || scheme == "ftps" {
+			return RemoteURL{}, fmt.Errorf("%s:// is deprecated in git; use https:// or ssh://", scheme)
+		}
+		return RemoteURL{}, fmt.Errorf("unsupported scheme %q", scheme)
+	}
+
+	authority, path := rest, ""
+	if slash := strings.Index(rest, "/"); slash >= 0 {
+		authority, path = rest[:slash], rest[slash:]
+	}
+	parsed := RemoteURL{Kind: kind, Scheme: scheme, Path: path}
+
+	if kind == RemoteLocal {
+		// file:///path or file://localhost/path; git has no remote file transport
+		if authority != "" && authority != "localhost" {
+			return RemoteURL{}, fmt.Errorf("file:// URLs cannot name a host (%q)", authority)
+		}
+		if path == "" {
+			return RemoteURL{}, errors.New("missing repository path")
+		}
+		return parsed, nil
+	}
+
+	user, hostPort := splitUserHost(authority)
+	host, port, err := splitHostPort(hostPort)
+	if err != nil {
+		return RemoteURL{}, err
+	}
+	if host == "" {
+		return RemoteURL{}, errors.New("missing host")
+	}
+	if (kind == RemoteSSH || kind == RemoteGit) && strings.Trim(path, "/") == "" {
+		return RemoteURL{}, errors.New("missing repository path")
+	}
+	parsed.User, parsed.Host, parsed.Port = user, host, port
+	return parsed, nil
+}
+
+// parseLocalPath accepts absolute, home-relative and explicitly relative paths
+func parseLocalPath(raw string) (RemoteURL, error) {
+	first, _, hasSlash := strings.Cut(raw, "/")
+	switch {
+	case strings.HasPrefix(raw, "/"), strings.HasPrefix(raw, "~"),
+		raw == ".", raw == "..", first == ".", first == "..", isDrivePath(raw):
+	case strings.Contains(first, "@"):
+		return RemoteURL{}, fmt.Errorf("%q looks like an SSH address: separate host and path with ':'", raw)
+	case !hasSlash:
+		return RemoteURL{}, fmt.Errorf("%q is not a URL or path (use ./%s for a local directory)", raw, raw)
+	}
+	return RemoteURL{Kind: RemoteLocal, Path: raw}, nil
+}
+
+// scpColon returns the index of the colon separating host and path in an
+// scp-style address, or -1. Bracketed hosts may contain colons (IPv6).
+func scpColon(raw string) int {
+	start := 0
+	if at := strings.Index(raw, "@["); at >= 0 || strings.HasPrefix(raw, "[") {
+		end := strings.Index(raw, "]")
+		if end < 0 {
+			return -1
+		}
+		start = end
+	}
+	colon := strings.Index(raw[start:], ":")
+	if colon < 0 {
+		return -1
+	}
+	colon += start
+	if strings.Contains(raw[:colon], "/") {
+		return -1
+	}
+	return colon
+}
+
+// isDrivePath reports a DOS drive path ("C:\repo", "C:/repo"), which git treats as local
+func isDrivePath(raw string) bool {
+	return len(raw) >= 3 && raw[1] == ':' && (raw[2] == '\\' || raw[2] == '/') &&
+		(raw[0] >= 'a' && raw[0] <= 'z' || raw[0] >= 'A' && raw[0] <= 'Z')
+}
+
+// isHelperName reports whether s can name a remote helper (git-remote-<s>)
+func isHelperName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("+-.", r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitUserHost splits "user@host" at the last '@' (user names may contain '@')
+func splitUserHost(authority string) (user, host string) {
+	if at := strings.LastIndex(authority, "@"); at >= 0 {
+		return authority[:at], authority[at+1:]
+	}
+	return "", authority
+}
+
+// splitHostPort splits "host:port" and "[v6]:port"; the port is optional
+func splitHostPort(hostPort string) (host, port string, err error) {
+	host = hostPort
+	if strings.HasPrefix(hostPort, "[") {
+		end := strings.Index(hostPort, "]")
+		if end < 0 {
+			return "", "", errors.New("unterminated '[' in host")
+		}
+		host, port = hostPort[1:end], strings.TrimPrefix(hostPort[end+1:], ":")
+	} else if h, p, found := strings.Cut(hostPort, ":"); found {
+		host, port = h, p
+	}
+	if port != "" {
+		if n, convErr := strconv.Atoi(port); convErr != nil || n <= 0 || n > 65535 {
+			return "", "", fmt.Errorf("invalid port %q", port)
+		}
+	}
+	return host, port, nil
+}
+
+// ========================================
+// Connectivity test
+// ========================================
+
+// RemoteProbeTimeout bounds the connectivity test run before saving a remote
+const RemoteProbeTimeout = 20 * time.Second
+
+// RemoteProbeStatus is the outcome of a remote connectivity test
+type RemoteProbeStatus string
+
+const (
+	ProbeOK          RemoteProbeStatus = "ok"          // Reachable, refs listed
+	ProbeEmpty       RemoteProbeStatus = "empty"       // Reachable, repository has no commits yet
+	ProbeAuthFailed  RemoteProbeStatus = "auth_failed" // Server refused the credentials
+	ProbeHostKey     RemoteProbeStatus = "host_key"    // SSH host key unknown or changed
+	ProbeNotFound    RemoteProbeStatus = "not_found"   // Host reachable, repository missing
+	ProbeUnreachable RemoteProbeStatus = "unreachable" // DNS failure, connection refused
+	ProbeTimeout     RemoteProbeStatus = "timeout"     // No answer within RemoteProbeTimeout
+	ProbeFailed      RemoteProbeStatus = "failed"      // Any other error (see Detail)
+)
+
+// RemoteProbe is the result of ProbeRemote
+type RemoteProbe struct {
+	Status        RemoteProbeStatus
+	DefaultBranch string // Branch the remote HEAD points to, empty if not advertised
+	Detail        string // git's stderr for failures
+}
+
+// Reachable reports whether the remote answered (with or without commits)
+func (p RemoteProbe) Reachable() bool {
+	return p.Status == ProbeOK || p.Status == ProbeEmpty
+}
+
+// authProbeStatus folds auth diagnoses (DiagnoseAuth) into probe statuses
+var authProbeStatus = map[AuthProblem]RemoteProbeStatus{
+	AuthHostKeyUnknown:     ProbeHostKey,
+	AuthHostKeyChanged:     ProbeHostKey,
+	AuthPublicKeyDenied:    ProbeAuthFailed,
+	AuthCredentialsMissing: ProbeAuthFailed,
+	AuthTokenRequired:      ProbeAuthFailed,
+	AuthAccessDenied:       ProbeAuthFailed,
+	AuthRepoNotFound:       ProbeNotFound,
+}
+
+// remoteProbeStderr maps network error text to a status, checked in order after DiagnoseAuth
+var remoteProbeStderr = []struct {
+	needle string
+	status RemoteProbeStatus
+}{
+	{"could not resolve host", ProbeUnreachable},
+	{"name or service not known", ProbeUnreachable},
+	{"connection refused", ProbeUnreachable},
+	{"network is unreachable", ProbeUnreachable},
+	{"no route to host", ProbeUnreachable},
+	{"connection timed out", ProbeTimeout},
+	{"operation timed out", ProbeTimeout},
+	{"not found", ProbeNotFound},
+}
+
+// ProbeRemote checks that url answers `git ls-remote --symref <url> HEAD` within timeout.
+// SSH runs in BatchMode so an unknown host key or passphrase prompt fails instead of
+// grabbing the terminal; HTTP prompts are already disabled by the backend.
+func ProbeRemote(ctx context.Context, url string, timeout time.Duration) RemoteProbe {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sshCommand := "ssh"
+	if configured := Execute("config", "--get", "core.sshCommand"); configured.Success && configured.Stdout != "" {
+		sshCommand = configured.Stdout
+	}
+	connectTimeout := int(timeout / time.Second)
+	sshCommand += fmt.Sprintf(" -o BatchMode=yes -o ConnectTimeout=%d", connectTimeout)
+
+	result := CurrentBackend().RunContext(ctx, "-c", "core.sshCommand="+sshCommand,
+		"ls-remote", "--symref", url, "HEAD")
+	if ctx.Err() == context.DeadlineExceeded {
+		return RemoteProbe{Status: ProbeTimeout, Detail: fmt.Sprintf("no answer within %s", timeout)}
+	}
+	if !result.Success {
+		return RemoteProbe{Status: classifyProbeError(result.Stderr), Detail: result.Stderr}
+	}
+	return parseProbeOutput(result.Stdout)
+}
+
+// classifyProbeError maps ls-remote stderr to a probe status
+func classifyProbeError(stderr string) RemoteProbeStatus {
+	if problem, _ := DiagnoseAuth(stderr); problem != AuthNone {
+		return authProbeStatus[problem]
+	}
+	lower := strings.ToLower(stderr)
+	for _, rule := range remoteProbeStderr {
+		if strings.Contains(lower, rule.needle) {
+			return rule.status
+		}
+	}
+	return ProbeFailed
+}
+
+// parseProbeOutput reads `ls-remote --symref <url> HEAD`:
+// "ref: refs/heads/main\tHEAD" then "<hash>\tHEAD"; no lines for an empty repository
+func parseProbeOutput(stdout string) RemoteProbe {
+	probe := RemoteProbe{Status: ProbeEmpty}
+	for _, line := range strings.Split(stdout, "\n") {
+		target, name, found := strings.Cut(line, "\t")
+		if !found || name != "HEAD" {
+			continue
+		}
+		if ref, isSymref := strings.CutPrefix(target, "ref: "); isSymref {
+			probe.DefaultBranch = strings.TrimPrefix(ref, "refs/heads/")
+			continue
+		}
+		probe.Status = ProbeOK
+	}
+	return probe
+}
@@ -0,0 +1,104 @@
+package git
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jrengmusic/tit/internal"
+)
+
+// DashboardConcurrency caps the git processes the dashboard runs at once
+const DashboardConcurrency = 8
+
+// DashboardOpTimeout bounds one repository's fetch or pull in a batch
+const DashboardOpTimeout = 2 * time.Minute
+
+// FindRepos expands dirs into repository roots: a directory containing .git is itself,
+// any other directory contributes its immediate subdirectories that are repositories.
+// Returns absolute paths, sorted and without duplicates.
+func FindRepos(dirs []string) []string {
+	seen := make(map[string]bool)
+	var repos []string
+	add := func(path string) {
+		if abs, err := filepath.Abs(path); err == nil && !seen[abs] {
+			seen[abs] = true
+			repos = append(repos, abs)
+		}
+	}
+
+	for _, dir := range dirs {
+		if isRepoRoot(dir) {
+			add(dir)
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			child := filepath.Join(dir, entry.Name())
+			if entry.IsDir() && isRepoRoot(child) {
+				add(child)
+			}
+		}
+	}
+	sort.Strings(repos)
+	return repos
+}
+
+// isRepoRoot reports whether dir has a .git directory (or gitfile, for worktrees)
+func isRepoRoot(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, internal.GitDirectoryName))
+	return err == nil
+}
+
+// DetectStates runs DetectStateIn for every repository concurrently; states[i] belongs to repos[i]
+func DetectStates(repos []string) []*State {
+	states := make([]*State, len(repos))
+	forEachRepo(len(repos), func(i int) {
+		states[i], _ = DetectStateIn(repos[i]) // Never fails (see DetectState)
+	})
+	return states
+}
+
+// FetchRepos fetches origin in each repository concurrently; results[i] belongs to repos[i]
+func FetchRepos(ctx context.Context, repos []string) []CommandResult {
+	return runInRepos(ctx, repos, "fetch", "--quiet", "origin")
+}
+
+// FastForwardRepos pulls each repository concurrently, refusing anything but a fast-forward
+// so a batch never creates merge commits or conflicts; results[i] belongs to repos[i]
+func FastForwardRepos(ctx context.Context, repos []string) []CommandResult {
+	return runInRepos(ctx, repos, "pull", "--ff-only", "--quiet")
+}
+
+// runInRepos runs `git -C <repo> args...` for every repository, each bounded by DashboardOpTimeout
+func runInRepos(ctx context.Context, repos []string, args ...string) []CommandResult {
+	results := make([]CommandResult, len(repos))
+	forEachRepo(len(repos), func(i int) {
+		repoCtx, cancel := context.WithTimeout(ctx, DashboardOpTimeout)
+		defer cancel()
+		results[i] = CurrentBackend().RunContext(repoCtx, append([]string{"-C", repos[i]}, args...)...)
+	})
+	return results
+}
+
+// forEachRepo calls fn(0..n-1) with at most DashboardConcurrency calls running at once
+func forEachRepo(n int, fn func(i int)) {
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, DashboardConcurrency)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
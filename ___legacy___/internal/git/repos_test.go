@@ -0,0 +1,67 @@
+package git
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDetectStates(t *testing.T) {
+	root := newGitTestRepo(t)
+
+	// repos/behind is clean and one commit behind origin; repos/dirty has a local change
+	remote := filepath.Join(root, "remote.git")
+	behind := filepath.Join(root, "repos", "behind")
+	dirty := filepath.Join(root, "repos", "dirty")
+	peer := filepath.Join(root, "peer")
+	gitRun(t, root, "init", "-q", "--bare", "-b", "main", remote)
+	gitRun(t, root, "clone", "-q", remote, peer)
+	gitRun(t, peer, "commit", "-q", "--allow-empty", "-m", "initial")
+	gitRun(t, peer, "push", "-q", "origin", "main")
+	gitRun(t, root, "clone", "-q", remote, behind)
+	gitRun(t, peer, "commit", "-q", "--allow-empty", "-m", "upstream")
+	gitRun(t, peer, "push", "-q", "origin", "main")
+	gitRun(t, root, "init", "-q", "-b", "main", dirty)
+	if err := os.WriteFile(filepath.Join(dirty, "notes.txt"), []byte("x\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(root, "repos", "plain"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	repos := FindRepos([]string{filepath.Join(root, "repos"), peer, behind})
+	if want := []string{peer, behind, dirty}; !reflect.DeepEqual(repos, want) {
+		t.Fatalf("FindRepos() = %q, want %q", repos, want)
+	}
+
+	// Fetch makes "behind" visible; detection from elsewhere matches DetectState inside the repo
+	for i, result := range FetchRepos(context.Background(), repos) {
+		if repos[i] != dirty && !result.Success {
+			t.Fatalf("fetch %s: %s", repos[i], result.Stderr)
+		}
+	}
+	t.Chdir(root)
+	states := DetectStates(repos)
+	for i, repo := range repos {
+		t.Chdir(repo)
+		want, _ := DetectState()
+		if !reflect.DeepEqual(states[i], want) {
+			t.Errorf("DetectStateIn(%s) = %+v, want %+v", repo, states[i], want)
+		}
+	}
+	if states[1].Timeline != Behind || states[1].WorkingTree != Clean {
+		t.Errorf("behind repo: timeline=%s tree=%s", states[1].Timeline, states[1].WorkingTree)
+	}
+	if states[2].WorkingTree != Dirty || states[2].Remote != NoRemote {
+		t.Errorf("dirty repo: tree=%s remote=%s", states[2].WorkingTree, states[2].Remote)
+	}
+
+	if result := FastForwardRepos(context.Background(), []string{behind})[0]; !result.Success {
+		t.Fatalf("fast-forward: %s", result.Stderr)
+	}
+	if state, _ := DetectStateIn(behind); state.Timeline != InSync {
+		t.Errorf("after fast-forward: timeline=%s", state.Timeline)
+	}
+}
@@ -0,0 +1,198 @@
+package git
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SparseDir aggregates the files of one directory in HEAD's tree
+type SparseDir struct {
+	Path        string   // Repository-relative, no trailing slash ("" = root)
+	Files       int      // Files in this directory and below
+	Size        int64    // Bytes in this directory and below
+	DirectFiles int      // Files directly in this directory
+	DirectSize  int64    // Bytes directly in this directory
+	Children    []string // Immediate subdirectory paths, sorted
+}
+
+// RepoTree is HEAD's directory tree with per-directory file counts and sizes
+type RepoTree struct {
+	Dirs map[string]*SparseDir // Keyed by path; "" is the root
+}
+
+// Root returns the repository root directory
+func (t *RepoTree) Root() *SparseDir {
+	return t.Dirs[""]
+}
+
+// ReadRepoTree lists HEAD with `git ls-tree -r -l -z` and aggregates files per directory.
+// Reads the committed tree, so it covers paths outside the current sparse cone.
+func ReadRepoTree() (*RepoTree, error) {
+	output, err := executeGitCommand("ls-tree", "-r", "-l", "-z", "HEAD")
+	if err != nil {
+		return nil, err
+	}
+	return parseRepoTree(output), nil
+}
+
+// parseRepoTree parses `ls-tree -r -l -z` records: "<mode> <type> <oid> <size>\t<path>".
+// Submodules (type commit, size "-") count as files of size 0.
+func parseRepoTree(output string) *RepoTree {
+	tree := &RepoTree{Dirs: map[string]*SparseDir{"": {Path: ""}}}
+
+	for _, record := range splitNul(output) {
+		meta, file, found := strings.Cut(record, "\t")
+		if !found {
+			continue
+		}
+		fields := strings.Fields(meta)
+		if len(fields) != 4 {
+			continue
+		}
+		size, _ := strconv.ParseInt(fields[3], 10, 64)
+
+		dir := path.Dir(file)
+		if dir == "." {
+			dir = ""
+		}
+		leaf := tree.ensureDir(dir)
+		leaf.DirectFiles++
+		leaf.DirectSize += size
+
+		// Roll the file up into every ancestor, root included
+		for d := dir; ; d = parentDir(d) {
+			tree.Dirs[d].Files++
+			tree.Dirs[d].Size += size
+			if d == "" {
+				break
+			}
+		}
+	}
+
+	for _, dir := range tree.Dirs {
+		sort.Strings(dir.Children)
+	}
+	return tree
+}
+
+// ensureDir returns the entry for dir, creating it and any missing ancestors
+func (t *RepoTree) ensureDir(dir string) *SparseDir {
+	if existing, ok := t.Dirs[dir]; ok {
+		return existing
+	}
+	parent := t.ensureDir(parentDir(dir))
+	created := &SparseDir{Path: dir}
+	t.Dirs[dir] = created
+	parent.Children = append(parent.Children, dir)
+	return created
+}
+
+// parentDir returns the parent of a repository-relative directory ("" for top-level)
+func parentDir(dir string) string {
+	if parent := path.Dir(dir); parent != "." {
+		return parent
+	}
+	return ""
+}
+
+// ConeEstimate returns the files and bytes a cone-mode sparse checkout of dirs materializes:
+// root files, files directly inside each ancestor of a chosen directory, and everything below it.
+// dirs must not contain a directory nested inside another entry.
+func (t *RepoTree) ConeEstimate(dirs []string) (files int, size int64) {
+	root := t.Root()
+	files, size = root.DirectFiles, root.DirectSize
+
+	ancestors := make(map[string]bool)
+	for _, dir := range dirs {
+		entry, ok := t.Dirs[dir]
+		if !ok {
+			continue
+		}
+		files += entry.Files
+		size += entry.Size
+		for a := parentDir(dir); a != ""; a = parentDir(a) {
+			ancestors[a] = true
+		}
+	}
+	for a := range ancestors {
+		files += t.Dirs[a].DirectFiles
+		size += t.Dirs[a].DirectSize
+	}
+	return files, size
+}
+
+// IsSparse reports whether sparse checkout is enabled (core.sparseCheckout, no subprocess)
+func IsSparse() bool {
+	return currentRepo.readConfigBool("core", "sparsecheckout")
+}
+
+// IsSparseCone reports whether the sparse patterns are in cone mode (core.sparseCheckoutCone)
+func IsSparseCone() bool {
+	return currentRepo.readConfigBool("core", "sparsecheckoutcone")
+}
+
+// SparsePatterns returns `git sparse-checkout list`: directories in cone mode, raw patterns otherwise
+func SparsePatterns() ([]string, error) {
+	output, err := executeGitCommand("sparse-checkout", "list")
+	if err != nil {
+		return nil, err
+	}
+	var patterns []string
+	for _, line := range strings.Split(output, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			patterns = append(patterns, line)
+		}
+	}
+	return patterns, nil
+}
+
+// SparseSetArgs returns the `git sparse-checkout set --cone` arguments for dirs
+func SparseSetArgs(dirs []string) []string {
+	return append([]string{"sparse-checkout", "set", "--cone"}, dirs...)
+}
+
+// readConfigBool reads a boolean from .git/config, then .git/config.worktree
+// (where git stores sparse settings once extensions.worktreeConfig is on).
+// Keys are matched case-insensitively; a missing key is false.
+func (d repoDir) readConfigBool(section, key string) bool {
+	switch strings.ToLower(d.readConfigValue(section, key)) {
+	case "true", "yes", "on", "1":
+		return true
+	}
+	return false
+}
+
+// readConfigValue reads a value from .git/config, then .git/config.worktree; the last
+// occurrence wins. Section and key are matched case-insensitively; a missing key is "".
+func (d repoDir) readConfigValue(section, key string) string {
+	value := ""
+	for _, name := range []string{"config", "config.worktree"} {
+		f, err := os.Open(d.gitPath(name))
+		if err != nil {
+			continue
+		}
+		current := ""
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if strings.HasPrefix(line, "[") {
+				current = strings.ToLower(strings.Trim(line, "[]"))
+				continue
+			}
+			if current != section {
+				continue
+			}
+			k, v, found := strings.Cut(line, "=")
+			if !found || strings.ToLower(strings.TrimSpace(k)) != key {
+				continue
+			}
+			value = strings.Trim(strings.TrimSpace(v), `"`)
+		}
+		f.Close()
+	}
+	return value
+}
@@ -0,0 +1,93 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadRepoTree(t *testing.T) {
+	fake := useFakeBackend(t)
+	fake.OnOutput("ls-tree -r -l -z HEAD", ""+
+		"100644 blob aaaa      10\tREADME.md\x00"+
+		"100644 blob bbbb     100\tsrc/main.go\x00"+
+		"100644 blob cccc    1000\tsrc/app/app.go\x00"+
+		"100644 blob dddd   10000\tsrc/app/view/view.go\x00"+
+		"160000 commit eeee       -\tvendor/lib\x00"+
+		"100644 blob ffff  100000\tdocs/guide.md\x00")
+
+	tree, err := ReadRepoTree()
+	if err != nil {
+		t.Fatalf("ReadRepoTree: %v", err)
+	}
+
+	dirs := []struct {
+		path     string
+		files    int
+		size     int64
+		children []string
+	}{
+		{"", 6, 111110, []string{"docs", "src", "vendor"}},
+		{"src", 3, 11100, []string{"src/app"}},
+		{"src/app", 2, 11000, []string{"src/app/view"}},
+		{"vendor", 1, 0, nil},
+	}
+	for _, tc := range dirs {
+		d, ok := tree.Dirs[tc.path]
+		if !ok {
+			t.Errorf("dir %q missing", tc.path)
+			continue
+		}
+		if d.Files != tc.files || d.Size != tc.size || !reflect.DeepEqual(d.Children, tc.children) {
+			t.Errorf("dir %q = %d files, %d bytes, children %v; want %d, %d, %v",
+				tc.path, d.Files, d.Size, d.Children, tc.files, tc.size, tc.children)
+		}
+	}
+
+	estimates := []struct {
+		dirs  []string
+		files int
+		size  int64
+	}{
+		{nil, 1, 10},                  // Root files only
+		{[]string{"docs"}, 2, 100010}, // Root + docs
+		// Ancestors contribute their direct files: src/main.go and src/app/app.go
+		{[]string{"src/app/view"}, 4, 11110},
+		{[]string{"src", "docs", "vendor"}, 6, 111110},
+	}
+	for _, tc := range estimates {
+		files, size := tree.ConeEstimate(tc.dirs)
+		if files != tc.files || size != tc.size {
+			t.Errorf("ConeEstimate(%v) = %d files, %d bytes; want %d, %d", tc.dirs, files, size, tc.files, tc.size)
+		}
+	}
+}
+
+func TestIsSparse(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := os.Mkdir(".git", 0755); err != nil {
+		t.Fatal(err)
+	}
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(".git", name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("config", "[core]\n\tbare = false\n[remote \"origin\"]\n\tsparseCheckout = true\n")
+	if IsSparse() {
+		t.Error("IsSparse() = true for a key outside [core]")
+	}
+
+	write("config", "[core]\n\tsparseCheckout = true\n\tsparseCheckoutCone = true\n")
+	if !IsSparse() || !IsSparseCone() {
+		t.Errorf("IsSparse() = %v, IsSparseCone() = %v; want true, true", IsSparse(), IsSparseCone())
+	}
+
+	// Worktree config overrides the shared config
+	write("config.worktree", "[core]\n\tsparseCheckout = false\n")
+	if IsSparse() {
+		t.Error("IsSparse() = true after config.worktree disabled it")
+	}
+}
@@ -1,130 +1,376 @@
 package git
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 	"github.com/jrengmusic/tit/internal"
 )
 
-// GenerateSSHKey generates a new SSH key pair
-func GenerateSSHKey(email string) error {
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return fmt.Errorf("could not get home directory: %w", err)
-	}
+// SSHKeyType is the algorithm of a key generated by the setup wizard
+type SSHKeyType string
 
-	sshDir := filepath.Join(home, ".ssh")
+const (
+	SSHKeyEd25519 SSHKeyType = "ed25519" // Default: short keys, accepted by every current host
+	SSHKeyRSA     SSHKeyType = "rsa"     // 4096-bit, for servers without ed25519 support
+)
 
-	// Create .ssh directory if it doesn't exist
-	if err := os.MkdirAll(sshDir, internal.SSHDirPerms); err != nil {
-		return fmt.Errorf("could not create .ssh directory: %w", err)
-	}
+// SSHKey is a private key in ~/.ssh that has its public half next to it
+type SSHKey struct {
+	Path    string // Private key
+	Type    string // Algorithm from the public key ("ssh-ed25519", "ssh-rsa", ...)
+	Comment string // Usually the email given at generation
+}
+
+// PublicPath returns the path of the public key
+func (k SSHKey) PublicPath() string {
+	return k.Path + ".pub"
+}
+
+// SSHProvider is a git host the setup wizard knows how to set up
+type SSHProvider struct {
+	Name    string
+	Host    string
+	KeysURL string // Where the public key is added
+}
+
+// SSHProviders lists the hosts offered by the setup wizard (any other host can be typed in)
+var SSHProviders = []SSHProvider{
+	{Name: "GitHub", Host: "github.com", KeysURL: "github.com/settings/ssh/new"},
+	{Name: "GitLab", Host: "gitlab.com", KeysURL: "gitlab.com/-/user_settings/ssh_keys"},
+	{Name: "Bitbucket", Host: "bitbucket.org", KeysURL: "bitbucket.org/account/settings/ssh-keys"},
+}
 
-	// Use TIT-specific key names to avoid conflicts
-	keyPath := filepath.Join(sshDir, "TIT_id_rsa")
+// askpassSecretEnv carries a passphrase to TIT re-executed as SSH_ASKPASS
+const askpassSecretEnv = "TIT_ASKPASS_SECRET"
 
-	// Check if TIT key already exists
-	if _, err := os.Stat(keyPath); err == nil {
-		// Key already exists, skip generation
+// sshDir returns ~/.ssh
+func sshDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("could not get home directory: %w", err)
+	}
+	return filepath.Join(home, ".ssh"), nil
+}
+
+// FindSSHKeys lists the key pairs in ~/.ssh: every *.pub file with its private key beside it
+func FindSSHKeys() []SSHKey {
+	dir, err := sshDir()
+	if err != nil {
 		return nil
 	}
+	pubs, _ := filepath.Glob(filepath.Join(dir, "*.pub"))
+	sort.Strings(pubs)
 
-	// Generate SSH key: ssh-keygen -t rsa -b 4096 -C "email" -f keyPath -N ""
-	// Note: ssh-keygen automatically creates the .pub file with the same name
-	cmd := exec.Command("ssh-keygen", "-t", "rsa", "-b", "4096", "-C", email, "-f", keyPath, "-N", "")
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("ssh-keygen failed: %w", err)
+	var keys []SSHKey
+	for _, pub := range pubs {
+		private := strings.TrimSuffix(pub, ".pub")
+		if !fileExists(private) {
+			continue
+		}
+		data, err := os.ReadFile(pub)
+		if err != nil {
+			continue
+		}
+		fields := strings.Fields(string(data))
+		if len(fields) < 2 {
+			continue
+		}
+		key := SSHKey{Path: private, Type: fields[0]}
+		if len(fields) > 2 {
+			key.Comment = strings.Join(fields[2:], " ")
+		}
+		keys = append(keys, key)
 	}
+	return keys
+}
 
-	return nil
+// NewSSHKeyPath returns the path for a new TIT key for a host alias: ~/.ssh/TIT_id_<type>_<alias>
+func NewSSHKeyPath(keyType SSHKeyType, alias string) (string, error) {
+	dir, err := sshDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, fmt.Sprintf("TIT_id_%s_%s", keyType, alias)), nil
 }
 
-// AddKeyToAgent adds the SSH key to ssh-agent
-func AddKeyToAgent() error {
-	home, err := os.UserHomeDir()
+// GenerateSSHKey creates a key pair with ssh-keygen (RSA keys are 4096-bit).
+// A passphrase reaches ssh-keygen through SSH_ASKPASS (see ServeAskpass), never argv.
+func GenerateSSHKey(keyType SSHKeyType, keyPath, email, passphrase string) error {
+	if err := os.MkdirAll(filepath.Dir(keyPath), internal.SSHDirPerms); err != nil {
+		return fmt.Errorf("could not create .ssh directory: %w", err)
+	}
+	if fileExists(keyPath) {
+		return fmt.Errorf("%s already exists: choose it from the existing keys instead", keyPath)
+	}
+
+	args := []string{"-q", "-t", string(keyType), "-C", email, "-f", keyPath}
+	if keyType == SSHKeyRSA {
+		args = append(args, "-b", "4096")
+	}
+	if passphrase == "" {
+		args = append(args, "-N", "")
+	}
+	cmd, err := askpassCommand(passphrase, "ssh-keygen", args...)
 	if err != nil {
-		return fmt.Errorf("could not get home directory: %w", err)
+		return err
+	}
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("ssh-keygen failed: %w\n%s", err, strings.TrimSpace(string(output)))
 	}
+	return nil
+}
 
-	keyPath := filepath.Join(home, ".ssh", "TIT_id_rsa")
+// SSHKeyEncrypted reports whether the private key is protected by a passphrase
+func SSHKeyEncrypted(keyPath string) bool {
+	return exec.Command("ssh-keygen", "-y", "-P", "", "-f", keyPath).Run() != nil
+}
 
-	// Start ssh-agent if not running
+// AddKeyToAgent loads the key into ssh-agent, starting an agent if none is running.
+// passphrase unlocks an encrypted key; it is passed through SSH_ASKPASS.
+func AddKeyToAgent(keyPath, passphrase string) error {
+	if passphrase == "" && SSHKeyEncrypted(keyPath) {
+		return errors.New("key is passphrase-protected and no passphrase was given")
+	}
 	if !isSSHAgentRunning() {
 		if err := startSSHAgent(); err != nil {
 			return fmt.Errorf("could not start ssh-agent: %w", err)
 		}
 	}
 
-	// Add key to agent: ssh-add keyPath
-	cmd := exec.Command("ssh-add", keyPath)
-	if err := cmd.Run(); err != nil {
-		// ssh-add may fail if agent is not running or key already added
-		// This is not a critical error
-		return nil
+	cmd, err := askpassCommand(passphrase, "ssh-add", keyPath)
+	if err != nil {
+		return err
+	}
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("ssh-add failed: %s", strings.TrimSpace(string(output)))
 	}
-
 	return nil
 }
 
-// WriteSSHConfig writes SSH configuration
-func WriteSSHConfig() error {
-	home, err := os.UserHomeDir()
+// ServeAskpass answers an SSH_ASKPASS prompt when TIT was started as one by askpassCommand.
+// Returns true when it did; main must then exit without starting the UI.
+func ServeAskpass() bool {
+	secret, ok := os.LookupEnv(askpassSecretEnv)
+	if !ok {
+		return false
+	}
+	fmt.Println(secret)
+	return true
+}
+
+// askpassCommand builds an ssh tool command that reads secret from TIT re-executed as
+// SSH_ASKPASS. SSH_ASKPASS_REQUIRE=force (OpenSSH 8.4+) keeps the tool off the terminal,
+// which belongs to the TUI. Without a secret the command is returned unchanged.
+func askpassCommand(secret, name string, args ...string) (*exec.Cmd, error) {
+	cmd := exec.Command(name, args...)
+	if secret == "" {
+		return cmd, nil
+	}
+	self, err := os.Executable()
 	if err != nil {
-		return fmt.Errorf("could not get home directory: %w", err)
+		return nil, fmt.Errorf("could not locate TIT for SSH_ASKPASS: %w", err)
+	}
+	cmd.Env = append(os.Environ(),
+		"SSH_ASKPASS="+self,
+		"SSH_ASKPASS_REQUIRE=force",
+		askpassSecretEnv+"="+secret,
+	)
+	if os.Getenv("DISPLAY") == "" {
+		cmd.Env = append(cmd.Env, "DISPLAY=:0") // OpenSSH before 8.4 only uses askpass with DISPLAY set
 	}
+	return cmd, nil
+}
 
-	sshDir := filepath.Join(home, ".ssh")
-	configPath := filepath.Join(sshDir, "config")
+// ========================================
+// ~/.ssh/config host entries
+// ========================================
 
-	// Check if config already has the required settings
-	if configHasRequiredSettings(configPath) {
-		return nil
+// SSHHost is a Host block of ~/.ssh/config written by the setup wizard.
+// A second account on the same provider uses its own alias (github.com-work)
+// in remote URLs: git@github.com-work:owner/repo.git
+type SSHHost struct {
+	Alias        string // Host pattern, used in remote URLs
+	HostName     string // Real host name
+	IdentityFile string // Private key
+}
+
+// UpsertSSHHost writes the Host block for h.Alias to ~/.ssh/config, replacing an existing one
+func UpsertSSHHost(h SSHHost) error {
+	dir, err := sshDir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, internal.SSHDirPerms); err != nil {
+		return fmt.Errorf("could not create .ssh directory: %w", err)
 	}
+	configPath := filepath.Join(dir, "config")
 
-	// Append configuration
-	configContent := `Host *
-  AddKeysToAgent yes
-  IdentityFile ~/.ssh/TIT_id_rsa
-  IdentitiesOnly yes
-`
+	current, err := os.ReadFile(configPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("could not read SSH config: %w", err)
+	}
 
-	f, err := os.OpenFile(configPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, internal.ConfigFilePerms)
-	if err != nil {
-		return fmt.Errorf("could not open SSH config: %w", err)
+	if home, err := os.UserHomeDir(); err == nil {
+		if rel, err := filepath.Rel(home, h.IdentityFile); err == nil && !strings.HasPrefix(rel, "..") {
+			h.IdentityFile = "~/" + filepath.ToSlash(rel)
+		}
 	}
-	defer f.Close()
 
-	if _, err := f.WriteString(configContent); err != nil {
+	updated := upsertSSHHostBlock(string(current), h)
+	if err := os.WriteFile(configPath, []byte(updated), internal.ConfigFilePerms); err != nil {
 		return fmt.Errorf("could not write SSH config: %w", err)
 	}
-
 	return nil
 }
 
-// GetPublicKey returns the public key content
-func GetPublicKey() (string, error) {
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return "", fmt.Errorf("could not get home directory: %w", err)
+// upsertSSHHostBlock replaces the block whose Host line names exactly h.Alias, or inserts one.
+// New blocks go before the first "Host *": ssh takes the first value it finds, and an
+// IdentitiesOnly/IdentityFile under "Host *" would otherwise win for every account.
+func upsertSSHHostBlock(config string, h SSHHost) string {
+	block := []string{
+		"Host " + h.Alias,
+		"  HostName " + h.HostName,
+		"  User git",
+		"  IdentityFile " + h.IdentityFile,
+		"  IdentitiesOnly yes",
+		"  AddKeysToAgent yes",
+		"",
+	}
+
+	lines := strings.Split(strings.TrimRight(config, "\n"), "\n")
+	if config == "" {
+		lines = nil
 	}
 
-	pubKeyPath := filepath.Join(home, ".ssh", "TIT_id_rsa.pub")
+	start, end, wildcard := -1, len(lines), -1
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		keyword := strings.ToLower(fields[0])
+		if keyword != "host" && keyword != "match" {
+			continue
+		}
+		if start >= 0 {
+			end = i
+			break
+		}
+		if keyword == "host" && len(fields) == 2 && fields[1] == h.Alias {
+			start = i
+		} else if keyword == "host" && len(fields) == 2 && fields[1] == "*" && wildcard < 0 {
+			wildcard = i
+		}
+	}
+
+	var out []string
+	switch {
+	case start >= 0:
+		// Keep blank lines that separated the old block from the next one
+		for end > start && strings.TrimSpace(lines[end-1]) == "" {
+			end--
+		}
+		out = append(out, lines[:start]...)
+		out = append(out, block[:len(block)-1]...)
+		out = append(out, lines[end:]...)
+	case wildcard >= 0:
+		out = append(out, lines[:wildcard]...)
+		out = append(out, block...)
+		out = append(out, lines[wildcard:]...)
+	default:
+		out = append(out, lines...)
+		if len(out) > 0 && strings.TrimSpace(out[len(out)-1]) != "" {
+			out = append(out, "")
+		}
+		out = append(out, block[:len(block)-1]...)
+	}
+	return strings.Join(out, "\n") + "\n"
+}
 
-	data, err := os.ReadFile(pubKeyPath)
+// GetPublicKey returns the public key of a private key
+func GetPublicKey(keyPath string) (string, error) {
+	data, err := os.ReadFile(keyPath + ".pub")
 	if err != nil {
 		return "", fmt.Errorf("could not read public key: %w", err)
 	}
-
 	return string(data), nil
 }
 
+// ========================================
+// End-to-end verification
+// ========================================
+
+// SSHVerifyTimeout bounds the `ssh -T` check
+const SSHVerifyTimeout = 20 * time.Second
+
+// SSHVerifyResult is the outcome of VerifySSH
+type SSHVerifyResult struct {
+	OK      bool
+	Problem AuthProblem // Diagnosis when not OK (AuthNone if unrecognized)
+	Message string      // The host's greeting, or ssh's error
+}
+
+// VerifySSH runs `ssh -T git@<alias>` the way git would connect.
+// Git hosts refuse a shell but greet authenticated users, exiting with their own code;
+// ssh itself exits 255 when it cannot connect or authenticate.
+// An unknown host key is accepted on first use (StrictHostKeyChecking=accept-new),
+// the same as answering "yes" to ssh's prompt; a changed key still fails.
+func VerifySSH(ctx context.Context, alias string) SSHVerifyResult {
+	ctx, cancel := context.WithTimeout(ctx, SSHVerifyTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "ssh", "-T",
+		"-o", "BatchMode=yes",
+		"-o", "StrictHostKeyChecking=accept-new",
+		"-o", fmt.Sprintf("ConnectTimeout=%d", int(SSHVerifyTimeout/time.Second)),
+		"git@"+alias)
+	output, err := cmd.CombinedOutput()
+	if ctx.Err() == context.DeadlineExceeded {
+		return SSHVerifyResult{Message: fmt.Sprintf("no answer within %s", SSHVerifyTimeout)}
+	}
+
+	exitCode := 0
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		exitCode = exitErr.ExitCode()
+	} else if err != nil {
+		return SSHVerifyResult{Message: err.Error()}
+	}
+	return sshVerifyResult(string(output), exitCode)
+}
+
+// sshVerifyResult classifies `ssh -T` output by its exit code
+func sshVerifyResult(output string, exitCode int) SSHVerifyResult {
+	output = strings.TrimSpace(output)
+	if exitCode != 255 {
+		return SSHVerifyResult{OK: true, Message: lastLine(output)}
+	}
+	problem, line := DiagnoseAuth(output)
+	if line == "" {
+		line = lastLine(output)
+	}
+	return SSHVerifyResult{Problem: problem, Message: line}
+}
+
+// lastLine returns the last non-empty line (ssh prints warnings before the answer)
+func lastLine(s string) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
+
 // isSSHAgentRunning checks if ssh-agent is running
+// `ssh-add -l` exits 1 for an agent without keys and 2 when no agent answers
 func isSSHAgentRunning() bool {
-	cmd := exec.Command("ssh-add", "-l")
-	return cmd.Run() == nil
+	err := exec.Command("ssh-add", "-l").Run()
+	var exitErr *exec.ExitError
+	return err == nil || errors.As(err, &exitErr) && exitErr.ExitCode() == 1
 }
 
 // startSSHAgent starts ssh-agent
@@ -142,24 +388,11 @@ func startSSHAgent() error {
 			// Set environment variable
 			parts := strings.SplitN(line, "=", 2)
 			if len(parts) == 2 {
-				os.Setenv(parts[0], parts[1])
+				value, _, _ := strings.Cut(parts[1], ";") // "SSH_AUTH_SOCK=/tmp/...; export SSH_AUTH_SOCK;"
+				os.Setenv(parts[0], value)
 			}
 		}
 	}
 
 	return nil
 }
-
-// configHasRequiredSettings checks if SSH config already has the required settings
-func configHasRequiredSettings(configPath string) bool {
-	data, err := os.ReadFile(configPath)
-	if err != nil {
-		return false
-	}
-
-	configContent := string(data)
-
-	// Check if config contains the key settings
-	return strings.Contains(configContent, "AddKeysToAgent yes") &&
-		strings.Contains(configContent, "IdentityFile ~/.ssh/TIT_id_rsa")
-}
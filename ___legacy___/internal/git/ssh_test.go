@@ -0,0 +1,124 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestMain lets the test binary stand in for TIT as SSH_ASKPASS (see askpassCommand)
+func TestMain(m *testing.M) {
+	if ServeAskpass() {
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+func TestUpsertSSHHostBlock(t *testing.T) {
+	work := SSHHost{Alias: "github.com-work", HostName: "github.com", IdentityFile: "~/.ssh/TIT_id_ed25519_github.com-work"}
+	block := "Host github.com-work\n  HostName github.com\n  User git\n  IdentityFile ~/.ssh/TIT_id_ed25519_github.com-work\n  IdentitiesOnly yes\n  AddKeysToAgent yes\n"
+
+	tests := []struct {
+		name   string
+		config string
+		want   string
+	}{
+		{
+			name:   "empty config",
+			config: "",
+			want:   block,
+		},
+		{
+			name:   "appended after other hosts",
+			config: "Host example\n  User me\n",
+			want:   "Host example\n  User me\n\n" + block,
+		},
+		{
+			name:   "inserted before Host *",
+			config: "Host example\n  User me\n\nHost *\n  IdentityFile ~/.ssh/TIT_id_rsa\n",
+			want:   "Host example\n  User me\n\n" + block + "\nHost *\n  IdentityFile ~/.ssh/TIT_id_rsa\n",
+		},
+		{
+			name:   "existing block replaced in place",
+			config: "Host github.com-work\n  IdentityFile ~/.ssh/old\n\nHost example\n  User me\n",
+			want:   block + "\nHost example\n  User me\n",
+		},
+		{
+			name:   "alias inside a pattern list is not replaced",
+			config: "Host github.com-work gitlab.com\n  User me\n",
+			want:   "Host github.com-work gitlab.com\n  User me\n\n" + block,
+		},
+	}
+	for _, tc := range tests {
+		if got := upsertSSHHostBlock(tc.config, work); got != tc.want {
+			t.Errorf("%s:\ngot:\n%s\nwant:\n%s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestSSHVerifyResult(t *testing.T) {
+	tests := []struct {
+		name     string
+		output   string
+		exitCode int
+		want     SSHVerifyResult
+	}{
+		{
+			name:     "github greeting",
+			output:   "Warning: Permanently added 'github.com' (ED25519) to the list of known hosts.\nHi octo! You've successfully authenticated, but GitHub does not provide shell access.",
+			exitCode: 1,
+			want:     SSHVerifyResult{OK: true, Message: "Hi octo! You've successfully authenticated, but GitHub does not provide shell access."},
+		},
+		{
+			name:     "key rejected",
+			output:   "git@github.com: Permission denied (publickey).",
+			exitCode: 255,
+			want:     SSHVerifyResult{Problem: AuthPublicKeyDenied, Message: "git@github.com: Permission denied (publickey)."},
+		},
+		{
+			name:     "dns failure",
+			output:   "ssh: Could not resolve hostname github.con: Name or service not known",
+			exitCode: 255,
+			want:     SSHVerifyResult{Message: "ssh: Could not resolve hostname github.con: Name or service not known"},
+		},
+	}
+	for _, tc := range tests {
+		if got := sshVerifyResult(tc.output, tc.exitCode); got != tc.want {
+			t.Errorf("%s: sshVerifyResult() = %+v, want %+v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestGenerateSSHKeyPassphrase(t *testing.T) {
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		t.Skip("ssh-keygen not installed")
+	}
+	t.Setenv("HOME", t.TempDir())
+
+	keyPath, err := NewSSHKeyPath(SSHKeyEd25519, "github.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := GenerateSSHKey(SSHKeyEd25519, keyPath, "me@example.com", "correct horse"); err != nil {
+		t.Fatalf("GenerateSSHKey: %v", err)
+	}
+	if !SSHKeyEncrypted(keyPath) {
+		t.Error("key generated with a passphrase is not encrypted")
+	}
+	if err := exec.Command("ssh-keygen", "-y", "-P", "correct horse", "-f", keyPath).Run(); err != nil {
+		t.Errorf("passphrase does not unlock the key: %v", err)
+	}
+	if err := GenerateSSHKey(SSHKeyEd25519, keyPath, "me@example.com", ""); err == nil {
+		t.Error("existing key overwritten")
+	}
+
+	keys := FindSSHKeys()
+	want := SSHKey{Path: keyPath, Type: "ssh-ed25519", Comment: "me@example.com"}
+	if len(keys) != 1 || keys[0] != want {
+		t.Errorf("FindSSHKeys() = %+v, want [%+v]", keys, want)
+	}
+	if filepath.Base(keyPath) != "TIT_id_ed25519_github.com" {
+		t.Errorf("key name: got %s", filepath.Base(keyPath))
+	}
+}
@@ -2,9 +2,7 @@ package git
 
 import (
 	"os"
-	"path/filepath"
 	"strings"
-	"github.com/jrengmusic/tit/internal"
 )
 
 // DetectState performs comprehensive 5-axis git state detection for the current repository.
@@ -17,8 +15,11 @@ import (
 // 4. Remote State: Remote repository configuration
 // 5. Environment State: Git installation and configuration
 //
-// This function is called frequently and must be fast. It uses cached results
-// where possible and only executes git commands when necessary.
+// This function is called frequently and must be fast. A single
+// `git status --porcelain=v2 --branch` provides branch, upstream, ahead/behind
+// and file entries; operation markers, remotes and refs are read from .git directly.
+// Only a branch without a fetched upstream costs one extra rev-list, as does the
+// working branch once its tip or canon's moves (counts are cached per tip pair).
 //
 // Returns:
 // - *State: Complete git state representation (always valid, never nil)
@@ -29,65 +30,65 @@ import (
 // CONTRACT: Never returns error - all system-level failures use graceful fallbacks.
 
 func DetectState() (*State, error) {
+	return currentRepo.detectState()
+}
+
+// DetectStateIn is DetectState for the repository at dir, leaving the working directory alone.
+// Safe to call concurrently for different repositories.
+func DetectStateIn(dir string) (*State, error) {
+	return repoDir(dir).detectState()
+}
+
+// detectState implements DetectState for the repository rooted at d
+func (d repoDir) detectState() (*State, error) {
 	state := &State{}
 
 	// PRIORITY CHECK: DirtyOperation trumps everything except NotRepo
-	isRepo, _ := IsInitializedRepo()
-	if !isRepo {
+	if _, err := os.Stat(d.gitPath()); err != nil {
 		return &State{Operation: NotRepo}, nil
 	}
 
 	// Check for dirty operation in progress (PRIORITY 1: Before time travel check)
-	if IsDirtyOperationActive() {
+	if d.isDirtyOperationActive() {
 		return &State{
 			Operation: DirtyOperation,
 		}, nil
 	}
 
-	// Detect LFS usage (cheap file read + one subprocess if LFS present)
-	state.LFS = IsRepoLFS()
+	// Detect LFS usage (cheap file read; filter registration is cached)
+	state.LFS = d.isRepoLFS()
 	if state.LFS {
 		state.LFSReady = IsLFSInstalled()
 	}
 
-	// Check if repo has any commits yet
-	hash, err := executeGitCommand("rev-parse", "HEAD")
-	hasCommits := err == nil && hash != ""
+	// Detect shallow history and sparse checkout (file reads, no subprocess)
+	state.Shallow = d.isShallow()
+	state.Sparse = d.readConfigBool("core", "sparsecheckout")
 
-	// Detect working tree state (always applicable)
-	// Graceful fallback: assume Clean if git status fails
-	workingTree, modifiedCount, err := detectWorkingTree()
+	// ONE subprocess: branch, upstream, ahead/behind and file entries
+	// Graceful fallback: empty snapshot (Clean, Normal, no commits) if git status fails
+	status, err := d.readStatus()
 	if err != nil {
-		state.WorkingTree = Clean // Default to Clean on system-level failure
-		state.ModifiedCount = 0
-	} else {
-		state.WorkingTree = workingTree
-		state.ModifiedCount = modifiedCount
+		status = &statusSnapshot{}
 	}
+	hasCommits := status.hasCommits()
+
+	// Detect working tree state (always applicable)
+	state.WorkingTree, state.ModifiedCount = detectWorkingTree(status)
+	state.Changes = status.Changes
+	state.Entries = status.Entries
 
 	// Detect operation state (determines if timeline is applicable)
-	// Graceful fallback: assume Normal if detection fails
-	operation, err := detectOperation()
-	if err != nil {
-		state.Operation = Normal // Default to Normal on system-level failure
-	} else {
-		state.Operation = operation
-	}
+	state.Operation = d.detectOperation(status)
 
 	// Detect remote presence (determines if timeline is applicable)
-	// Graceful fallback: assume NoRemote if detection fails
-	remote, err := detectRemote()
-	if err != nil {
-		state.Remote = NoRemote // Default to NoRemote on system-level failure
-	} else {
-		state.Remote = remote
-	}
+	state.Remote = d.detectRemote()
 
 	// Detect timeline state (CONDITIONAL: only when on branch with tracking)
 	// Timeline = comparison between local vs remote tracking branch
 	// Not applicable when: Operation != Normal OR Remote = NoRemote
 	if state.Operation == Normal && state.Remote == HasRemote && hasCommits {
-		timeline, ahead, behind, err := detectTimeline()
+		timeline, ahead, behind, err := d.detectTimeline(status)
 		if err != nil {
 			// Graceful fallback: assume InSync if timeline detection fails
 			state.Timeline = InSync
@@ -105,25 +106,28 @@ func DetectState() (*State, error) {
 		state.CommitsBehind = 0
 	}
 
-	// Get current commit hash FIRST (needed for detached HEAD display)
-	hash, _ = executeGitCommand("rev-parse", "--short", "HEAD") // error ignored: empty hash is valid fallback for detached HEAD
-	if hash != "" {
-		state.CurrentHash = hash
+	// Current commit hash (needed for detached HEAD display)
+	if hasCommits {
+		state.CurrentHash = ShortenHash(status.Oid)
 	}
 
-	// Get current branch
-	// Use symbolic-ref for branch name (works even with zero commits)
-	branch, err := executeGitCommand("symbolic-ref", "--short", "HEAD")
-	if err != nil {
-		// symbolic-ref fails when HEAD is detached
+	// Current branch (branch.head is reported even with zero commits)
+	if state.Operation == Bisecting {
+		// Bisect: show the branch the session returns to, keep the bisect menu
+		state.Bisect = d.readBisect()
+		state.Detached = status.detached()
+		state.CurrentBranch = state.Bisect.OriginalBranch
+		if state.CurrentBranch == "" {
+			state.CurrentBranch = status.Head
+		}
+	} else if status.detached() {
 		state.Detached = true
 
 		// Check if this is TIT-initiated time travel
-		gitDir := internal.GitDirectoryName
-		if _, statErr := os.Stat(filepath.Join(gitDir, "TIT_TIME_TRAVEL")); statErr == nil {
+		if _, statErr := os.Stat(d.gitPath("TIT_TIME_TRAVEL")); statErr == nil {
 			state.IsTitTimeTravel = true
 			// TIT time travel: get original branch from marker for display
-			if data, err := os.ReadFile(filepath.Join(gitDir, "TIT_TIME_TRAVEL")); err == nil {
+			if data, err := os.ReadFile(d.gitPath("TIT_TIME_TRAVEL")); err == nil {
 				lines := strings.Split(strings.TrimSpace(string(data)), "\n")
 				if len(lines) > 0 && lines[0] != "" {
 					state.CurrentBranch = lines[0] // Show original branch name
@@ -142,17 +146,20 @@ func DetectState() (*State, error) {
 		// Menu shows browse history + return, regardless of cause
 		state.Operation = TimeTraveling
 	} else {
-		state.CurrentBranch = branch
+		state.CurrentBranch = status.Head
 	}
 
-	if state.Remote == HasRemote {
-		remoteHash, err := executeGitCommand("rev-parse", "@{u}")
-		if err != nil {
-			// Remote tracking not set up yet (expected for new branches)
-			remoteHash = ""
-		}
-		state.RemoteHash = remoteHash
-		state.LocalBranchOnRemote = CurrentBranchExistsOnRemote()
+	// Canon/working policy (file read); divergence only while on the working branch
+	workflow := d.readWorkflow()
+	state.Canon, state.Working = workflow.Canon, workflow.Working
+	if workflow.Configured() && !state.Detached && state.CurrentBranch == workflow.Working {
+		state.WorkingAhead, state.WorkingBehind = d.workflowDivergence(workflow)
+	}
+
+	if state.Remote == HasRemote && status.Upstream != "" {
+		// Upstream ref read from .git (empty if gone or not fetched yet)
+		state.RemoteHash = d.resolveUpstream(status.Upstream)
+		state.LocalBranchOnRemote = state.RemoteHash != ""
 	}
 
 	return state, nil
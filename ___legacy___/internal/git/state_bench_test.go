@@ -0,0 +1,236 @@
+package git
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// countingBackend wraps a Backend and counts spawned git processes
+type countingBackend struct {
+	inner Backend
+	calls atomic.Int64
+}
+
+func (c *countingBackend) Run(args ...string) CommandResult {
+	c.calls.Add(1)
+	return c.inner.Run(args...)
+}
+
+func (c *countingBackend) RunContext(ctx context.Context, args ...string) CommandResult {
+	c.calls.Add(1)
+	return c.inner.RunContext(ctx, args...)
+}
+
+func (c *countingBackend) RunRaw(args ...string) CommandResult {
+	c.calls.Add(1)
+	return c.inner.RunRaw(args...)
+}
+
+func (c *countingBackend) Stream(ctx context.Context, args ...string) CommandResult {
+	c.calls.Add(1)
+	return c.inner.Stream(ctx, args...)
+}
+
+// setupBenchRepo builds a repo with an upstream, a dirty tree and LFS attributes,
+// then changes into it for the rest of the benchmark
+func setupBenchRepo(b *testing.B) {
+	b.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		b.Skip("git not installed")
+	}
+	root := b.TempDir()
+	b.Setenv("HOME", root)
+	b.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+	b.Setenv("GIT_AUTHOR_NAME", "Bench")
+	b.Setenv("GIT_AUTHOR_EMAIL", "bench@example.com")
+	b.Setenv("GIT_COMMITTER_NAME", "Bench")
+	b.Setenv("GIT_COMMITTER_EMAIL", "bench@example.com")
+
+	work := filepath.Join(root, "work")
+	remote := filepath.Join(root, "remote.git")
+	run := func(dir string, args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			b.Fatalf("git %s: %v\n%s", strings.Join(args, " "), err, out)
+		}
+	}
+
+	run(root, "init", "-q", "--bare", "-b", "main", remote)
+	run(root, "init", "-q", "-b", "main", work)
+	for i := 0; i < 50; i++ {
+		name := filepath.Join(work, "file"+strconv.Itoa(i)+".txt")
+		if err := os.WriteFile(name, []byte("content\n"), 0644); err != nil {
+			b.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(work, ".gitattributes"), []byte("*.bin filter=lfs\n"), 0644); err != nil {
+		b.Fatal(err)
+	}
+	run(work, "add", ".")
+	run(work, "commit", "-q", "-m", "initial")
+	run(work, "remote", "add", "origin", remote)
+	run(work, "push", "-q", "-u", "origin", "main")
+	run(work, "commit", "-q", "--allow-empty", "-m", "local")
+	if err := os.WriteFile(filepath.Join(work, "file0.txt"), []byte("changed\n"), 0644); err != nil {
+		b.Fatal(err)
+	}
+
+	b.Chdir(work)
+}
+
+// BenchmarkDetectState compares batched detection against the previous
+// one-subprocess-per-question implementation (detectStateMultiProcess).
+// Reports git-calls/op alongside ns/op.
+func BenchmarkDetectState(b *testing.B) {
+	setupBenchRepo(b)
+
+	impls := []struct {
+		name   string
+		detect func() (*State, error)
+	}{
+		{"batched", DetectState},
+		{"multiprocess", detectStateMultiProcess},
+	}
+
+	for _, impl := range impls {
+		b.Run(impl.name, func(b *testing.B) {
+			counter := &countingBackend{inner: NewExecBackend()}
+			SetBackend(counter)
+			b.Cleanup(func() { SetBackend(nil) })
+			resetLFSFilterCache()
+
+			want, _ := detectStateMultiProcess()
+			got, _ := impl.detect()
+			if got.WorkingTree != want.WorkingTree || got.Timeline != want.Timeline ||
+				got.Operation != want.Operation || got.Remote != want.Remote ||
+				got.CurrentBranch != want.CurrentBranch || got.CurrentHash != want.CurrentHash {
+				b.Fatalf("state mismatch: got %+v, want %+v", *got, *want)
+			}
+
+			counter.calls.Store(0)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				impl.detect()
+			}
+			b.StopTimer()
+			b.ReportMetric(float64(counter.calls.Load())/float64(b.N), "git-calls/op")
+		})
+	}
+}
+
+// resetLFSFilterCache forgets the cached filter lookup so both runs start cold
+func resetLFSFilterCache() {
+	lfsFiltersMutex.Lock()
+	lfsFiltersRegistered = false
+	lfsFiltersMutex.Unlock()
+}
+
+// detectStateMultiProcess is the detection pipeline before batching: every
+// question is answered by its own git subprocess. Kept for benchmarking only.
+func detectStateMultiProcess() (*State, error) {
+	state := &State{}
+
+	if isRepo, _ := IsInitializedRepo(); !isRepo {
+		return &State{Operation: NotRepo}, nil
+	}
+	if IsDirtyOperationActive() {
+		return &State{Operation: DirtyOperation}, nil
+	}
+
+	state.LFS = IsRepoLFS()
+	if state.LFS {
+		_, pathErr := exec.LookPath("git-lfs")
+		result := Execute("config", "--get", "filter.lfs.process")
+		state.LFSReady = pathErr == nil && result.Success && result.Stdout != ""
+	}
+
+	hash, err := executeGitCommand("rev-parse", "HEAD")
+	hasCommits := err == nil && hash != ""
+
+	status, _ := executeGitCommand("status", "--porcelain=v2")
+	for _, line := range strings.Split(status, "\n") {
+		if line != "" && (line[0] == '1' || line[0] == '2' || line[0] == '?') {
+			state.ModifiedCount++
+		}
+	}
+	state.WorkingTree = Clean
+	if state.ModifiedCount > 0 {
+		state.WorkingTree = Dirty
+	}
+
+	state.Operation = Normal
+	status, _ = executeGitCommand("status", "--porcelain=v2")
+	conflicted := false
+	for _, line := range strings.Split(status, "\n") {
+		if strings.HasPrefix(line, "u ") {
+			conflicted = true
+		}
+	}
+	if _, err := os.Stat(filepath.Join(".git", "TIT_TIME_TRAVEL")); err == nil && !conflicted {
+		if _, err := executeGitCommand("symbolic-ref", "--short", "HEAD"); err != nil {
+			state.Operation = TimeTraveling
+		}
+	}
+	if conflicted {
+		state.Operation = Conflicted
+	} else if FileExists(filepath.Join(".git", "MERGE_HEAD")) {
+		state.Operation = Merging
+	} else if FileExists(filepath.Join(".git", "rebase-merge")) || FileExists(filepath.Join(".git", "rebase-apply")) {
+		state.Operation = Rebasing
+	}
+
+	state.Remote = NoRemote
+	if remotes, err := executeGitCommand("remote"); err == nil && remotes != "" {
+		state.Remote = HasRemote
+	}
+
+	if state.Operation == Normal && state.Remote == HasRemote && hasCommits {
+		if Execute("rev-parse", "@{u}").Success {
+			counts, _ := executeGitCommand("rev-list", "--left-right", "--count", "HEAD...@{u}")
+			if parts := strings.Fields(counts); len(parts) == 2 {
+				state.CommitsAhead, _ = strconv.Atoi(parts[0])
+				state.CommitsBehind, _ = strconv.Atoi(parts[1])
+			}
+		} else {
+			branch, _ := executeGitCommand("symbolic-ref", "--short", "HEAD")
+			remoteBranch := "refs/remotes/origin/" + branch
+			if Execute("rev-parse", remoteBranch).Success {
+				counts, _ := executeGitCommand("rev-list", "--left-right", "--count", "HEAD..."+remoteBranch)
+				if parts := strings.Fields(counts); len(parts) == 2 {
+					state.CommitsAhead, _ = strconv.Atoi(parts[0])
+					state.CommitsBehind, _ = strconv.Atoi(parts[1])
+				}
+			} else {
+				count, _ := executeGitCommand("rev-list", "--count", "HEAD")
+				state.CommitsAhead, _ = strconv.Atoi(count)
+			}
+		}
+		state.Timeline = determineTimeline(state.CommitsAhead, state.CommitsBehind)
+	}
+
+	state.CurrentHash, _ = executeGitCommand("rev-parse", "--short", "HEAD")
+
+	if branch, err := executeGitCommand("symbolic-ref", "--short", "HEAD"); err != nil {
+		state.Detached = true
+		state.CurrentBranch = "DETACHED"
+		state.Operation = TimeTraveling
+	} else {
+		state.CurrentBranch = branch
+	}
+
+	if state.Remote == HasRemote {
+		state.RemoteHash, _ = executeGitCommand("rev-parse", "@{u}")
+		if branch, err := executeGitCommand("rev-parse", "--abbrev-ref", "HEAD"); err == nil {
+			state.LocalBranchOnRemote = Execute("config", "--get", "branch."+branch+".remote").Success
+		}
+	}
+
+	return state, nil
+}
@@ -9,6 +9,7 @@ package git
 // - Remote presence changes (affects push/pull options)
 // - Timeline changes EXCEPT within same state (Ahead(n)->Ahead(m) doesn't change menu)
 // - CurrentBranch changes (different branch = different state)
+// - Canon/working policy changes, or working starts/stops being ahead of or behind canon
 //
 // Menu stays same when:
 // - Ahead commits increase/decrease (Ahead(1)->Ahead(2) shows same menu)
@@ -47,5 +48,13 @@ func CompareStates(old, new *State) bool {
 		return true
 	}
 
+	// Deliver/update items depend on the policy and on divergence, not its size
+	if old.Canon != new.Canon || old.Working != new.Working {
+		return true
+	}
+	if (old.WorkingAhead > 0) != (new.WorkingAhead > 0) || (old.WorkingBehind > 0) != (new.WorkingBehind > 0) {
+		return true
+	}
+
 	return false
 }
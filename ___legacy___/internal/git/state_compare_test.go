@@ -73,6 +73,25 @@ func TestCompareStates(t *testing.T) {
 			new:  func() *State { s := base(); s.Timeline = Behind; s.CommitsBehind = 2; return s }(),
 			want: false,
 		},
+		{
+			name: "working branch configured",
+			old:  base(),
+			new:  func() *State { s := base(); s.Canon = "main"; s.Working = "dev"; return s }(),
+			want: true,
+		},
+		{
+			name: "working falls behind canon",
+			old:  base(),
+			new:  func() *State { s := base(); s.WorkingBehind = 1; return s }(),
+			want: true,
+		},
+		{
+			// Only the presence of divergence shows/hides deliver and update
+			name: "working ahead of canon 1->3",
+			old:  func() *State { s := base(); s.WorkingAhead = 1; return s }(),
+			new:  func() *State { s := base(); s.WorkingAhead = 3; return s }(),
+			want: false,
+		},
 		{
 			// CurrentHash is not compared by CompareStates — menu is unaffected.
 			name: "only CurrentHash changes",
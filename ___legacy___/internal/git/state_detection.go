@@ -2,125 +2,194 @@ package git
 
 import (
 	"os"
-	"os/exec"
-	"path/filepath"
 	"strconv"
 	"strings"
-	"github.com/jrengmusic/tit/internal"
 )
 
-// detectWorkingTree checks for staged/unstaged changes or untracked files
-// Returns Clean as fallback if git status fails (system-level issue)
-func detectWorkingTree() (WorkingTree, int, error) {
-	cmd := exec.Command("git", "status", "--porcelain=v2")
-	output, err := cmd.Output()
-	if err != nil {
-		return Clean, 0, nil // Graceful fallback: assume Clean on system-level failure
-	}
+// Values git prints in `# branch.*` headers when there is no commit or branch
+const (
+	statusInitialOid   = "(initial)"
+	statusDetachedHead = "(detached)"
+)
+
+// statusSnapshot is everything `git status --porcelain=v2 --branch` reports in one call
+type statusSnapshot struct {
+	Oid            string // Full HEAD hash, or "(initial)" before the first commit
+	Head           string // Branch name, or "(detached)"
+	Upstream       string // Upstream short name (e.g. "origin/main"), empty if not configured
+	HasAheadBehind bool   // True when git could compare against the upstream ref
+	Ahead          int
+	Behind         int
+	Changes        ChangeCounts
+	Entries        []StatusEntry // Changed, renamed, unmerged and untracked entries
+}
+
+// hasCommits reports whether HEAD points at a commit
+func (s *statusSnapshot) hasCommits() bool {
+	return s.Oid != "" && s.Oid != statusInitialOid
+}
 
-	outputStr := string(output)
+// detached reports whether HEAD is not on a branch
+func (s *statusSnapshot) detached() bool {
+	return s.Head == statusDetachedHead
+}
 
-	if outputStr == "" {
-		return Clean, 0, nil
+// readStatus runs the single git subprocess needed by DetectState
+// -z keeps paths with spaces or non-ASCII characters unquoted
+func (d repoDir) readStatus() (*statusSnapshot, error) {
+	output, err := d.execute("status", "--porcelain=v2", "--branch", "-z")
+	if err != nil {
+		return nil, err
 	}
+	return parseStatus(output), nil
+}
 
-	lines := strings.Split(outputStr, "\n")
-	modifiedCount := 0
+// parseStatus parses NUL-separated porcelain v2 output with branch headers
+func parseStatus(output string) *statusSnapshot {
+	s := &statusSnapshot{}
 
-	for _, line := range lines {
-		if len(line) == 0 {
-			continue
-		}
-		// Skip untracked ignored files (.DS_Store, etc)
-		if line[0] == '!' {
+	records := strings.Split(output, "\x00")
+	for i := 0; i < len(records); i++ {
+		record := records[i]
+		if len(record) == 0 {
 			continue
 		}
-		// Lines starting with '1', '2' (changes) or '?' (untracked) indicate modifications
-		if line[0] == '1' || line[0] == '2' || line[0] == '?' {
-			modifiedCount++
+		switch record[0] {
+		case '#':
+			parseStatusHeader(s, record)
+		case '1':
+			// 1 XY sub mH mI mW hH hI path
+			if fields := strings.SplitN(record, " ", 9); len(fields) == 9 && len(fields[1]) == 2 {
+				s.addEntry(StatusEntry{Path: fields[8], Index: fields[1][0], WorkTree: fields[1][1]})
+			}
+		case '2':
+			// 2 XY sub mH mI mW hH hI Xscore path, followed by the original path record
+			if fields := strings.SplitN(record, " ", 10); len(fields) == 10 && len(fields[1]) == 2 {
+				entry := StatusEntry{Path: fields[9], Index: fields[1][0], WorkTree: fields[1][1]}
+				if i+1 < len(records) {
+					i++
+					entry.OrigPath = records[i]
+				}
+				s.addEntry(entry)
+			}
+		case 'u':
+			// u XY sub m1 m2 m3 mW h1 h2 h3 path
+			if fields := strings.SplitN(record, " ", 11); len(fields) == 11 && len(fields[1]) == 2 {
+				s.addEntry(StatusEntry{Path: fields[10], Index: fields[1][0], WorkTree: fields[1][1], Conflicted: true})
+			}
+		case '?':
+			s.addEntry(StatusEntry{Path: strings.TrimPrefix(record, "? "), Index: '?', WorkTree: '?', Untracked: true})
 		}
+		// '!' records are ignored files (.DS_Store, etc) - skipped
 	}
 
-	if modifiedCount > 0 {
-		return Dirty, modifiedCount, nil
+	return s
+}
+
+// addEntry records a file entry and updates the per-category counts
+func (s *statusSnapshot) addEntry(e StatusEntry) {
+	s.Entries = append(s.Entries, e)
+
+	switch {
+	case e.Conflicted:
+		s.Changes.Conflicted++
+		return
+	case e.Untracked:
+		s.Changes.Untracked++
+		return
+	}
+
+	if e.IsStaged() {
+		s.Changes.Staged++
+	}
+	if e.IsUnstaged() {
+		s.Changes.Unstaged++
+	}
+	if e.OrigPath != "" {
+		s.Changes.Renamed++
+	}
+	if e.Index == 'D' || e.WorkTree == 'D' {
+		s.Changes.Deleted++
+	}
+}
+
+// parseStatusHeader parses one `# branch.<key> <value>` line into s
+func parseStatusHeader(s *statusSnapshot, line string) {
+	fields := strings.Fields(line)
+	if len(fields) < 3 {
+		return
+	}
+
+	switch fields[1] {
+	case "branch.oid":
+		s.Oid = fields[2]
+	case "branch.head":
+		s.Head = fields[2]
+	case "branch.upstream":
+		s.Upstream = fields[2]
+	case "branch.ab":
+		// Format: "# branch.ab +<ahead> -<behind>"
+		if len(fields) < 4 {
+			return
+		}
+		ahead, aheadErr := strconv.Atoi(strings.TrimPrefix(fields[2], "+"))
+		behind, behindErr := strconv.Atoi(strings.TrimPrefix(fields[3], "-"))
+		if aheadErr != nil || behindErr != nil {
+			return
+		}
+		s.Ahead = ahead
+		s.Behind = behind
+		s.HasAheadBehind = true
 	}
+}
 
-	return Clean, 0, nil
+// detectWorkingTree maps the status snapshot to working tree state and change count
+func detectWorkingTree(s *statusSnapshot) (WorkingTree, int) {
+	if len(s.Entries) > 0 {
+		return Dirty, len(s.Entries)
+	}
+	return Clean, 0
 }
 
 // detectTimeline checks relationship between local and remote branches
-func detectTimeline() (Timeline, int, int, error) {
+func (d repoDir) detectTimeline(s *statusSnapshot) (Timeline, int, int, error) {
 	// PRECONDITION: Only called when Remote = HasRemote (checked by DetectState)
 	// Timeline = comparison between local branch vs remote tracking branch
 
-	// Try to get upstream tracking branch
-	cmd := exec.Command("git", "rev-parse", "@{u}")
-	err := cmd.Run()
-	if err != nil {
-		// No upstream tracking - try to compare with refs/remotes/origin/[current-branch]
-		// Use symbolic-ref first (works in empty repos), fall back to rev-parse
-		currentBranch, err := executeGitCommand("symbolic-ref", "--short", "HEAD")
-		if err != nil {
-			currentBranch, err = executeGitCommand("rev-parse", "--abbrev-ref", "HEAD")
-			if err != nil {
-				return InSync, 0, 0, nil
-			}
-		}
-		if currentBranch == "" || currentBranch == "HEAD" {
-			return InSync, 0, 0, nil
-		}
+	// Upstream tracking with a fetched ref - status already counted commits
+	if s.HasAheadBehind {
+		return determineTimeline(s.Ahead, s.Behind), s.Ahead, s.Behind, nil
+	}
 
-		// Use full ref path to avoid ambiguity
-		remoteBranch := "refs/remotes/origin/" + currentBranch
-		checkRemoteCmd := exec.Command("git", "rev-parse", remoteBranch)
-		err = checkRemoteCmd.Run()
-		if err != nil {
-			// Remote branch doesn't exist yet (never pushed)
-			cmd := exec.Command("git", "rev-list", "--count", "HEAD")
-			output, err := cmd.Output()
-			if err != nil {
-				return InSync, 0, 0, nil
-			}
-			count, err := strconv.Atoi(strings.TrimSpace(string(output)))
-			if err != nil {
-				return InSync, 0, 0, nil
-			}
-			if count > 0 {
-				return Ahead, count, 0, nil
-			}
-			return InSync, 0, 0, nil
-		}
+	// No usable upstream - compare with refs/remotes/origin/[current-branch]
+	if s.Head == "" || s.detached() {
+		return InSync, 0, 0, nil
+	}
 
-		// Remote branch exists - compare HEAD with refs/remotes/origin/[branch]
-		cmd = exec.Command("git", "rev-list", "--left-right", "--count", "HEAD..."+remoteBranch)
-		output, err := cmd.Output()
+	// Use full ref path to avoid ambiguity
+	remoteBranch := "refs/remotes/origin/" + s.Head
+	if d.resolveRef(remoteBranch) == "" {
+		// Remote branch doesn't exist yet (never pushed)
+		output, err := d.execute("rev-list", "--count", "HEAD")
 		if err != nil {
 			return InSync, 0, 0, nil
 		}
-		parts := strings.Fields(strings.TrimSpace(string(output)))
-		if len(parts) != 2 {
-			return InSync, 0, 0, nil
-		}
-
-		ahead, err := strconv.Atoi(parts[0])
+		count, err := strconv.Atoi(output)
 		if err != nil {
 			return InSync, 0, 0, nil
 		}
-		behind, err := strconv.Atoi(parts[1])
-		if err != nil {
-			return InSync, 0, 0, nil
+		if count > 0 {
+			return Ahead, count, 0, nil
 		}
-		return determineTimeline(ahead, behind), ahead, behind, nil
+		return InSync, 0, 0, nil
 	}
 
-	// We have upstream tracking - count commits
-	cmd = exec.Command("git", "rev-list", "--left-right", "--count", "HEAD...@{u}")
-	output, err := cmd.Output()
+	// Remote branch exists - compare HEAD with refs/remotes/origin/[branch]
+	output, err := d.execute("rev-list", "--left-right", "--count", "HEAD..."+remoteBranch)
 	if err != nil {
 		return InSync, 0, 0, nil
 	}
-
-	parts := strings.Fields(strings.TrimSpace(string(output)))
+	parts := strings.Fields(output)
 	if len(parts) != 2 {
 		return InSync, 0, 0, nil
 	}
@@ -133,7 +202,6 @@ func detectTimeline() (Timeline, int, int, error) {
 	if err != nil {
 		return InSync, 0, 0, nil
 	}
-
 	return determineTimeline(ahead, behind), ahead, behind, nil
 }
 
@@ -157,12 +225,11 @@ func determineTimeline(ahead, behind int) Timeline {
 // Bypasses DetectState which short-circuits on DirtyOperation
 // Used by checkForConflicts during dirty operations where TIT_DIRTY_OP exists
 func HasConflicts() bool {
-	cmd := exec.Command("git", "status", "--porcelain=v2")
-	output, err := cmd.Output()
+	output, err := executeGitCommand("status", "--porcelain=v2")
 	if err != nil {
 		return false
 	}
-	for _, line := range strings.Split(string(output), "\n") {
+	for _, line := range strings.Split(output, "\n") {
 		if strings.HasPrefix(line, "u ") {
 			return true
 		}
@@ -171,61 +238,58 @@ func HasConflicts() bool {
 }
 
 // detectOperation checks for merge/rebase/conflict/cherry-pick
-// Returns Normal as fallback if detection fails (system-level issue)
-func detectOperation() (Operation, error) {
+// Uses the status snapshot plus marker files in .git - no subprocess
+func (d repoDir) detectOperation(s *statusSnapshot) Operation {
 	// Priority 1: Check for conflicts FIRST (highest priority)
-	cmd := exec.Command("git", "status", "--porcelain=v2")
-	output, err := cmd.Output()
-	if err != nil {
-		return Normal, nil // Graceful fallback: assume Normal on system-level failure
+	if s.Changes.Conflicted > 0 {
+		return Conflicted
 	}
-	for _, line := range strings.Split(string(output), "\n") {
-		if strings.HasPrefix(line, "u ") {
-			return Conflicted, nil
-		}
+
+	// Priority 2: Check for bisect (HEAD is detached on the commit under test)
+	if d.isBisecting() {
+		return Bisecting
 	}
 
-	// Priority 2: Check for time traveling (TIT-specific)
-	// Cross-check with symbolic-ref: if HEAD is on a branch, the marker is stale
-	gitDir := internal.GitDirectoryName
-	if _, err := os.Stat(filepath.Join(gitDir, "TIT_TIME_TRAVEL")); err == nil {
-		_, symRefErr := executeGitCommand("symbolic-ref", "--short", "HEAD")
-		if symRefErr != nil {
-			// symbolic-ref failed — HEAD is detached, marker is valid
-			return TimeTraveling, nil
+	// Priority 3: Check for time traveling (TIT-specific)
+	// Cross-check with branch.head: if HEAD is on a branch, the marker is stale
+	if _, err := os.Stat(d.gitPath("TIT_TIME_TRAVEL")); err == nil {
+		if s.detached() {
+			// HEAD is detached, marker is valid
+			return TimeTraveling
 		}
-		// symbolic-ref succeeded — HEAD is on a branch, marker is stale; clean up and fall through
-		ClearTimeTravelInfo()
+		// HEAD is on a branch, marker is stale; clean up and fall through
+		os.Remove(d.gitPath("TIT_TIME_TRAVEL"))
 	}
 
-	// Priority 3: Check for ongoing operations
+	// Priority 4: Check for ongoing operations
 	// Check for merge in progress
-	if _, err := os.Stat(filepath.Join(gitDir, "MERGE_HEAD")); err == nil {
-		return Merging, nil
+	if _, err := os.Stat(d.gitPath("MERGE_HEAD")); err == nil {
+		return Merging
 	}
 
 	// Check for rebase in progress
-	if _, err := os.Stat(filepath.Join(gitDir, "rebase-merge")); err == nil {
-		return Rebasing, nil
+	if _, err := os.Stat(d.gitPath("rebase-merge")); err == nil {
+		return Rebasing
 	}
-	if _, err := os.Stat(filepath.Join(gitDir, "rebase-apply")); err == nil {
-		return Rebasing, nil
+	if _, err := os.Stat(d.gitPath("rebase-apply")); err == nil {
+		return Rebasing
 	}
 
-	return Normal, nil
+	return Normal
 }
 
-// detectRemote checks if remote exists
-// Returns NoRemote as fallback if detection fails (system-level issue)
-func detectRemote() (Remote, error) {
-	cmd := exec.Command("git", "remote")
-	output, err := cmd.Output()
+// detectRemote checks if a remote is configured by reading .git/config
+// Returns NoRemote as fallback if the config cannot be read
+func (d repoDir) detectRemote() Remote {
+	data, err := os.ReadFile(d.gitPath("config"))
 	if err != nil {
-		return NoRemote, nil // Graceful fallback: assume NoRemote on system-level failure
+		return NoRemote // Graceful fallback: assume NoRemote on system-level failure
 	}
 
-	if strings.TrimSpace(string(output)) == "" {
-		return NoRemote, nil
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "[remote \"") {
+			return HasRemote
+		}
 	}
-	return HasRemote, nil
+	return NoRemote
 }
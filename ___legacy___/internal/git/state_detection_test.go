@@ -1,6 +1,12 @@
 package git
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
 
 func TestDetermineTimeline(t *testing.T) {
 	tests := []struct {
@@ -24,3 +30,174 @@ func TestDetermineTimeline(t *testing.T) {
 		}
 	}
 }
+
+// useFakeBackend installs a fake backend for the duration of the test
+func useFakeBackend(t *testing.T) *FakeBackend {
+	t.Helper()
+	fake := NewFakeBackend()
+	SetBackend(fake)
+	t.Cleanup(func() { SetBackend(nil) })
+	return fake
+}
+
+func TestParseStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		records []string
+		want    statusSnapshot
+	}{
+		{"empty repo", []string{"# branch.oid (initial)", "# branch.head main"},
+			statusSnapshot{Oid: "(initial)", Head: "main"}},
+		{"clean with upstream", []string{"# branch.oid abc123", "# branch.head main", "# branch.upstream origin/main", "# branch.ab +2 -3"},
+			statusSnapshot{Oid: "abc123", Head: "main", Upstream: "origin/main", HasAheadBehind: true, Ahead: 2, Behind: 3}},
+		{"upstream gone", []string{"# branch.oid abc123", "# branch.head main", "# branch.upstream origin/main"},
+			statusSnapshot{Oid: "abc123", Head: "main", Upstream: "origin/main"}},
+		{"detached dirty", []string{"# branch.oid abc123", "# branch.head (detached)",
+			"1 .M N... 100644 100644 100644 a a my notes.md", "? new file.txt", "! .DS_Store"},
+			statusSnapshot{Oid: "abc123", Head: "(detached)",
+				Changes: ChangeCounts{Unstaged: 1, Untracked: 1},
+				Entries: []StatusEntry{
+					{Path: "my notes.md", Index: '.', WorkTree: 'M'},
+					{Path: "new file.txt", Index: '?', WorkTree: '?', Untracked: true},
+				}}},
+		{"staged, renamed and deleted", []string{"# branch.oid abc123", "# branch.head main",
+			"1 MM N... 100644 100644 100644 a b both.go",
+			"1 D. N... 100644 000000 000000 a a gone.go",
+			"2 R. N... 100644 100644 100644 a a R100 new.go", "old.go"},
+			statusSnapshot{Oid: "abc123", Head: "main",
+				Changes: ChangeCounts{Staged: 3, Unstaged: 1, Renamed: 1, Deleted: 1},
+				Entries: []StatusEntry{
+					{Path: "both.go", Index: 'M', WorkTree: 'M'},
+					{Path: "gone.go", Index: 'D', WorkTree: '.'},
+					{Path: "new.go", OrigPath: "old.go", Index: 'R', WorkTree: '.'},
+				}}},
+		{"conflicted", []string{"# branch.oid abc123", "# branch.head main",
+			"u UU N... 100644 100644 100644 100644 a b c README.md"},
+			statusSnapshot{Oid: "abc123", Head: "main",
+				Changes: ChangeCounts{Conflicted: 1},
+				Entries: []StatusEntry{{Path: "README.md", Index: 'U', WorkTree: 'U', Conflicted: true}}}},
+	}
+
+	for _, tc := range tests {
+		got := parseStatus(strings.Join(tc.records, "\x00") + "\x00")
+		if !reflect.DeepEqual(*got, tc.want) {
+			t.Errorf("%s: parseStatus() = %+v, want %+v", tc.name, *got, tc.want)
+		}
+	}
+}
+
+// writeGitFile writes content to a file below .git in the current directory
+func writeGitFile(t *testing.T, name, content string) {
+	t.Helper()
+	path := filepath.Join(".git", filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDetectTimelineFallback(t *testing.T) {
+	t.Chdir(t.TempDir())
+	writeGitFile(t, "refs/remotes/origin/main", "abc\n")
+
+	tests := []struct {
+		name   string
+		status statusSnapshot
+		script func(f *FakeBackend)
+		want   Timeline
+		ahead  int
+		behind int
+		calls  int
+	}{
+		{"upstream counted by status", statusSnapshot{Head: "main", HasAheadBehind: true, Ahead: 2, Behind: 3},
+			func(f *FakeBackend) {}, Diverged, 2, 3, 0},
+		{"no upstream, remote branch behind", statusSnapshot{Head: "main"},
+			func(f *FakeBackend) {
+				f.OnOutput("rev-list --left-right --count HEAD...refs/remotes/origin/main", "0\t4")
+			}, Behind, 0, 4, 1},
+		{"never pushed", statusSnapshot{Head: "feature"},
+			func(f *FakeBackend) { f.OnOutput("rev-list --count HEAD", "5") }, Ahead, 5, 0, 1},
+		{"detached head", statusSnapshot{Head: "(detached)"},
+			func(f *FakeBackend) {}, InSync, 0, 0, 0},
+	}
+
+	for _, tc := range tests {
+		fake := useFakeBackend(t)
+		tc.script(fake)
+		got, ahead, behind, err := currentRepo.detectTimeline(&tc.status)
+		if err != nil || got != tc.want || ahead != tc.ahead || behind != tc.behind {
+			t.Errorf("%s: detectTimeline() = %q, %d, %d, %v; want %q, %d, %d",
+				tc.name, got, ahead, behind, err, tc.want, tc.ahead, tc.behind)
+		}
+		if n := len(fake.Calls()); n != tc.calls {
+			t.Errorf("%s: git calls = %d, want %d", tc.name, n, tc.calls)
+		}
+	}
+}
+
+func TestResolveRef(t *testing.T) {
+	t.Chdir(t.TempDir())
+	writeGitFile(t, "refs/remotes/origin/main", "loose\n")
+	writeGitFile(t, "packed-refs", "# pack-refs with: peeled fully-peeled sorted\n"+
+		"packed refs/remotes/origin/dev\n^peeled\nlocal refs/heads/main\n")
+
+	tests := []struct {
+		ref  string
+		want string
+	}{
+		{"refs/remotes/origin/main", "loose"},
+		{"refs/remotes/origin/dev", "packed"},
+		{"refs/remotes/origin/missing", ""},
+	}
+	for _, tc := range tests {
+		if got := currentRepo.resolveRef(tc.ref); got != tc.want {
+			t.Errorf("resolveRef(%q) = %q, want %q", tc.ref, got, tc.want)
+		}
+	}
+	if got := currentRepo.resolveUpstream("main"); got != "local" {
+		t.Errorf("resolveUpstream(%q) = %q, want %q", "main", got, "local")
+	}
+}
+
+func TestDetectStateUpstream(t *testing.T) {
+	t.Chdir(t.TempDir())
+	writeGitFile(t, "config", "[core]\n\tbare = false\n[remote \"origin\"]\n\turl = x\n")
+	writeGitFile(t, "refs/remotes/origin/main", "abc123\n")
+	fake := useFakeBackend(t)
+
+	tests := []struct {
+		name       string
+		upstream   string
+		wantHash   string
+		wantRemote bool
+	}{
+		{"fetched upstream", "origin/main", "abc123", true},
+		{"upstream gone", "origin/deleted", "", false},
+	}
+	for _, tc := range tests {
+		fake.OnOutput("status --porcelain=v2 --branch -z",
+			"# branch.oid abc123\x00# branch.head main\x00# branch.upstream "+tc.upstream+"\x00")
+		state, err := DetectState()
+		if err != nil || state.RemoteHash != tc.wantHash || state.LocalBranchOnRemote != tc.wantRemote {
+			t.Errorf("%s: DetectState() RemoteHash = %q, LocalBranchOnRemote = %v, %v; want %q, %v",
+				tc.name, state.RemoteHash, state.LocalBranchOnRemote, err, tc.wantHash, tc.wantRemote)
+		}
+	}
+}
+
+func TestDetectRemote(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if got := currentRepo.detectRemote(); got != NoRemote {
+		t.Errorf("detectRemote() without .git/config = %q, want %q", got, NoRemote)
+	}
+	writeGitFile(t, "config", "[core]\n\tbare = false\n")
+	if got := currentRepo.detectRemote(); got != NoRemote {
+		t.Errorf("detectRemote() without remote section = %q, want %q", got, NoRemote)
+	}
+	writeGitFile(t, "config", "[core]\n\tbare = false\n[remote \"origin\"]\n\turl = x\n")
+	if got := currentRepo.detectRemote(); got != HasRemote {
+		t.Errorf("detectRemote() with origin = %q, want %q", got, HasRemote)
+	}
+}
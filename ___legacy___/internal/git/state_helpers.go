@@ -54,44 +54,17 @@ func detectDirtyOperation() bool {
 // GetTimeTravelInfo reads the .git/TIT_TIME_TRAVEL file and returns the original branch
 // Returns: originalBranch, stashID, error
 func GetTimeTravelInfo() (string, string, error) {
-	gitDir := internal.GitDirectoryName
-	filePath := filepath.Join(gitDir, "TIT_TIME_TRAVEL")
-
-	content, err := os.ReadFile(filePath)
+	marker, err := ReadTimeTravelMarker()
 	if err != nil {
-		return "", "", fmt.Errorf("failed to read time travel info: %w", err)
+		return "", "", err
 	}
-
-	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
-	if len(lines) < 1 {
-		return "", "", fmt.Errorf("invalid time travel info format")
-	}
-
-	originalBranch := strings.TrimSpace(lines[0])
-	stashID := ""
-	if len(lines) >= 2 {
-		stashID = strings.TrimSpace(lines[1])
-	}
-
-	return originalBranch, stashID, nil
+	return marker.OriginalBranch, marker.StashID, nil
 }
 
-// WriteTimeTravelInfo writes the .git/TIT_TIME_TRAVEL file with original branch and optional stash ID
+// WriteTimeTravelInfo writes the .git/TIT_TIME_TRAVEL file with original branch and optional stash ID.
+// Starts a new session: any recorded hops are dropped.
 func WriteTimeTravelInfo(originalBranch, stashID string) error {
-	gitDir := internal.GitDirectoryName
-	filePath := filepath.Join(gitDir, "TIT_TIME_TRAVEL")
-
-	content := originalBranch + "\n"
-	if stashID != "" {
-		content += stashID + "\n"
-	}
-
-	err := os.WriteFile(filePath, []byte(content), internal.GitignorePerms)
-	if err != nil {
-		return fmt.Errorf("failed to write time travel info: %w", err)
-	}
-
-	return nil
+	return WriteTimeTravelMarker(TimeTravelMarker{OriginalBranch: originalBranch, StashID: stashID})
 }
 
 // ClearTimeTravelInfo removes the .git/TIT_TIME_TRAVEL file
@@ -118,24 +91,11 @@ func LoadTimeTravelInfo() (*TimeTravelInfo, error) {
 		return nil, nil
 	}
 
-	// Read marker file as plain text (simpler format)
-	data, err := os.ReadFile(markerPath)
+	marker, err := ReadTimeTravelMarker()
 	if err != nil {
 		return nil, fmt.Errorf("failed to read time travel marker: %w", err)
 	}
 
-	// Parse two lines: branch and optional stash ID
-	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
-	if len(lines) < 1 {
-		return nil, fmt.Errorf("time travel marker is empty")
-	}
-
-	branch := strings.TrimSpace(lines[0])
-	stashID := ""
-	if len(lines) > 1 {
-		stashID = strings.TrimSpace(lines[1])
-	}
-
 	// Get current commit info (we're in detached HEAD during time travel)
 	currentHash, err := executeGitCommand("rev-parse", "HEAD")
 	if err != nil {
@@ -160,8 +120,9 @@ func LoadTimeTravelInfo() (*TimeTravelInfo, error) {
 	}
 
 	return &TimeTravelInfo{
-		OriginalBranch:  branch,
-		OriginalStashID: stashID,
+		OriginalBranch:  marker.OriginalBranch,
+		OriginalStashID: marker.StashID,
+		Hops:            marker.Hops,
 		CurrentCommit: CommitInfo{
 			Hash:    currentHash,
 			Subject: currentSubject,
@@ -1,26 +1,73 @@
 package git
 
 import (
-	"os/exec"
+	"bufio"
+	"os"
+	"path/filepath"
 	"strings"
+
+	"github.com/jrengmusic/tit/internal"
 )
 
+// repoDir is the root of a repository's work tree; "" is the process working directory.
+// State detection reads .git files and runs git relative to it, so several
+// repositories can be detected concurrently (see DetectStateIn).
+type repoDir string
+
+// currentRepo is the repository in the process working directory
+const currentRepo repoDir = ""
+
+// gitPath joins elem under the repository's .git directory
+func (d repoDir) gitPath(elem ...string) string {
+	return filepath.Join(append([]string{string(d), internal.GitDirectoryName}, elem...)...)
+}
+
+// execute runs git in the repository (git -C <dir> for anything but currentRepo)
+func (d repoDir) execute(args ...string) (string, error) {
+	if d != currentRepo {
+		args = append([]string{"-C", string(d)}, args...)
+	}
+	return executeGitCommand(args...)
+}
+
 // executeGitCommand runs git command and returns trimmed output or error
 func executeGitCommand(args ...string) (string, error) {
-	cmd := exec.Command("git", args...)
-	output, err := cmd.Output()
-	if err != nil {
-		return "", err
+	result := Execute(args...)
+	if !result.Success {
+		return "", resultError(result)
 	}
-	return strings.TrimSpace(string(output)), nil
+	return result.Stdout, nil
 }
 
-// CurrentBranchExistsOnRemote checks if current branch exists on remote
-func CurrentBranchExistsOnRemote() bool {
-	currentBranch, err := executeGitCommand("rev-parse", "--abbrev-ref", "HEAD")
+// resolveRef reads a full ref name (e.g. "refs/remotes/origin/main") to its hash
+// straight from .git (loose ref first, then packed-refs). Returns "" if not found.
+func (d repoDir) resolveRef(ref string) string {
+	if data, err := os.ReadFile(d.gitPath(filepath.FromSlash(ref))); err == nil {
+		return strings.TrimSpace(string(data))
+	}
+
+	file, err := os.Open(d.gitPath("packed-refs"))
 	if err != nil {
-		return false
+		return ""
+	}
+	defer file.Close()
+
+	// Format: "<hash> <ref>", with "#" header and "^<hash>" peeled tag lines
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[1] == ref {
+			return fields[0]
+		}
+	}
+	return ""
+}
+
+// resolveUpstream resolves a status upstream short name to its hash.
+// "origin/main" lives under refs/remotes; a local upstream ("main") under refs/heads.
+func (d repoDir) resolveUpstream(upstream string) string {
+	if hash := d.resolveRef("refs/remotes/" + upstream); hash != "" {
+		return hash
 	}
-	cmd := exec.Command("git", "config", "--get", "branch."+currentBranch+".remote")
-	return cmd.Run() == nil
+	return d.resolveRef("refs/heads/" + upstream)
 }
@@ -0,0 +1,229 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jrengmusic/tit/internal"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Multi-hop time travel and commit bookmarks.
+//
+// .git/TIT_TIME_TRAVEL keeps its original layout (departure branch on the first
+// line, optional stash ID on the next) and records every visited commit in order:
+//
+//	main
+//	hop 3f2a9c1e...
+//	hop 81bd04aa...
+//
+// Readers that only look at the first line keep working unchanged.
+
+const (
+	timeTravelMarkerFile = "TIT_TIME_TRAVEL"
+	bookmarksFile        = "TIT_BOOKMARKS"
+	hopPrefix            = "hop "
+)
+
+// TimeTravelMarker is the parsed content of .git/TIT_TIME_TRAVEL
+type TimeTravelMarker struct {
+	OriginalBranch string   // Branch the session departed from
+	StashID        string   // Stash taken on departure (empty if clean entry)
+	Hops           []string // Visited commits (full hashes), oldest first; last is HEAD
+}
+
+// parseTimeTravelMarker parses marker content: branch, then "hop <hash>" lines;
+// any other non-empty line is the stash ID
+func parseTimeTravelMarker(content string) (TimeTravelMarker, error) {
+	lines := strings.Split(strings.TrimSpace(content), "\n")
+	marker := TimeTravelMarker{OriginalBranch: strings.TrimSpace(lines[0])}
+	if marker.OriginalBranch == "" {
+		return TimeTravelMarker{}, fmt.Errorf("invalid time travel info format")
+	}
+	for _, line := range lines[1:] {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, hopPrefix):
+			marker.Hops = append(marker.Hops, strings.TrimSpace(strings.TrimPrefix(line, hopPrefix)))
+		default:
+			marker.StashID = line
+		}
+	}
+	return marker, nil
+}
+
+// format renders the marker in the .git/TIT_TIME_TRAVEL layout
+func (m TimeTravelMarker) format() string {
+	var b strings.Builder
+	b.WriteString(m.OriginalBranch + "\n")
+	if m.StashID != "" {
+		b.WriteString(m.StashID + "\n")
+	}
+	for _, hop := range m.Hops {
+		b.WriteString(hopPrefix + hop + "\n")
+	}
+	return b.String()
+}
+
+// ReadTimeTravelMarker reads .git/TIT_TIME_TRAVEL
+func ReadTimeTravelMarker() (TimeTravelMarker, error) {
+	content, err := os.ReadFile(filepath.Join(internal.GitDirectoryName, timeTravelMarkerFile))
+	if err != nil {
+		return TimeTravelMarker{}, fmt.Errorf("failed to read time travel info: %w", err)
+	}
+	return parseTimeTravelMarker(string(content))
+}
+
+// WriteTimeTravelMarker replaces .git/TIT_TIME_TRAVEL with m
+func WriteTimeTravelMarker(m TimeTravelMarker) error {
+	path := filepath.Join(internal.GitDirectoryName, timeTravelMarkerFile)
+	if err := os.WriteFile(path, []byte(m.format()), internal.StateFilePerms); err != nil {
+		return fmt.Errorf("failed to write time travel info: %w", err)
+	}
+	return nil
+}
+
+// ExecuteTimeTravelBack checks out the previous hop of the session and drops the
+// current one from the trail. Returns: TimeTravelCheckoutMsg
+func ExecuteTimeTravelBack() func() tea.Msg {
+	return func() tea.Msg {
+		marker, err := ReadTimeTravelMarker()
+		if err != nil {
+			return TimeTravelCheckoutMsg{Success: false, Error: err.Error()}
+		}
+		if len(marker.Hops) < 2 {
+			return TimeTravelCheckoutMsg{
+				Success:        false,
+				OriginalBranch: marker.OriginalBranch,
+				Error:          "No previous hop in this time travel session",
+			}
+		}
+
+		target := marker.Hops[len(marker.Hops)-2]
+		Log(fmt.Sprintf("Jumping back to %s...", ShortenHash(target)))
+		if result := Execute("checkout", target); !result.Success {
+			Error(fmt.Sprintf("Error checking out commit: %s", result.Stderr))
+			return TimeTravelCheckoutMsg{
+				Success:        false,
+				OriginalBranch: marker.OriginalBranch,
+				CommitHash:     target,
+				Error:          fmt.Sprintf("Failed to checkout commit: %s", result.Stderr),
+			}
+		}
+
+		marker.Hops = marker.Hops[:len(marker.Hops)-1]
+		if err := WriteTimeTravelMarker(marker); err != nil {
+			Error(err.Error())
+			return TimeTravelCheckoutMsg{
+				Success:        false,
+				OriginalBranch: marker.OriginalBranch,
+				CommitHash:     target,
+				Error:          err.Error(),
+			}
+		}
+
+		Log("Time travel successful")
+		return TimeTravelCheckoutMsg{
+			Success:        true,
+			OriginalBranch: marker.OriginalBranch,
+			CommitHash:     target,
+		}
+	}
+}
+
+// Bookmark is a named commit, stored in .git/TIT_BOOKMARKS as "<hash>\t<name>" lines
+type Bookmark struct {
+	Name string
+	Hash string // Full commit hash
+}
+
+// LoadBookmarks reads the repository's bookmarks in the order they were created.
+// A missing file means no bookmarks.
+func LoadBookmarks() ([]Bookmark, error) {
+	content, err := os.ReadFile(filepath.Join(internal.GitDirectoryName, bookmarksFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bookmarks: %w", err)
+	}
+	return parseBookmarks(string(content)), nil
+}
+
+// parseBookmarks parses "<hash>\t<name>" lines, skipping malformed ones
+func parseBookmarks(content string) []Bookmark {
+	var bookmarks []Bookmark
+	for _, line := range strings.Split(content, "\n") {
+		hash, name, found := strings.Cut(strings.TrimSpace(line), "\t")
+		if !found || hash == "" || strings.TrimSpace(name) == "" {
+			continue
+		}
+		bookmarks = append(bookmarks, Bookmark{Name: strings.TrimSpace(name), Hash: hash})
+	}
+	return bookmarks
+}
+
+// SaveBookmark names hash, moving the bookmark if the name is already taken
+func SaveBookmark(name, hash string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || strings.ContainsAny(name, "\t\n") {
+		return fmt.Errorf("invalid bookmark name %q", name)
+	}
+	bookmarks, err := LoadBookmarks()
+	if err != nil {
+		return err
+	}
+	replaced := false
+	for i := range bookmarks {
+		if bookmarks[i].Name == name {
+			bookmarks[i].Hash = hash
+			replaced = true
+		}
+	}
+	if !replaced {
+		bookmarks = append(bookmarks, Bookmark{Name: name, Hash: hash})
+	}
+	return writeBookmarks(bookmarks)
+}
+
+// DeleteBookmark removes the bookmark called name (no-op if absent)
+func DeleteBookmark(name string) error {
+	bookmarks, err := LoadBookmarks()
+	if err != nil {
+		return err
+	}
+	kept := bookmarks[:0]
+	for _, b := range bookmarks {
+		if b.Name != name {
+			kept = append(kept, b)
+		}
+	}
+	return writeBookmarks(kept)
+}
+
+// writeBookmarks replaces .git/TIT_BOOKMARKS
+func writeBookmarks(bookmarks []Bookmark) error {
+	var b strings.Builder
+	for _, bm := range bookmarks {
+		b.WriteString(bm.Hash + "\t" + bm.Name + "\n")
+	}
+	path := filepath.Join(internal.GitDirectoryName, bookmarksFile)
+	if err := os.WriteFile(path, []byte(b.String()), internal.StateFilePerms); err != nil {
+		return fmt.Errorf("failed to write bookmarks: %w", err)
+	}
+	return nil
+}
+
+// BookmarkName returns the name of the first bookmark on hash, or ""
+func BookmarkName(bookmarks []Bookmark, hash string) string {
+	for _, b := range bookmarks {
+		if b.Hash == hash {
+			return b.Name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,59 @@
+package git
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTimeTravelMarker(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    TimeTravelMarker
+		wantErr bool
+	}{
+		{name: "legacy branch only", content: "main\n",
+			want: TimeTravelMarker{OriginalBranch: "main"}},
+		{name: "legacy branch and stash", content: "main\nabc123\n",
+			want: TimeTravelMarker{OriginalBranch: "main", StashID: "abc123"}},
+		{name: "hops", content: "main\nhop aaa\nhop bbb\n",
+			want: TimeTravelMarker{OriginalBranch: "main", Hops: []string{"aaa", "bbb"}}},
+		{name: "stash and hops", content: "dev\nabc123\nhop aaa\n\n",
+			want: TimeTravelMarker{OriginalBranch: "dev", StashID: "abc123", Hops: []string{"aaa"}}},
+		{name: "empty", content: "\n", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseTimeTravelMarker(tc.content)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("parseTimeTravelMarker(%q) = %+v, want %+v", tc.content, got, tc.want)
+			}
+			if tc.wantErr {
+				return
+			}
+			if again, _ := parseTimeTravelMarker(got.format()); !reflect.DeepEqual(again, got) {
+				t.Errorf("format round trip = %+v, want %+v", again, got)
+			}
+		})
+	}
+}
+
+func TestParseBookmarks(t *testing.T) {
+	content := "aaa\tlast known good build\nbbb\trelease\n\nmalformed\nccc\t \n"
+	want := []Bookmark{
+		{Name: "last known good build", Hash: "aaa"},
+		{Name: "release", Hash: "bbb"},
+	}
+	if got := parseBookmarks(content); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseBookmarks() = %+v, want %+v", got, want)
+	}
+	if got := BookmarkName(want, "bbb"); got != "release" {
+		t.Errorf("BookmarkName(bbb) = %q, want release", got)
+	}
+	if got := BookmarkName(want, "zzz"); got != "" {
+		t.Errorf("BookmarkName(zzz) = %q, want empty", got)
+	}
+}
@@ -33,6 +33,7 @@ const (
 	DirtyOperation Operation = "DirtyOperation"
 	TimeTraveling  Operation = "TimeTraveling"
 	Rewinding      Operation = "Rewinding" // Represents active rewind operation (git reset --hard in progress)
+	Bisecting      Operation = "Bisecting" // git bisect session running (.git/BISECT_START)
 )
 
 // Remote represents the remote repository presence
@@ -48,10 +49,11 @@ const (
 type GitEnvironment int
 
 const (
-	Ready      GitEnvironment = iota // git + ssh + key exists
-	NeedsSetup                       // git + ssh exist, no SSH key
-	MissingGit                       // git not installed
-	MissingSSH                       // ssh not installed
+	Ready         GitEnvironment = iota // git + ssh + key + identity exist
+	NeedsSetup                          // git + ssh exist, no SSH key
+	MissingGit                          // git not installed
+	MissingSSH                          // ssh not installed
+	NeedsIdentity                       // key exists, user.name or user.email unset
 )
 
 func (e GitEnvironment) String() string {
@@ -64,6 +66,8 @@ func (e GitEnvironment) String() string {
 		return "missing_git"
 	case MissingSSH:
 		return "missing_ssh"
+	case NeedsIdentity:
+		return "needs_identity"
 	default:
 		return "unknown"
 	}
@@ -72,7 +76,9 @@ func (e GitEnvironment) String() string {
 // State represents the complete git state tuple: (WorkingTree, Timeline, Operation, Remote)
 type State struct {
 	WorkingTree         WorkingTree
-	ModifiedCount       int // Number of modified files (for omp-style display)
+	ModifiedCount       int           // Number of modified files (for omp-style display)
+	Changes             ChangeCounts  // Per-category breakdown of ModifiedCount
+	Entries             []StatusEntry // Parsed file entries from git status
 	Timeline            Timeline
 	Operation           Operation
 	Remote              Remote
@@ -81,11 +87,49 @@ type State struct {
 	RemoteHash          string
 	CommitsAhead        int
 	CommitsBehind       int
-	LocalBranchOnRemote bool // Whether current branch exists on remote
-	Detached            bool // HEAD is detached (not on any branch)
-	IsTitTimeTravel     bool // True if detached HEAD was caused by TIT time travel
-	LFS                 bool // Repo has .gitattributes with filter=lfs
-	LFSReady            bool // git-lfs binary installed AND filters registered
+	LocalBranchOnRemote bool            // Whether current branch exists on remote
+	Detached            bool            // HEAD is detached (not on any branch)
+	IsTitTimeTravel     bool            // True if detached HEAD was caused by TIT time travel
+	LFS                 bool            // Repo has .gitattributes with filter=lfs
+	LFSReady            bool            // git-lfs binary installed AND filters registered
+	Shallow             bool            // History truncated by a shallow clone (.git/shallow exists)
+	Sparse              bool            // Sparse checkout enabled (core.sparseCheckout)
+	Canon               string          // tit.canon, empty if not set
+	Working             string          // tit.working, empty if not set
+	WorkingAhead        int             // Commits on working not yet delivered to canon (on working only)
+	WorkingBehind       int             // Commits on canon not yet in working (on working only)
+	Bisect              *BisectProgress // Set only while Operation == Bisecting
+}
+
+// ChangeCounts breaks the working tree down by change category.
+// A file can count in several categories (e.g. staged AND unstaged).
+type ChangeCounts struct {
+	Staged     int // Index differs from HEAD
+	Unstaged   int // Working tree differs from index
+	Untracked  int
+	Renamed    int // Renamed or copied, staged or not
+	Deleted    int // Deleted, staged or not
+	Conflicted int // Unmerged paths
+}
+
+// StatusEntry is one file entry from `git status --porcelain=v2`
+type StatusEntry struct {
+	Path       string
+	OrigPath   string // Source path for renames/copies, empty otherwise
+	Index      byte   // Staged status (X): '.', 'M', 'A', 'D', 'R', 'C', 'T', 'U'
+	WorkTree   byte   // Unstaged status (Y), same alphabet as Index
+	Untracked  bool
+	Conflicted bool
+}
+
+// IsStaged returns true if the entry has changes in the index
+func (e StatusEntry) IsStaged() bool {
+	return !e.Untracked && !e.Conflicted && e.Index != '.'
+}
+
+// IsUnstaged returns true if the entry has changes not yet added to the index
+func (e StatusEntry) IsUnstaged() bool {
+	return !e.Untracked && !e.Conflicted && e.WorkTree != '.'
 }
 
 // CommitInfo contains basic information about a commit (for list display)
@@ -114,6 +158,7 @@ type TimeTravelInfo struct {
 	OriginalHead    string     // Commit hash before time travel started
 	CurrentCommit   CommitInfo // Currently checked-out commit while time traveling
 	OriginalStashID string     // If user had dirty tree: stash ID (empty if clean entry)
+	Hops            []string   // Commits visited this session, oldest first (last is CurrentCommit)
 }
 
 // Logger interface for git package to emit messages without UI dependency.
@@ -0,0 +1,113 @@
+package git
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Canon/working branch policy, persisted per repository in .git/config:
+//
+//	[tit]
+//		canon = main
+//		working = dev
+//
+// Work happens on the working branch; canon only receives it through "deliver".
+const (
+	workflowSection    = "tit"
+	workflowCanonKey   = "tit.canon"
+	workflowWorkingKey = "tit.working"
+)
+
+// BranchWorkflow is the repository's canon/working branch pair
+type BranchWorkflow struct {
+	Canon   string // Branch that only receives delivered work (e.g. "main")
+	Working string // Branch day-to-day commits go to, empty if not set
+}
+
+// Configured reports whether both branches are set and distinct
+func (w BranchWorkflow) Configured() bool {
+	return w.Canon != "" && w.Working != "" && w.Canon != w.Working
+}
+
+// LoadWorkflow reads the canon/working pair of the repository in the working directory
+func LoadWorkflow() BranchWorkflow {
+	return currentRepo.readWorkflow()
+}
+
+// readWorkflow reads tit.canon and tit.working straight from .git/config
+func (d repoDir) readWorkflow() BranchWorkflow {
+	return BranchWorkflow{
+		Canon:   d.readConfigValue(workflowSection, "canon"),
+		Working: d.readConfigValue(workflowSection, "working"),
+	}
+}
+
+// SaveWorkflow writes the canon/working pair to the repository config.
+// An empty Working removes the working branch (canon alone is still recorded).
+func SaveWorkflow(w BranchWorkflow) error {
+	if w.Canon == "" {
+		return fmt.Errorf("canon branch is required")
+	}
+	if result := Execute("config", workflowCanonKey, w.Canon); !result.Success {
+		return resultError(result)
+	}
+	if w.Working == "" {
+		Execute("config", "--unset", workflowWorkingKey) // Exit 5 when already unset
+		return nil
+	}
+	if result := Execute("config", workflowWorkingKey, w.Working); !result.Success {
+		return resultError(result)
+	}
+	return nil
+}
+
+// divergenceCache remembers the last working...canon count per repository,
+// keyed by both tip hashes, so unchanged tips cost no subprocess on refresh
+var divergenceCache = struct {
+	sync.Mutex
+	entries map[repoDir]divergenceEntry
+}{entries: make(map[repoDir]divergenceEntry)}
+
+type divergenceEntry struct {
+	key           string
+	ahead, behind int
+}
+
+// workflowDivergence counts commits working has that canon lacks (ahead)
+// and commits canon has that working lacks (behind)
+func (d repoDir) workflowDivergence(w BranchWorkflow) (int, int) {
+	canonHash := d.resolveRef("refs/heads/" + w.Canon)
+	workingHash := d.resolveRef("refs/heads/" + w.Working)
+	if canonHash == "" || workingHash == "" || canonHash == workingHash {
+		return 0, 0
+	}
+
+	key := workingHash + "..." + canonHash
+	divergenceCache.Lock()
+	entry, ok := divergenceCache.entries[d]
+	divergenceCache.Unlock()
+	if ok && entry.key == key {
+		return entry.ahead, entry.behind
+	}
+
+	output, err := d.execute("rev-list", "--left-right", "--count", key)
+	if err != nil {
+		return 0, 0
+	}
+	parts := strings.Fields(output)
+	if len(parts) != 2 {
+		return 0, 0
+	}
+	ahead, aheadErr := strconv.Atoi(parts[0])
+	behind, behindErr := strconv.Atoi(parts[1])
+	if aheadErr != nil || behindErr != nil {
+		return 0, 0
+	}
+
+	divergenceCache.Lock()
+	divergenceCache.entries[d] = divergenceEntry{key: key, ahead: ahead, behind: behind}
+	divergenceCache.Unlock()
+	return ahead, behind
+}
@@ -0,0 +1,58 @@
+package git
+
+import "testing"
+
+func TestWorkflowDivergence(t *testing.T) {
+	root := newGitTestRepo(t)
+	gitRun(t, root, "init", "-q", "-b", "trunk")
+	gitRun(t, root, "commit", "-q", "--allow-empty", "-m", "initial")
+	t.Chdir(root)
+
+	if err := SaveWorkflow(BranchWorkflow{Canon: "trunk", Working: "dev"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := LoadWorkflow(); got != (BranchWorkflow{Canon: "trunk", Working: "dev"}) {
+		t.Fatalf("LoadWorkflow: got %+v", got)
+	}
+	if got := CanonBranch(); got != "trunk" {
+		t.Errorf("CanonBranch: got %q, want tit.canon", got)
+	}
+
+	// dev: two commits ahead of trunk, one behind
+	gitRun(t, root, "switch", "-q", "-c", "dev")
+	gitRun(t, root, "commit", "-q", "--allow-empty", "-m", "dev 1")
+	gitRun(t, root, "commit", "-q", "--allow-empty", "-m", "dev 2")
+	gitRun(t, root, "switch", "-q", "trunk")
+	gitRun(t, root, "commit", "-q", "--allow-empty", "-m", "trunk fix")
+
+	tests := []struct {
+		branch        string
+		ahead, behind int
+	}{
+		{"dev", 2, 1},
+		{"trunk", 0, 0}, // Divergence is only reported on the working branch
+	}
+	for _, tc := range tests {
+		gitRun(t, root, "switch", "-q", tc.branch)
+		for pass := 0; pass < 2; pass++ { // Second pass is served from the cache
+			state, err := DetectState()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if state.Canon != "trunk" || state.Working != "dev" {
+				t.Errorf("%s: policy got %q/%q", tc.branch, state.Canon, state.Working)
+			}
+			if state.WorkingAhead != tc.ahead || state.WorkingBehind != tc.behind {
+				t.Errorf("%s pass %d: got ahead %d behind %d, want %d/%d",
+					tc.branch, pass, state.WorkingAhead, state.WorkingBehind, tc.ahead, tc.behind)
+			}
+		}
+	}
+
+	if err := SaveWorkflow(BranchWorkflow{Canon: "trunk"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := LoadWorkflow(); got.Working != "" || got.Configured() {
+		t.Errorf("working not cleared: %+v", got)
+	}
+}
@@ -0,0 +1,158 @@
+package syntax
+
+// Built-in lexers. More languages plug in with Register(NewLexer(Language{...}), ".ext").
+
+var cComments = [][2]string{{"/*", "*/"}}
+
+func init() {
+	Register(NewLexer(Language{
+		Label: "Go",
+		Keywords: []string{"break", "case", "chan", "const", "continue", "default", "defer", "else",
+			"fallthrough", "for", "func", "go", "goto", "if", "import", "interface", "map", "package",
+			"range", "return", "select", "struct", "switch", "type", "var", "nil", "true", "false", "iota"},
+		Types: []string{"bool", "byte", "complex64", "complex128", "error", "float32", "float64",
+			"int", "int8", "int16", "int32", "int64", "rune", "string",
+			"uint", "uint8", "uint16", "uint32", "uint64", "uintptr", "any"},
+		LineComments:  []string{"//"},
+		BlockComments: cComments,
+		Quotes:        `"'`,
+		MultiLine:     []string{"`"},
+	}), ".go")
+
+	Register(NewLexer(Language{
+		Label: "C",
+		Keywords: []string{"break", "case", "class", "const", "constexpr", "continue", "default", "delete",
+			"do", "else", "enum", "extern", "for", "goto", "if", "inline", "namespace", "new", "nullptr",
+			"private", "protected", "public", "return", "sizeof", "static", "struct", "switch", "template",
+			"this", "typedef", "typename", "union", "using", "virtual", "volatile", "while", "true", "false", "NULL"},
+		Types: []string{"auto", "bool", "char", "double", "float", "int", "long", "short", "signed",
+			"unsigned", "void", "size_t", "int8_t", "int16_t", "int32_t", "int64_t",
+			"uint8_t", "uint16_t", "uint32_t", "uint64_t"},
+		LineComments:  []string{"//"},
+		BlockComments: cComments,
+		Quotes:        `"'`,
+	}), ".c", ".h", ".cc", ".cpp", ".cxx", ".hpp", ".hh", ".hxx", ".m", ".mm")
+
+	Register(NewLexer(Language{
+		Label: "Java",
+		Keywords: []string{"abstract", "break", "case", "catch", "class", "continue", "default", "do",
+			"else", "enum", "extends", "final", "finally", "for", "fun", "if", "implements", "import",
+			"interface", "is", "new", "object", "override", "package", "private", "protected", "public",
+			"return", "static", "super", "switch", "this", "throw", "throws", "try", "val", "var", "void",
+			"when", "while", "null", "true", "false", "using", "namespace", "readonly", "async", "await"},
+		Types: []string{"boolean", "byte", "char", "double", "float", "int", "long", "short", "String",
+			"Int", "Long", "Boolean", "Unit", "bool", "string", "object", "decimal"},
+		LineComments:  []string{"//"},
+		BlockComments: cComments,
+		Quotes:        `"'`,
+		MultiLine:     []string{`"""`},
+	}), ".java", ".kt", ".kts", ".scala", ".cs")
+
+	Register(NewLexer(Language{
+		Label: "JavaScript",
+		Keywords: []string{"async", "await", "break", "case", "catch", "class", "const", "continue",
+			"default", "delete", "do", "else", "export", "extends", "finally", "for", "from", "function",
+			"if", "import", "in", "instanceof", "interface", "let", "new", "of", "return", "static",
+			"super", "switch", "this", "throw", "try", "type", "typeof", "var", "void", "while", "yield",
+			"null", "undefined", "true", "false", "enum", "implements", "private", "public", "readonly"},
+		Types:         []string{"any", "boolean", "never", "number", "object", "string", "symbol", "unknown"},
+		LineComments:  []string{"//"},
+		BlockComments: cComments,
+		Quotes:        `"'`,
+		MultiLine:     []string{"`"},
+	}), ".js", ".jsx", ".mjs", ".cjs", ".ts", ".tsx")
+
+	Register(NewLexer(Language{
+		Label: "Rust",
+		Keywords: []string{"as", "async", "await", "break", "const", "continue", "crate", "dyn", "else",
+			"enum", "extern", "fn", "for", "if", "impl", "in", "let", "loop", "match", "mod", "move",
+			"mut", "pub", "ref", "return", "self", "Self", "static", "struct", "super", "trait", "type",
+			"unsafe", "use", "where", "while", "true", "false"},
+		Types: []string{"bool", "char", "f32", "f64", "i8", "i16", "i32", "i64", "i128", "isize",
+			"str", "u8", "u16", "u32", "u64", "u128", "usize", "String", "Vec", "Option", "Result", "Box"},
+		LineComments:  []string{"//"},
+		BlockComments: cComments,
+		Quotes:        `"`, // ' also starts lifetimes
+	}), ".rs")
+
+	Register(NewLexer(Language{
+		Label: "Swift",
+		Keywords: []string{"as", "break", "case", "catch", "class", "continue", "default", "defer", "do",
+			"else", "enum", "extension", "func", "guard", "if", "import", "in", "init", "let", "nil",
+			"private", "protocol", "public", "return", "self", "static", "struct", "switch", "throw",
+			"throws", "try", "var", "where", "while", "true", "false"},
+		Types:         []string{"Bool", "Character", "Double", "Float", "Int", "String", "Void"},
+		LineComments:  []string{"//"},
+		BlockComments: cComments,
+		Quotes:        `"`,
+		MultiLine:     []string{`"""`},
+	}), ".swift")
+
+	Register(NewLexer(Language{
+		Label: "Python",
+		Keywords: []string{"and", "as", "assert", "async", "await", "break", "class", "continue", "def",
+			"del", "elif", "else", "except", "finally", "for", "from", "global", "if", "import", "in",
+			"is", "lambda", "nonlocal", "not", "or", "pass", "raise", "return", "try", "while", "with",
+			"yield", "None", "True", "False", "self"},
+		Types:        []string{"bool", "bytes", "dict", "float", "int", "list", "object", "set", "str", "tuple"},
+		LineComments: []string{"#"},
+		Quotes:       `"'`,
+		MultiLine:    []string{`"""`, `'''`},
+	}), ".py", ".pyi")
+
+	Register(NewLexer(Language{
+		Label: "Ruby",
+		Keywords: []string{"alias", "and", "begin", "break", "case", "class", "def", "do", "else",
+			"elsif", "end", "ensure", "false", "for", "if", "in", "module", "next", "nil", "not", "or",
+			"redo", "require", "rescue", "retry", "return", "self", "super", "then", "true", "unless",
+			"until", "when", "while", "yield"},
+		LineComments: []string{"#"},
+		Quotes:       `"'`,
+	}), ".rb", "Gemfile", "Rakefile")
+
+	Register(NewLexer(Language{
+		Label: "Lua",
+		Keywords: []string{"and", "break", "do", "else", "elseif", "end", "false", "for", "function",
+			"goto", "if", "in", "local", "nil", "not", "or", "repeat", "return", "then", "true",
+			"until", "while"},
+		LineComments:  []string{"--"},
+		BlockComments: [][2]string{{"--[[", "]]"}},
+		Quotes:        `"'`,
+	}), ".lua")
+
+	Register(NewLexer(Language{
+		Label: "Shell",
+		Keywords: []string{"case", "do", "done", "elif", "else", "esac", "export", "fi", "for",
+			"function", "if", "in", "local", "readonly", "return", "then", "until", "while"},
+		LineComments: []string{"#"},
+		Quotes:       `"'`,
+	}), ".sh", ".bash", ".zsh", ".fish", "Makefile", "makefile", "Dockerfile", ".mk")
+
+	Register(NewLexer(Language{
+		Label:        "Config",
+		Keywords:     []string{"true", "false", "null", "yes", "no", "on", "off"},
+		LineComments: []string{"#"},
+		Quotes:       `"'`,
+		MultiLine:    []string{`"""`, `'''`},
+	}), ".toml", ".yaml", ".yml", ".ini", ".cfg", ".conf", ".properties", ".gitignore", ".gitattributes")
+
+	Register(NewLexer(Language{
+		Label:    "JSON",
+		Keywords: []string{"true", "false", "null"},
+		Quotes:   `"`,
+	}), ".json", ".jsonc")
+
+	Register(NewLexer(Language{
+		Label: "SQL",
+		Keywords: []string{"select", "from", "where", "insert", "into", "values", "update", "set",
+			"delete", "create", "table", "drop", "alter", "index", "join", "left", "right", "inner",
+			"outer", "on", "and", "or", "not", "null", "as", "group", "by", "order", "having", "limit",
+			"primary", "key", "foreign", "references", "union", "distinct", "case", "when", "then",
+			"else", "end", "is", "in", "exists", "begin", "commit", "rollback"},
+		Types:         []string{"int", "integer", "bigint", "text", "varchar", "char", "boolean", "date", "timestamp", "real", "numeric"},
+		LineComments:  []string{"--"},
+		BlockComments: cComments,
+		Quotes:        `'"`,
+		CaseFold:      true,
+	}), ".sql")
+}
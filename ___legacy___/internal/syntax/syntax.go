@@ -0,0 +1,262 @@
+// Package syntax splits source lines into highlight tokens.
+// Lexers are registered per file extension; the ui layer maps token kinds
+// to theme colors and composes them with diff colors.
+package syntax
+
+import (
+	"path/filepath"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Kind classifies a token for coloring
+type Kind int
+
+const (
+	Plain Kind = iota // Identifiers, punctuation, whitespace: drawn in the line's own color
+	Keyword
+	Type
+	String
+	Number
+	Comment
+	Function
+)
+
+// Token is a run of a line with one kind
+type Token struct {
+	Text string
+	Kind Kind
+}
+
+// State carries a block comment or multi-line string from one line into the next.
+// The zero value starts outside any block.
+type State struct {
+	close string // Delimiter that ends the open block ("" = none)
+	kind  Kind   // Kind of the open block
+}
+
+// Lexer tokenizes one line at a time, reading and updating state
+type Lexer interface {
+	Name() string
+	Tokenize(line string, state *State) []Token
+}
+
+var (
+	byExtension = map[string]Lexer{} // ".go" → Go lexer
+	byFilename  = map[string]Lexer{} // "Makefile" → Make lexer
+)
+
+// Register makes lexer available for file names ending in one of the
+// extensions (".go") or equal to one of the names without a dot ("Makefile").
+// A later registration for the same pattern replaces the earlier one.
+func Register(lexer Lexer, patterns ...string) {
+	for _, pattern := range patterns {
+		if strings.HasPrefix(pattern, ".") {
+			byExtension[strings.ToLower(pattern)] = lexer
+		} else {
+			byFilename[pattern] = lexer
+		}
+	}
+}
+
+// ForFile returns the lexer for a path, nil when no lexer matches
+func ForFile(path string) Lexer {
+	base := filepath.Base(path)
+	if lexer, ok := byFilename[base]; ok {
+		return lexer
+	}
+	return byExtension[strings.ToLower(filepath.Ext(base))]
+}
+
+// Language describes a language for the rule-based Lexer: word lists,
+// comment and string delimiters. Most C-like and script languages fit.
+type Language struct {
+	Label         string
+	Keywords      []string
+	Types         []string
+	LineComments  []string    // e.g. "//", "#"
+	BlockComments [][2]string // open and close delimiters, e.g. {"/*", "*/"}
+	Quotes        string      // Single-line string quotes, e.g. `"'`
+	MultiLine     []string    // Delimiters of strings that may span lines, e.g. "`", `"""`
+	CaseFold      bool        // Keywords match regardless of case (SQL)
+}
+
+// ruleLexer implements Lexer from a Language
+type ruleLexer struct {
+	lang     Language
+	keywords map[string]Kind
+}
+
+// NewLexer builds a Lexer from a language description
+func NewLexer(lang Language) Lexer {
+	keywords := make(map[string]Kind, len(lang.Keywords)+len(lang.Types))
+	for _, word := range lang.Types {
+		keywords[foldCase(word, lang.CaseFold)] = Type
+	}
+	for _, word := range lang.Keywords {
+		keywords[foldCase(word, lang.CaseFold)] = Keyword
+	}
+	return &ruleLexer{lang: lang, keywords: keywords}
+}
+
+func (l *ruleLexer) Name() string {
+	return l.lang.Label
+}
+
+// Tokenize splits line into tokens, merging neighbours of the same kind
+func (l *ruleLexer) Tokenize(line string, state *State) []Token {
+	var tokens []Token
+	emit := func(text string, kind Kind) {
+		if text == "" {
+			return
+		}
+		if n := len(tokens); n > 0 && tokens[n-1].Kind == kind {
+			tokens[n-1].Text += text
+			return
+		}
+		tokens = append(tokens, Token{Text: text, Kind: kind})
+	}
+
+	i := 0
+	// Finish a block left open by an earlier line
+	if state.close != "" {
+		end := strings.Index(line, state.close)
+		if end < 0 {
+			emit(line, state.kind)
+			return tokens
+		}
+		i = end + len(state.close)
+		emit(line[:i], state.kind)
+		state.close = ""
+	}
+
+	for i < len(line) {
+		rest := line[i:]
+		// Block comments first: Lua's "--[[" starts with its line comment "--"
+		if open, closing := blockIn(rest, l.lang.BlockComments); open != "" {
+			i += l.block(rest, open, closing, Comment, state, emit)
+			continue
+		}
+		if prefixIn(rest, l.lang.LineComments) != "" {
+			emit(rest, Comment)
+			break
+		}
+		if delim := prefixIn(rest, l.lang.MultiLine); delim != "" {
+			i += l.block(rest, delim, delim, String, state, emit)
+			continue
+		}
+
+		r := rune(rest[0])
+		switch {
+		case strings.ContainsRune(l.lang.Quotes, r):
+			end := stringEnd(rest, rest[0])
+			emit(rest[:end], String)
+			i += end
+		case isDigit(r) && !precededByWord(line, i):
+			end := 1
+			for end < len(rest) && (isWordByte(rest[end]) || rest[end] == '.') {
+				end++
+			}
+			emit(rest[:end], Number)
+			i += end
+		case isWordByte(rest[0]) || r >= 0x80:
+			end := wordEnd(rest)
+			word := rest[:end]
+			kind, ok := l.keywords[foldCase(word, l.lang.CaseFold)]
+			if !ok {
+				kind = Plain
+				if strings.HasPrefix(strings.TrimLeft(rest[end:], " "), "(") {
+					kind = Function
+				}
+			}
+			emit(word, kind)
+			i += end
+		default:
+			emit(rest[:1], Plain)
+			i++
+		}
+	}
+	return tokens
+}
+
+// block emits a delimited block starting at rest and returns the bytes consumed;
+// when the block does not close on this line it stays open in state
+func (l *ruleLexer) block(rest, open, closing string, kind Kind, state *State, emit func(string, Kind)) int {
+	end := strings.Index(rest[len(open):], closing)
+	if end < 0 {
+		emit(rest, kind)
+		state.close, state.kind = closing, kind
+		return len(rest)
+	}
+	n := len(open) + end + len(closing)
+	emit(rest[:n], kind)
+	return n
+}
+
+// stringEnd returns the length of the quoted string at the start of s
+// (through the closing quote, or the rest of the line when unclosed)
+func stringEnd(s string, quote byte) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case quote:
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+// wordEnd returns the length of the identifier at the start of s
+// (or of its first rune when that is not a letter)
+func wordEnd(s string) int {
+	for i, r := range s {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			continue
+		}
+		if i == 0 {
+			_, size := utf8.DecodeRuneInString(s)
+			return size
+		}
+		return i
+	}
+	return len(s)
+}
+
+func prefixIn(s string, prefixes []string) string {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return prefix
+		}
+	}
+	return ""
+}
+
+func blockIn(s string, blocks [][2]string) (open, closing string) {
+	for _, block := range blocks {
+		if strings.HasPrefix(s, block[0]) {
+			return block[0], block[1]
+		}
+	}
+	return "", ""
+}
+
+func precededByWord(line string, i int) bool {
+	return i > 0 && isWordByte(line[i-1])
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9'
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func foldCase(word string, fold bool) string {
+	if fold {
+		return strings.ToLower(word)
+	}
+	return word
+}
@@ -0,0 +1,71 @@
+package syntax
+
+import (
+	"strings"
+	"testing"
+)
+
+// tokensString renders tokens as kind:text pairs, skipping plain runs
+func tokensString(tokens []Token) string {
+	names := map[Kind]string{Keyword: "kw", Type: "type", String: "str", Number: "num", Comment: "cmt", Function: "fn"}
+	var parts []string
+	for _, token := range tokens {
+		if token.Kind != Plain {
+			parts = append(parts, names[token.Kind]+":"+token.Text)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestTokenize(t *testing.T) {
+	cases := []struct {
+		path string
+		line string
+		want string
+	}{
+		{"main.go", `func add(a int) string { return fmt.Sprint(a + 42) } // sum`,
+			"kw:func fn:add type:int type:string kw:return fn:Sprint num:42 cmt:// sum"},
+		{"a.go", `s := "x \" y" + 'z'`, `str:"x \" y" str:'z'`},
+		{"a.go", `v2 := x1`, ""}, // Digits inside identifiers are not numbers
+		{"script.PY", `def f(): return None  # done`, "kw:def fn:f kw:return kw:None cmt:# done"},
+		{"q.sql", `SELECT id FROM t -- all`, "kw:SELECT kw:FROM cmt:-- all"},
+		{"init.lua", `--[[ note ]] local x`, "cmt:--[[ note ]] kw:local"},
+		{"Makefile", `all: build # default`, "cmt:# default"},
+		{"a.go", `x := "ünï" + y→z`, `str:"ünï"`},
+	}
+	for _, tc := range cases {
+		lexer := ForFile(tc.path)
+		if lexer == nil {
+			t.Fatalf("ForFile(%q): no lexer", tc.path)
+		}
+		var state State
+		tokens := lexer.Tokenize(tc.line, &state)
+		if got := tokensString(tokens); got != tc.want {
+			t.Errorf("%s %q:\n got  %s\n want %s", lexer.Name(), tc.line, got, tc.want)
+		}
+		var joined strings.Builder
+		for _, token := range tokens {
+			joined.WriteString(token.Text)
+		}
+		if joined.String() != tc.line {
+			t.Errorf("%q: tokens join to %q", tc.line, joined.String())
+		}
+	}
+
+	if lexer := ForFile("photo.png"); lexer != nil {
+		t.Errorf("ForFile(photo.png) = %s, want none", lexer.Name())
+	}
+}
+
+func TestTokenizeAcrossLines(t *testing.T) {
+	lexer := ForFile("a.go")
+	var state State
+	var got []string
+	for _, line := range []string{"x := 1 /* start", "still comment", "end */ y := `raw", "raw` + 2"} {
+		got = append(got, tokensString(lexer.Tokenize(line, &state)))
+	}
+	want := []string{"num:1 cmt:/* start", "cmt:still comment", "cmt:end */ str:`raw", "str:raw` num:2"}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
@@ -0,0 +1,107 @@
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif" // Register decoders for image previews
+	_ "image/jpeg"
+	_ "image/png"
+	"path/filepath"
+	"strings"
+
+	"github.com/jrengmusic/tit/internal"
+	"github.com/jrengmusic/tit/internal/banner"
+	"github.com/jrengmusic/tit/internal/git"
+)
+
+// Binary files are shown as summaries in place of their text: size and blob
+// hash per side, plus dimensions and a braille thumbnail for images.
+
+// imageExtensions lists the formats TIT can decode for previews
+var imageExtensions = map[string]bool{".png": true, ".jpg": true, ".jpeg": true, ".gif": true}
+
+// ImagePreview is what TIT shows of an image blob
+type ImagePreview struct {
+	Format        string // Decoder name: "png", "jpeg", "gif"
+	Width, Height int
+	Thumbnail     []string // Braille rows; empty when only the header decoded
+}
+
+// BinarySide is one version of a binary file
+type BinarySide struct {
+	Blob  git.Blob
+	Image *ImagePreview // nil when the blob is not a previewable image
+}
+
+// IsImagePath reports whether path has an extension TIT can preview
+func IsImagePath(path string) bool {
+	return imageExtensions[strings.ToLower(filepath.Ext(path))]
+}
+
+// NewImagePreview decodes the dimensions and a braille thumbnail of image
+// data; nil when data is not a PNG, JPEG or GIF image. Images declaring more
+// than ImagePreviewMaxPixels keep their dimensions but are never decoded.
+func NewImagePreview(data []byte) *ImagePreview {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil
+	}
+	preview := &ImagePreview{Format: format, Width: config.Width, Height: config.Height}
+	if int64(config.Width)*int64(config.Height) > internal.ImagePreviewMaxPixels {
+		return preview
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return preview
+	}
+	for _, row := range banner.ImageToBrailleArray(img, internal.ImageThumbnailCols, internal.ImageThumbnailRows) {
+		var line strings.Builder
+		for _, cell := range row {
+			line.WriteRune(cell.Char)
+		}
+		preview.Thumbnail = append(preview.Thumbnail, line.String())
+	}
+	return preview
+}
+
+// binarySummary returns the lines describing one side (none when the file
+// does not exist there)
+func binarySummary(side BinarySide) []string {
+	if !side.Blob.Exists() {
+		return nil
+	}
+	hash := side.Blob.Hash
+	if len(hash) > CopyHashShortHashLen {
+		hash = hash[:CopyHashShortHashLen]
+	}
+	lines := []string{fmt.Sprintf("binary file · %s · blob %s", FormatBytes(side.Blob.Size), hash)}
+	if img := side.Image; img != nil {
+		lines = append(lines, fmt.Sprintf("%s image · %d × %d px", strings.ToUpper(img.Format), img.Width, img.Height))
+		lines = append(lines, img.Thumbnail...)
+	}
+	return lines
+}
+
+// BinaryDiffContent renders a binary change as a diff of its summaries: the
+// old side as removed lines, the new side as added lines, so both diff
+// layouts show them next to each other
+func BinaryDiffContent(oldSide, newSide BinarySide) string {
+	oldLines, newLines := binarySummary(oldSide), binarySummary(newSide)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "@@ -1,%d +1,%d @@\n", len(oldLines), len(newLines))
+	for _, line := range oldLines {
+		b.WriteString("-" + line + "\n")
+	}
+	for _, line := range newLines {
+		b.WriteString("+" + line + "\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// BinaryVersionContent renders one version of a binary file as plain text
+func BinaryVersionContent(side BinarySide) string {
+	return strings.Join(binarySummary(side), "\n")
+}
@@ -0,0 +1,76 @@
+package ui
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/jrengmusic/tit/internal"
+	"github.com/jrengmusic/tit/internal/git"
+)
+
+func TestNewImagePreview(t *testing.T) {
+	// 8×8 dots: white left half, transparent right half → 4×2 braille cells
+	img := image.NewNRGBA(image.Rect(0, 0, 8, 8))
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 4; x++ {
+			img.Set(x, y, color.White)
+		}
+	}
+	var data bytes.Buffer
+	if err := png.Encode(&data, img); err != nil {
+		t.Fatal(err)
+	}
+
+	preview := NewImagePreview(data.Bytes())
+	if preview == nil || preview.Format != "png" || preview.Width != 8 || preview.Height != 8 {
+		t.Fatalf("NewImagePreview() = %+v, want 8×8 png", preview)
+	}
+	want := []string{"⣿⣿⠀⠀", "⣿⣿⠀⠀"}
+	if strings.Join(preview.Thumbnail, "\n") != strings.Join(want, "\n") {
+		t.Errorf("thumbnail:\n%s\nwant:\n%s", strings.Join(preview.Thumbnail, "\n"), strings.Join(want, "\n"))
+	}
+
+	if preview := NewImagePreview([]byte("\x00not an image")); preview != nil {
+		t.Errorf("NewImagePreview(garbage) = %+v, want nil", preview)
+	}
+
+	// Images over the pixel cap keep their dimensions and are not decoded
+	const width = internal.ImagePreviewMaxPixels/64 + 1
+	data.Reset()
+	if err := png.Encode(&data, image.NewGray(image.Rect(0, 0, width, 64))); err != nil {
+		t.Fatal(err)
+	}
+	preview = NewImagePreview(data.Bytes())
+	if preview == nil || preview.Width != width || preview.Height != 64 || preview.Thumbnail != nil {
+		t.Errorf("NewImagePreview(%d×64) = %+v, want dimensions without thumbnail", width, preview)
+	}
+}
+
+func TestBinaryDiffContent(t *testing.T) {
+	added := BinarySide{
+		Blob:  git.Blob{Hash: "0123456789abcdef", Size: 2048},
+		Image: &ImagePreview{Format: "gif", Width: 2, Height: 4, Thumbnail: []string{"⣿"}},
+	}
+	content := BinaryDiffContent(BinarySide{}, added)
+	want := "@@ -1,0 +1,3 @@\n" +
+		"+binary file · 2.0 KiB · blob 0123456\n" +
+		"+GIF image · 2 × 4 px\n" +
+		"+⣿"
+	if content != want {
+		t.Fatalf("BinaryDiffContent() =\n%s\nwant:\n%s", content, want)
+	}
+
+	// Both layouts show the summary as added lines numbered from 1
+	lines := parseDiffContent(content)
+	if len(lines) != 3 || lines[0].LineType != "added" || lines[0].LineNum != 1 {
+		t.Errorf("parseDiffContent() = %+v", lines)
+	}
+	rows := parseSplitDiff(content)
+	if len(rows) != 3 || rows[0][0] != nil || rows[2][1].Code != "⣿" {
+		t.Errorf("parseSplitDiff() rows = %d", len(rows))
+	}
+}
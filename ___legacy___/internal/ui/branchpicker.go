@@ -20,13 +20,19 @@ type BranchInfo struct {
 	TrackingRemote string // e.g., "origin/main", or "" if local only
 	Ahead          int
 	Behind         int
+	Gone           bool // Upstream deleted on the remote
+	Merged         bool // Merged into the canon branch
+	IsRemote       bool // Remote-tracking branch (listed after local branches)
+	HasLocal       bool // Remote branch already tracked by a local branch
+	IsTag          bool // Tag (compare picker only)
 }
 
 // BranchPickerState represents the state of the branch picker (2-pane split-view)
 // Mirrors HistoryState pattern: list pane (left) + details pane (right)
 // Uses SSOT: ListPane + TextPane for consistent rendering with history mode
 type BranchPickerState struct {
-	Branches          []BranchInfo // List of all branches
+	Branches          []BranchInfo // Local branches, then remote branches (switch picker only)
+	Canon             string       // Branch merged status is relative to, empty if unknown
 	SelectedIdx       int          // Currently selected branch (0-indexed)
 	PaneFocused       bool         // true = list pane, false = details pane
 	ListScrollOffset  int          // Scroll offset for branch list
@@ -82,7 +88,18 @@ func buildBranchListItems(branches []BranchInfo, selectedIdx int, theme *Theme)
 		}
 
 		// Show tracking/divergence info
-		if branch.TrackingRemote != "" {
+		if branch.IsTag {
+			attrText += "tag"
+		} else if branch.IsRemote {
+			if branch.HasLocal {
+				attrText += "remote, tracked"
+			} else {
+				attrText += "remote"
+			}
+		} else if branch.Gone {
+			attrText += "gone"
+			attrColor = theme.OutputStderrColor
+		} else if branch.TrackingRemote != "" {
 			// Has upstream: show divergence if any
 			if branch.Ahead > 0 || branch.Behind > 0 {
 				attrText += fmt.Sprintf("↑%d ↓%d", branch.Ahead, branch.Behind)
@@ -93,6 +110,9 @@ func buildBranchListItems(branches []BranchInfo, selectedIdx int, theme *Theme)
 			// Local only branch
 			attrText += "local"
 		}
+		if branch.Merged {
+			attrText += " ✓"
+		}
 
 		items[i] = ListItem{
 			AttributeText:  attrText,
@@ -141,14 +161,33 @@ func renderBranchDetailsPane(state *BranchPickerState, theme *Theme, width, heig
 		lines = append(lines, "BRANCH")
 		lines = append(lines, fmt.Sprintf("  Name: %s", branch.Name))
 
-		if branch.IsCurrent {
+		switch {
+		case branch.IsTag:
+			lines = append(lines, "  Status: Tag (read-only)")
+		case branch.IsCurrent:
 			lines = append(lines, "  Status: ● Current")
-		} else {
+		case branch.IsRemote && branch.HasLocal:
+			lines = append(lines, "  Status: Remote, tracked by a local branch")
+		case branch.IsRemote:
+			lines = append(lines, "  Status: Remote only (Enter creates a local tracking branch)")
+		default:
 			lines = append(lines, "  Status: Not current")
 		}
 
-		// Tracking/upstream info
-		if branch.TrackingRemote != "" {
+		if state.Canon != "" && branch.Name != state.Canon {
+			if branch.Merged {
+				lines = append(lines, fmt.Sprintf("  Merged into %s: yes", state.Canon))
+			} else {
+				lines = append(lines, fmt.Sprintf("  Merged into %s: no", state.Canon))
+			}
+		}
+
+		// Tracking/upstream info (remote branches have no upstream of their own)
+		switch {
+		case branch.IsRemote, branch.IsTag:
+		case branch.Gone:
+			lines = append(lines, fmt.Sprintf("  Upstream: %s (gone from remote)", branch.TrackingRemote))
+		case branch.TrackingRemote != "":
 			trackStr := fmt.Sprintf("  Upstream: %s", branch.TrackingRemote)
 			if branch.Ahead > 0 || branch.Behind > 0 {
 				trackStr += fmt.Sprintf(" (↑%d ↓%d)", branch.Ahead, branch.Behind)
@@ -156,7 +195,7 @@ func renderBranchDetailsPane(state *BranchPickerState, theme *Theme, width, heig
 				trackStr += " (synced)"
 			}
 			lines = append(lines, trackStr)
-		} else {
+		default:
 			lines = append(lines, "  Upstream: none (local only)")
 		}
 
@@ -0,0 +1,170 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jrengmusic/tit/internal/git"
+)
+
+// CompareFile is an alias for git.CompareFile to avoid import cycles in UI
+type CompareFile = git.CompareFile
+
+// ComparePane represents which pane is focused in compare mode
+type ComparePane int
+
+const (
+	ComparePaneLeft  ComparePane = iota // Commits only in the left side
+	ComparePaneRight                    // Commits only in the right side
+	ComparePaneFiles
+	ComparePaneDiff
+	comparePaneCount
+)
+
+// CompareState represents the state of the compare view (two commits, branches or tags)
+type CompareState struct {
+	Left             string        // Left ref as picked (branch, tag or full hash)
+	Right            string        // Right ref as picked
+	LeftLabel        string        // Left ref for display (short hash for commits)
+	RightLabel       string        // Right ref for display
+	Mode             string        // git.CompareThreeDot or git.CompareTwoDot
+	LeftOnly         []CommitInfo  // Commits in left but not right, newest first
+	RightOnly        []CommitInfo  // Commits in right but not left, newest first
+	Files            []CompareFile // Files changed between the sides (per Mode)
+	FocusedPane      ComparePane   // Which pane has focus
+	LeftIdx          int           // Selected commit in the left pane
+	RightIdx         int           // Selected commit in the right pane
+	SelectedFileIdx  int           // Selected file
+	DiffContent      string        // Diff of the selected file (populated by handlers)
+	DiffScrollOff    int           // Scroll offset for diff pane
+	DiffLineCursor   int           // Line cursor for diff pane
+	VisualModeActive bool          // True when visual mode is active (for selecting lines)
+	VisualModeStart  int           // Starting line of visual selection
+	SplitDiff        bool          // True = side-by-side diff layout, false = unified
+	SyntaxHighlight  bool          // True = syntax colors in the diff pane (preference)
+}
+
+// NextPane cycles focus: left commits → right commits → files → diff
+func (s *CompareState) NextPane() {
+	s.FocusedPane = (s.FocusedPane + 1) % comparePaneCount
+}
+
+// RenderCompareSplitPane renders the compare view in the file history layout:
+// top row = left-only commits, right-only commits, files with stats; bottom row = diff.
+// Returns content exactly `width` chars wide (footer handled externally)
+func RenderCompareSplitPane(state interface{}, theme Theme, width, height int) string {
+	if width <= 0 || height <= 0 {
+		return ""
+	}
+
+	compareState, ok := state.(*CompareState)
+	if !ok || compareState == nil {
+		return "Error: invalid compare state"
+	}
+
+	// Same row split as file history
+	topRowHeight := height / 3
+	bottomRowHeight := height - topRowHeight - 5
+
+	commitPaneWidth := CommitListPaneWidth
+	filesPaneWidth := width - 2*commitPaneWidth
+
+	leftPane := renderCompareCommitsPane("◀ "+compareState.LeftLabel, compareState.LeftOnly, compareState.LeftIdx,
+		compareState.FocusedPane == ComparePaneLeft, theme, commitPaneWidth, topRowHeight)
+	rightPane := renderCompareCommitsPane("▶ "+compareState.RightLabel, compareState.RightOnly, compareState.RightIdx,
+		compareState.FocusedPane == ComparePaneRight, theme, commitPaneWidth, topRowHeight)
+	filesPane := renderCompareFilesPane(compareState, theme, filesPaneWidth, topRowHeight)
+
+	topRow := lipgloss.JoinHorizontal(lipgloss.Top, leftPane, rightPane, filesPane)
+	bottomRow := renderCompareDiffPane(compareState, theme, width, bottomRowHeight)
+
+	return topRow + "\n" + bottomRow
+}
+
+// renderCompareCommitsPane renders the commits unique to one side
+func renderCompareCommitsPane(title string, commits []CommitInfo, selectedIdx int, isActive bool, theme Theme, width, height int) string {
+	listPane := NewListPane(truncateTitle(title, width-4), &theme)
+	items := buildCommitListItems(commits, selectedIdx, theme, nil)
+
+	visibleLines := height - 2
+	if visibleLines < 1 {
+		visibleLines = 1
+	}
+	listPane.AdjustScroll(selectedIdx, visibleLines)
+
+	return listPane.Render(items, width, height, isActive, 0, 1)
+}
+
+// renderCompareFilesPane renders changed files with their line stats; the title
+// shows the range form and the totals
+func renderCompareFilesPane(state *CompareState, theme Theme, width, height int) string {
+	var added, deleted int
+	items := make([]ListItem, 0, len(state.Files))
+	for i, file := range state.Files {
+		stat := fmt.Sprintf("+%d -%d", file.Added, file.Deleted)
+		if file.Binary {
+			stat = "binary"
+		}
+		added += file.Added
+		deleted += file.Deleted
+		items = append(items, ListItem{
+			AttributeText:  fileStatusIndicator(file.Status),
+			AttributeColor: theme.DimmedTextColor,
+			ContentText:    file.Path + "  " + stat,
+			ContentColor:   theme.ContentTextColor,
+			IsSelected:     i == state.SelectedFileIdx,
+		})
+	}
+
+	title := fmt.Sprintf("%s%s%s · %d files +%d -%d", state.LeftLabel, state.Mode, state.RightLabel, len(state.Files), added, deleted)
+	listPane := NewListPane(truncateTitle(title, width-4), &theme)
+
+	visibleLines := height - 2
+	if visibleLines < 1 {
+		visibleLines = 1
+	}
+	listPane.AdjustScroll(state.SelectedFileIdx, visibleLines)
+
+	return listPane.Render(items, width, height, state.FocusedPane == ComparePaneFiles, 0, 1)
+}
+
+// renderCompareDiffPane renders the selected file's diff (same layouts as file history)
+func renderCompareDiffPane(state *CompareState, theme Theme, width, height int) string {
+	diffContent := state.DiffContent
+	if diffContent == "" {
+		diffContent = "(no diff available)"
+	}
+	path := ""
+	if state.SelectedFileIdx >= 0 && state.SelectedFileIdx < len(state.Files) {
+		path = state.Files[state.SelectedFileIdx].Path
+	}
+
+	rendered, newScrollOffset := RenderDiffPane(
+		diffContent,
+		width,
+		height,
+		state.DiffLineCursor,
+		state.DiffScrollOff,
+		state.FocusedPane == ComparePaneDiff,
+		state.SplitDiff,
+		&theme,
+		state.VisualModeActive,
+		state.VisualModeStart,
+		lexerFor(state.SyntaxHighlight, path, diffContent),
+	)
+	state.DiffScrollOff = newScrollOffset
+
+	return rendered
+}
+
+// truncateTitle shortens a pane title to width cells, ending in "…" when cut
+func truncateTitle(title string, width int) string {
+	if width < 1 || lipgloss.Width(title) <= width {
+		return title
+	}
+	runes := []rune(title)
+	for len(runes) > 0 && lipgloss.Width(string(runes))+1 > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "…"
+}
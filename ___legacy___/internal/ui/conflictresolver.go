@@ -3,6 +3,8 @@ package ui
 import (
 	"strings"
 
+	"github.com/jrengmusic/tit/internal/syntax"
+
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -21,6 +23,7 @@ type ConflictFileGeneric struct {
 	Path     string
 	Versions []string // Content for each column
 	Chosen   int      // Which column is chosen (0-based)
+	Binary   bool     // Versions hold summaries; the chosen stage is checked out by git
 }
 
 // ========================================
@@ -30,6 +33,9 @@ type ConflictFileGeneric struct {
 // RenderConflictResolveGeneric renders the generic N-column parallel view
 // Top row: N columns showing file lists with checkboxes
 // Bottom row: N columns showing content for selected file
+// With showDiff, each content column marks what differs from the reference version
+// (the first column, or the second for the first column itself) with word-level highlights.
+// With syntaxHighlight, code gets syntax colors by file extension (below the size cutoff).
 // Returns content exactly `width` chars wide and `height - 1` lines tall (footer handled externally)
 func RenderConflictResolveGeneric(
 	files []ConflictFileGeneric,
@@ -42,6 +48,8 @@ func RenderConflictResolveGeneric(
 	width int,
 	height int,
 	theme Theme,
+	showDiff bool,
+	syntaxHighlight bool,
 ) string {
 	if width <= 0 || height <= 0 || numColumns == 0 {
 		return ""
@@ -109,10 +117,15 @@ func RenderConflictResolveGeneric(
 		if col < len(lineCursors) {
 			lineCursor = lineCursors[col]
 		}
-		content := ""
+		content, reference, path := "", "", ""
 		if selectedFileIndex >= 0 && selectedFileIndex < len(files) {
-			if col < len(files[selectedFileIndex].Versions) {
-				content = files[selectedFileIndex].Versions[col]
+			path = files[selectedFileIndex].Path
+			versions := files[selectedFileIndex].Versions
+			if col < len(versions) {
+				content = versions[col]
+			}
+			if ref := referenceColumn(col); ref < len(versions) {
+				reference = versions[ref]
 			}
 		}
 
@@ -124,7 +137,14 @@ func RenderConflictResolveGeneric(
 
 		// Render content column with cursor using SSOT TextPane
 		// No visual mode in conflict resolver
-		paneRendered, newScrollOffset := RenderTextPane(content, columnWidth, bottomRowHeight, lineCursor, scrollOffset, true, isActive, false, &theme, false, 0)
+		lexer := lexerFor(syntaxHighlight, path, content)
+		var paneRendered string
+		var newScrollOffset int
+		if showDiff && numColumns > 1 {
+			paneRendered, newScrollOffset = renderVersionDiffPane(content, reference, columnWidth, bottomRowHeight, lineCursor, scrollOffset, isActive, &theme, lexer)
+		} else {
+			paneRendered, newScrollOffset = renderTextPane(content, columnWidth, bottomRowHeight, lineCursor, scrollOffset, true, isActive, false, &theme, false, 0, lexer)
+		}
 
 		// Update scroll offset in array
 		if col < len(scrollOffsets) {
@@ -145,6 +165,25 @@ func RenderConflictResolveGeneric(
 // Helper Functions
 // ========================================
 
+// referenceColumn returns the column a content column is diffed against
+func referenceColumn(col int) int {
+	if col == 0 {
+		return 1
+	}
+	return 0
+}
+
+// renderVersionDiffPane renders one version with the lines and words that differ
+// from the reference version highlighted (one row per line, so cursors are unchanged)
+func renderVersionDiffPane(content, reference string, width, height, lineCursor, scrollOffset int, isActive bool, theme *Theme, lexer syntax.Lexer) (string, int) {
+	var rows [][]*DiffCell
+	for _, cell := range versionDiffCells(content, reference) {
+		rows = append(rows, []*DiffCell{cell})
+	}
+	highlightCells(rows, lexer)
+	return renderCellRows(rows, width, height, lineCursor, scrollOffset, isActive, theme, false, 0)
+}
+
 // convertFilesToListItems converts conflict files to ListItem format for ListPane
 func convertFilesToListItems(files []ConflictFileGeneric, selectedFileIndex int, columnIndex int, theme *Theme) []ListItem {
 	var items []ListItem
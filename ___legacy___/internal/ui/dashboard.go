@@ -0,0 +1,150 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// DashboardCell is one colored cell of a dashboard row
+type DashboardCell struct {
+	Text  string
+	Color string
+}
+
+// DashboardRow is one repository of the dashboard: name, branch and the 5-axis state
+type DashboardRow struct {
+	Name        DashboardCell
+	Branch      DashboardCell
+	WorkingTree DashboardCell
+	Timeline    DashboardCell
+	Operation   DashboardCell
+	Result      DashboardCell // Outcome of the last batch fetch/pull, empty when none
+}
+
+// DashboardState represents the multi-repository dashboard (full-width table).
+// Repos and Rows are parallel; Rows are rebuilt whenever states are re-detected.
+type DashboardState struct {
+	Repos        []string // Absolute repository roots
+	Rows         []DashboardRow
+	SelectedIdx  int
+	ScrollOffset int
+	Busy         bool   // Detection or a batch fetch/pull is running
+	Status       string // Progress or summary of the last batch, shown in the title
+}
+
+// dashboardColumns are the table headers with their share of the width (percent).
+// The result column takes what is left.
+var dashboardColumns = []struct {
+	title string
+	share int
+}{
+	{"REPOSITORY", 22},
+	{"BRANCH", 18},
+	{"WORKING TREE", 14},
+	{"TIMELINE", 18},
+	{"OPERATION", 12},
+	{"LAST BATCH", 0},
+}
+
+// RenderDashboard renders the dashboard table in a bordered box
+// Returns content exactly `width` chars wide and `height - 1` lines tall (footer handled externally)
+func RenderDashboard(state interface{}, theme Theme, width, height int) string {
+	if width <= 0 || height <= 0 {
+		return ""
+	}
+	dashboard, ok := state.(*DashboardState)
+	if !ok || dashboard == nil {
+		return ""
+	}
+
+	boxHeight := height - SplitPaneHeightOffset
+	contentWidth := width - 4 // Border + padding
+	widths := dashboardColumnWidths(contentWidth)
+
+	title := "Dashboard"
+	if dashboard.Status != "" {
+		title += " · " + dashboard.Status
+	}
+	lines := []string{
+		lipgloss.NewStyle().
+			Width(contentWidth).
+			Align(lipgloss.Center).
+			Bold(true).
+			Foreground(lipgloss.Color(theme.ConflictPaneTitleColor)).
+			Render(TruncateLabel(title, contentWidth)),
+		"",
+	}
+
+	var header []DashboardCell
+	for _, column := range dashboardColumns {
+		header = append(header, DashboardCell{Text: column.title, Color: theme.LabelTextColor})
+	}
+	lines = append(lines, renderDashboardCells(header, widths, lipgloss.NewStyle().Bold(true)))
+
+	visible := boxHeight - 3 // Title, blank line, column header
+	if visible < 1 {
+		visible = 1
+	}
+	if dashboard.SelectedIdx < dashboard.ScrollOffset {
+		dashboard.ScrollOffset = dashboard.SelectedIdx
+	} else if dashboard.SelectedIdx >= dashboard.ScrollOffset+visible {
+		dashboard.ScrollOffset = dashboard.SelectedIdx - visible + 1
+	}
+
+	if len(dashboard.Rows) == 0 {
+		lines = append(lines, lipgloss.NewStyle().
+			Width(contentWidth).
+			Align(lipgloss.Center).
+			Foreground(lipgloss.Color(theme.DimmedTextColor)).
+			Render("No repositories found"))
+	}
+	for i := dashboard.ScrollOffset; i < len(dashboard.Rows) && i < dashboard.ScrollOffset+visible; i++ {
+		row := dashboard.Rows[i]
+		cells := []DashboardCell{row.Name, row.Branch, row.WorkingTree, row.Timeline, row.Operation, row.Result}
+		base := lipgloss.NewStyle()
+		if i == dashboard.SelectedIdx {
+			// Menu convention: dark foreground on the selection background, whole row
+			for j := range cells {
+				cells[j].Color = theme.MainBackgroundColor
+			}
+			base = base.Bold(true).Background(lipgloss.Color(theme.MenuSelectionBackground))
+		}
+		lines = append(lines, renderDashboardCells(cells, widths, base))
+	}
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color(theme.ConflictPaneFocusedBorder)).
+		Width(width-2).
+		Height(boxHeight).
+		Padding(0, 1).
+		Render(strings.Join(lines, "\n"))
+}
+
+// dashboardColumnWidths splits width between the columns by share, the last taking the rest
+func dashboardColumnWidths(width int) []int {
+	widths := make([]int, len(dashboardColumns))
+	used := 0
+	for i, column := range dashboardColumns {
+		if column.share == 0 {
+			continue
+		}
+		widths[i] = width * column.share / 100
+		used += widths[i]
+	}
+	widths[len(widths)-1] = width - used
+	return widths
+}
+
+// renderDashboardCells lays cells out in fixed-width columns, each text truncated to leave a one-space gap
+func renderDashboardCells(cells []DashboardCell, widths []int, base lipgloss.Style) string {
+	var parts []string
+	for i, cell := range cells {
+		parts = append(parts, base.
+			Width(widths[i]).
+			Foreground(lipgloss.Color(cell.Color)).
+			Render(TruncateLabel(cell.Text, widths[i]-1)))
+	}
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,152 @@
+package ui
+
+import (
+	"strings"
+	"unicode"
+)
+
+// DiffSegment is a run of a changed line's text; Changed marks words that
+// differ from the line it is paired with (removed ↔ added)
+type DiffSegment struct {
+	Text    string
+	Changed bool
+}
+
+// maxLCSCells caps the LCS table (rows × columns) so huge files and minified
+// lines stay responsive; past it the unmatched middle is left as is
+const maxLCSCells = 1 << 20
+
+// lcsMatch marks the elements of a and b that belong to a longest common
+// subsequence. The common prefix and suffix are matched directly, the table
+// only covers what lies between.
+func lcsMatch(a, b []string) (matchA, matchB []bool) {
+	matchA = make([]bool, len(a))
+	matchB = make([]bool, len(b))
+
+	start := 0
+	for start < len(a) && start < len(b) && a[start] == b[start] {
+		matchA[start], matchB[start] = true, true
+		start++
+	}
+	endA, endB := len(a), len(b)
+	for endA > start && endB > start && a[endA-1] == b[endB-1] {
+		endA--
+		endB--
+		matchA[endA], matchB[endB] = true, true
+	}
+
+	n, m := endA-start, endB-start
+	if n == 0 || m == 0 || n*m > maxLCSCells {
+		return matchA, matchB
+	}
+
+	// table[i*(m+1)+j] = LCS length of a[start+i:endA] and b[start+j:endB]
+	table := make([]int32, (n+1)*(m+1))
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[start+i] == b[start+j] {
+				table[i*(m+1)+j] = table[(i+1)*(m+1)+j+1] + 1
+			} else {
+				table[i*(m+1)+j] = max(table[(i+1)*(m+1)+j], table[i*(m+1)+j+1])
+			}
+		}
+	}
+	for i, j := 0, 0; i < n && j < m; {
+		switch {
+		case a[start+i] == b[start+j]:
+			matchA[start+i], matchB[start+j] = true, true
+			i++
+			j++
+		case table[(i+1)*(m+1)+j] >= table[i*(m+1)+j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return matchA, matchB
+}
+
+// tokenizeWords splits a line into words (letters, digits, _), whitespace runs
+// and single punctuation characters
+func tokenizeWords(line string) []string {
+	var tokens []string
+	runes := []rune(line)
+	for i := 0; i < len(runes); {
+		j := i + 1
+		switch {
+		case isWordRune(runes[i]):
+			for j < len(runes) && isWordRune(runes[j]) {
+				j++
+			}
+		case unicode.IsSpace(runes[i]):
+			for j < len(runes) && unicode.IsSpace(runes[j]) {
+				j++
+			}
+		}
+		tokens = append(tokens, string(runes[i:j]))
+		i = j
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// intraLineSegments compares a removed line with the added line that replaced
+// it word by word. Returns nil for both when the lines share nothing but
+// whitespace: a rewritten line reads better without highlights.
+func intraLineSegments(removed, added string) (oldSegments, newSegments []DiffSegment) {
+	oldTokens, newTokens := tokenizeWords(removed), tokenizeWords(added)
+	matchOld, matchNew := lcsMatch(oldTokens, newTokens)
+
+	common := false
+	for i, matched := range matchOld {
+		if matched && strings.TrimSpace(oldTokens[i]) != "" {
+			common = true
+			break
+		}
+	}
+	if !common {
+		return nil, nil
+	}
+	return buildSegments(oldTokens, matchOld), buildSegments(newTokens, matchNew)
+}
+
+// buildSegments joins consecutive tokens with the same changed state
+func buildSegments(tokens []string, matched []bool) []DiffSegment {
+	var segments []DiffSegment
+	for i, token := range tokens {
+		changed := !matched[i]
+		if n := len(segments); n > 0 && segments[n-1].Changed == changed {
+			segments[n-1].Text += token
+			continue
+		}
+		segments = append(segments, DiffSegment{Text: token, Changed: changed})
+	}
+	return segments
+}
+
+// markIntraLine pairs each run of removed lines with the run of added lines
+// right after it (first with first) and sets their word-level segments
+func markIntraLine(lines []DiffLine) {
+	for i := 0; i < len(lines); {
+		if lines[i].LineType != "removed" {
+			i++
+			continue
+		}
+		removedEnd := i
+		for removedEnd < len(lines) && lines[removedEnd].LineType == "removed" {
+			removedEnd++
+		}
+		addedEnd := removedEnd
+		for addedEnd < len(lines) && lines[addedEnd].LineType == "added" {
+			addedEnd++
+		}
+		for k := 0; i+k < removedEnd && removedEnd+k < addedEnd; k++ {
+			removed, added := &lines[i+k], &lines[removedEnd+k]
+			removed.Segments, added.Segments = intraLineSegments(removed.Code, added.Code)
+		}
+		i = addedEnd
+	}
+}
@@ -0,0 +1,156 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jrengmusic/tit/internal"
+	"github.com/jrengmusic/tit/internal/syntax"
+)
+
+// segmentsString renders segments with changed text in [brackets]
+func segmentsString(segments []DiffSegment) string {
+	var b strings.Builder
+	for _, s := range segments {
+		if s.Changed {
+			b.WriteString("[" + s.Text + "]")
+		} else {
+			b.WriteString(s.Text)
+		}
+	}
+	return b.String()
+}
+
+func TestIntraLineSegments(t *testing.T) {
+	cases := []struct {
+		name    string
+		removed string
+		added   string
+		wantOld string
+		wantNew string
+	}{
+		{"renamed word", "return oldName(x)", "return newName(x)", "return [oldName](x)", "return [newName](x)"},
+		{"appended argument", "f(a)", "f(a, b)", "f(a)", "f(a[, b])"},
+		{"removed word", "if a && b {", "if a {", "if a [&& b ]{", "if a {"},
+		{"rewritten line", "foo bar", "baz qux", "", ""}, // Nothing in common: no highlights
+		{"whitespace only in common", "a b", "c d", "", ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			oldSegments, newSegments := intraLineSegments(tc.removed, tc.added)
+			if got := segmentsString(oldSegments); got != tc.wantOld {
+				t.Errorf("old: got %q, want %q", got, tc.wantOld)
+			}
+			if got := segmentsString(newSegments); got != tc.wantNew {
+				t.Errorf("new: got %q, want %q", got, tc.wantNew)
+			}
+		})
+	}
+}
+
+func TestLCSMatchOverCap(t *testing.T) {
+	// Middle over maxLCSCells: only prefix and suffix match
+	n := 2000
+	a, b := make([]string, n), make([]string, n)
+	for i := range a {
+		a[i], b[i] = fmt.Sprint("a", i), fmt.Sprint("b", i)
+	}
+	a[0], b[0] = "same", "same"
+	matchA, _ := lcsMatch(a, b)
+	if !matchA[0] || matchA[1] {
+		t.Errorf("got prefix %v, middle %v; want true, false", matchA[0], matchA[1])
+	}
+}
+
+// rowString renders a split row as "old|new" with line numbers and markers
+func rowString(row []*DiffCell) string {
+	var sides []string
+	for _, cell := range row {
+		if cell == nil {
+			sides = append(sides, "")
+			continue
+		}
+		marker := " "
+		switch cell.LineType {
+		case "added":
+			marker = "+"
+		case "removed":
+			marker = "-"
+		}
+		sides = append(sides, fmt.Sprintf("%d%s%s", cell.LineNum, marker, cell.Code))
+	}
+	return strings.Join(sides, "|")
+}
+
+func TestParseSplitDiff(t *testing.T) {
+	diff := strings.Join([]string{
+		"diff --git a/f.go b/f.go",
+		"index 1111111..2222222 100644",
+		"--- a/f.go",
+		"+++ b/f.go",
+		"@@ -10,4 +10,4 @@ func f() {",
+		" a",
+		"-b",
+		"-c",
+		"+B",
+		" d",
+		"+e",
+		"\\ No newline at end of file",
+	}, "\n")
+
+	var got []string
+	for _, row := range parseSplitDiff(diff) {
+		got = append(got, rowString(row))
+	}
+	want := []string{
+		"10 a|10 a",
+		"11-b|11+B", // Removed and added runs pair up
+		"12-c|",
+		"13 d|12 d",
+		"|13+e",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("rows:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	selected := GetSelectedLinesFromSplitDiff(diff, 2, 0)
+	if strings.Join(selected, ",") != " a,-b,+B,-c" {
+		t.Errorf("selected lines: got %v", selected)
+	}
+}
+
+func TestVersionDiffCells(t *testing.T) {
+	cells := versionDiffCells("a\nx = 2\nc\nnew", "a\nx = 1\nc")
+	var got []string
+	for _, cell := range cells {
+		got = append(got, rowString([]*DiffCell{cell})+" "+segmentsString(cell.Segments))
+	}
+	want := []string{"1 a ", "2+x = 2 x = [2]", "3 c ", "4+new "}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("cells:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestComposeRuns(t *testing.T) {
+	code := `x := "a" + b`
+	segments := []DiffSegment{{Text: `x := `}, {Text: `"a" + b`, Changed: true}}
+	tokens := []syntax.Token{{Text: `x := `}, {Text: `"a"`, Kind: syntax.String}, {Text: ` + b`}}
+
+	var got []string
+	for _, run := range composeRuns(code, segments, tokens) {
+		got = append(got, fmt.Sprintf("%q/%d/%v", run.text, run.kind, run.changed))
+	}
+	want := []string{`"x := "/0/false`, `"\"a\""/3/true`, `" + b"/0/true`}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("runs: got %v, want %v", got, want)
+	}
+
+	if lexer := lexerFor(true, "main.go", strings.Repeat("x", internal.SyntaxHighlightMaxBytes+1)); lexer != nil {
+		t.Errorf("lexer above the size cutoff: got %s, want none", lexer.Name())
+	}
+	if lexer := lexerFor(false, "main.go", code); lexer != nil {
+		t.Errorf("lexer with highlighting off: got %s, want none", lexer.Name())
+	}
+}
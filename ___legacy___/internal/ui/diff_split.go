@@ -0,0 +1,371 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jrengmusic/tit/internal/syntax"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// DiffCell is one line of one side in a side-by-side diff (or one line of a
+// conflict version compared with another version)
+type DiffCell struct {
+	LineNum  int    // Line number on this side (0 = none)
+	Code     string // Line content without the diff marker
+	LineType string // "added", "removed", or "context"
+
+	// Word-level segments when paired with the changed line on the other side; nil otherwise
+	Segments []DiffSegment
+
+	HunkStart bool           // First line of its side after an @@ header
+	Tokens    []syntax.Token // Syntax tokens of Code; nil when not highlighted
+}
+
+// diffTabWidth is how many spaces a tab takes in cut-to-width diff columns
+const diffTabWidth = 4
+
+// RenderDiffPane renders diff content in the unified layout (line# + marker +
+// code) or the split layout (old | new side by side, aligned by hunk).
+// A non-nil lexer adds syntax colors.
+func RenderDiffPane(
+	content string,
+	width int,
+	height int,
+	lineCursor int,
+	scrollOffset int,
+	isActive bool,
+	split bool,
+	theme *Theme,
+	visualModeActive bool,
+	visualModeStart int,
+	lexer syntax.Lexer,
+) (rendered string, newScrollOffset int) {
+	if !split {
+		return renderTextPane(content, width, height, lineCursor, scrollOffset, false, isActive, true, theme, visualModeActive, visualModeStart, lexer)
+	}
+	rows := parseSplitDiff(content)
+	highlightCells(rows, lexer)
+	return renderCellRows(rows, width, height, lineCursor, scrollOffset, isActive, theme, visualModeActive, visualModeStart)
+}
+
+// parseSplitDiff parses a unified diff into [old, new] rows. Within a hunk, each
+// run of removed lines is paired line by line with the added lines after it; a
+// nil cell pads the shorter side.
+func parseSplitDiff(diffContent string) [][]*DiffCell {
+	if diffContent == "" {
+		return nil
+	}
+
+	var rows [][]*DiffCell
+	var removed, added []*DiffCell
+	var oldNum, newNum int
+	oldHunk, newHunk := false, false // Next cell on that side starts a hunk
+
+	flush := func() {
+		for i := 0; i < len(removed) || i < len(added); i++ {
+			var left, right *DiffCell
+			if i < len(removed) {
+				left = removed[i]
+			}
+			if i < len(added) {
+				right = added[i]
+			}
+			if left != nil && right != nil {
+				left.Segments, right.Segments = intraLineSegments(left.Code, right.Code)
+			}
+			rows = append(rows, []*DiffCell{left, right})
+		}
+		removed, added = nil, nil
+	}
+
+	for _, line := range strings.Split(diffContent, "\n") {
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			flush()
+			oldNum, newNum = hunkStart(line)
+			oldHunk, newHunk = true, true
+		case line == "" || isDiffMetadata(line) || strings.HasPrefix(line, "\\"):
+			// "\ No newline at end of file" belongs to neither side
+		case strings.HasPrefix(line, "-"):
+			removed = append(removed, &DiffCell{LineNum: oldNum, Code: line[1:], LineType: "removed", HunkStart: oldHunk})
+			oldNum++
+			oldHunk = false
+		case strings.HasPrefix(line, "+"):
+			added = append(added, &DiffCell{LineNum: newNum, Code: line[1:], LineType: "added", HunkStart: newHunk})
+			newNum++
+			newHunk = false
+		default:
+			flush()
+			code := strings.TrimPrefix(line, " ")
+			rows = append(rows, []*DiffCell{
+				{LineNum: oldNum, Code: code, LineType: "context", HunkStart: oldHunk},
+				{LineNum: newNum, Code: code, LineType: "context", HunkStart: newHunk},
+			})
+			oldNum++
+			newNum++
+			oldHunk, newHunk = false, false
+		}
+	}
+	flush()
+
+	return rows
+}
+
+// GetSelectedLinesFromSplitDiff returns the rows in visual selection of the
+// split layout as unified lines (removed side before added side)
+func GetSelectedLinesFromSplitDiff(diffContent string, visualModeStart int, visualModeEnd int) []string {
+	rows := parseSplitDiff(diffContent)
+	minRow, maxRow := visualModeStart, visualModeEnd
+	if minRow > maxRow {
+		minRow, maxRow = maxRow, minRow
+	}
+	if minRow < 0 {
+		minRow = 0
+	}
+
+	var selectedLines []string
+	for i := minRow; i <= maxRow && i < len(rows); i++ {
+		left, right := rows[i][0], rows[i][1]
+		if left != nil && left.LineType == "context" {
+			selectedLines = append(selectedLines, " "+left.Code)
+			continue
+		}
+		if left != nil {
+			selectedLines = append(selectedLines, "-"+left.Code)
+		}
+		if right != nil {
+			selectedLines = append(selectedLines, "+"+right.Code)
+		}
+	}
+	return selectedLines
+}
+
+// versionDiffCells compares one file version with a reference version line by
+// line: lines the reference lacks are "added", and each run of them is paired
+// with the reference's own run at the same place for word-level highlights
+func versionDiffCells(content, reference string) []*DiffCell {
+	lines := strings.Split(content, "\n")
+	refLines := strings.Split(reference, "\n")
+	match, refMatch := lcsMatch(lines, refLines)
+
+	cells := make([]*DiffCell, len(lines))
+	for i, line := range lines {
+		lineType := "context"
+		if !match[i] {
+			lineType = "added"
+		}
+		cells[i] = &DiffCell{LineNum: i + 1, Code: line, LineType: lineType}
+	}
+
+	for i, j := 0, 0; i < len(lines); {
+		if match[i] {
+			// Skip reference-only lines up to this line's partner
+			for j < len(refLines) && !refMatch[j] {
+				j++
+			}
+			i++
+			j++
+			continue
+		}
+		end, refEnd := i, j
+		for end < len(lines) && !match[end] {
+			end++
+		}
+		for refEnd < len(refLines) && !refMatch[refEnd] {
+			refEnd++
+		}
+		for k := 0; i+k < end && j+k < refEnd; k++ {
+			_, cells[i+k].Segments = intraLineSegments(refLines[j+k], lines[i+k])
+		}
+		i, j = end, refEnd
+	}
+	return cells
+}
+
+// renderCellRows renders rows of cells side by side (one column per cell, "│"
+// between columns, nil cells blank) in the text pane box, with the row cursor
+// and visual selection. Rows never wrap: code is cut to the column width.
+func renderCellRows(
+	rows [][]*DiffCell,
+	width int,
+	height int,
+	lineCursor int,
+	scrollOffset int,
+	isActive bool,
+	theme *Theme,
+	visualModeActive bool,
+	visualModeStart int,
+) (rendered string, newScrollOffset int) {
+	if len(rows) == 0 {
+		return renderEmptyPane(width, height, isActive, theme), 0
+	}
+
+	// Clamp cursor
+	if lineCursor < 0 {
+		lineCursor = 0
+	}
+	if lineCursor >= len(rows) {
+		lineCursor = len(rows) - 1
+	}
+	// Each row is one physical line, so the whole pane height is the window
+	scrollOffset = scrollToCursor(len(rows), lineCursor, scrollOffset, max(height, 1))
+
+	// Column widths: separators between columns, remainder to the last column
+	contentWidth := width - 4
+	columns := len(rows[0])
+	columnWidth := (contentWidth - (columns - 1)) / columns
+	lastColumnWidth := contentWidth - (columns-1)*(columnWidth+1)
+	numWidth := lineNumberWidth(rows)
+	separator := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.DimmedTextColor)).Render("│")
+
+	minSelected, maxSelected := visualModeStart, lineCursor
+	if minSelected > maxSelected {
+		minSelected, maxSelected = maxSelected, minSelected
+	}
+
+	var renderedLines []string
+	for i := scrollOffset; i < len(rows) && i < scrollOffset+height; i++ {
+		isCursor := i == lineCursor && isActive
+		inVisual := visualModeActive && isActive && i >= minSelected && i <= maxSelected
+		var parts []string
+		for col, cell := range rows[i] {
+			w := columnWidth
+			if col == columns-1 {
+				w = lastColumnWidth
+			}
+			parts = append(parts, renderDiffCell(cell, numWidth, w, theme, isCursor || inVisual, isCursor && !visualModeActive))
+		}
+		renderedLines = append(renderedLines, strings.Join(parts, separator))
+	}
+
+	return renderPaneBox(strings.Join(renderedLines, "\n"), width, height, isActive, theme), scrollOffset
+}
+
+// lineNumberWidth returns the digits needed for the largest line number (at least 4)
+func lineNumberWidth(rows [][]*DiffCell) int {
+	largest := 0
+	for _, row := range rows {
+		for _, cell := range row {
+			if cell != nil && cell.LineNum > largest {
+				largest = cell.LineNum
+			}
+		}
+	}
+	return max(len(fmt.Sprint(largest)), 4)
+}
+
+// renderDiffCell renders a cell as line# + marker + code, exactly width cells wide
+func renderDiffCell(cell *DiffCell, numWidth, width int, theme *Theme, selected, bold bool) string {
+	selection := lipgloss.NewStyle().
+		Width(width).
+		Foreground(lipgloss.Color(theme.MainBackgroundColor)).
+		Background(lipgloss.Color(theme.MenuSelectionBackground)).
+		Bold(bold)
+	if cell == nil {
+		if selected {
+			return selection.Render("")
+		}
+		return strings.Repeat(" ", width)
+	}
+
+	num := strings.Repeat(" ", numWidth)
+	if cell.LineNum > 0 {
+		num = fmt.Sprintf("%*d", numWidth, cell.LineNum)
+	}
+	marker := "  "
+	switch cell.LineType {
+	case "added":
+		marker = "+ "
+	case "removed":
+		marker = "- "
+	}
+	codeWidth := max(width-numWidth-len(marker), 0)
+
+	if selected {
+		return selection.Render(num + marker + fitCells(expandDiffTabs(cell.Code), codeWidth))
+	}
+	color := diffLineColor(cell.LineType, theme)
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(theme.DimmedTextColor)).Render(num) +
+		lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render(marker) +
+		renderDiffCode(cell.Code, cell.Segments, cell.Tokens, codeWidth, color, inlineBackground(cell.LineType, theme), theme)
+}
+
+// renderDiffCode styles a diff line's code in color, with syntax tokens in their
+// theme colors and changed segments on the inline background. width >= 0 cuts or
+// pads the code to that many cells; -1 leaves its length as is.
+func renderDiffCode(code string, segments []DiffSegment, tokens []syntax.Token, width int, color, inlineBg string, theme *Theme) string {
+	var b strings.Builder
+	used := 0
+	for _, run := range composeRuns(code, segments, tokens) {
+		text := run.text
+		if width >= 0 {
+			text = fitCells(expandDiffTabs(text), width-used)
+		}
+		if text == "" {
+			continue
+		}
+		used += lipgloss.Width(text)
+		style := lipgloss.NewStyle().Foreground(lipgloss.Color(color))
+		if tokenColor := syntaxColor(run.kind, theme); tokenColor != "" {
+			style = style.Foreground(lipgloss.Color(tokenColor))
+		}
+		if run.changed && inlineBg != "" {
+			style = style.Background(lipgloss.Color(inlineBg))
+		}
+		b.WriteString(style.Render(text))
+	}
+	if width > used {
+		b.WriteString(strings.Repeat(" ", width-used))
+	}
+	return b.String()
+}
+
+// diffLineColor returns the text color for a diff line type
+func diffLineColor(lineType string, theme *Theme) string {
+	switch lineType {
+	case "added":
+		return theme.DiffAddedLineColor
+	case "removed":
+		return theme.DiffRemovedLineColor
+	default:
+		return theme.ContentTextColor
+	}
+}
+
+// inlineBackground returns the background for changed words of a diff line type
+func inlineBackground(lineType string, theme *Theme) string {
+	switch lineType {
+	case "added":
+		return theme.DiffAddedInlineBackground
+	case "removed":
+		return theme.DiffRemovedInlineBackground
+	default:
+		return ""
+	}
+}
+
+// expandDiffTabs replaces tabs so cut-to-width columns measure what they show
+func expandDiffTabs(text string) string {
+	return strings.ReplaceAll(text, "\t", strings.Repeat(" ", diffTabWidth))
+}
+
+// fitCells returns the longest prefix of text that fits in width display cells
+func fitCells(text string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if lipgloss.Width(text) <= width {
+		return text
+	}
+	used := 0
+	for i, r := range text {
+		w := lipgloss.Width(string(r))
+		if used+w > width {
+			return text[:i]
+		}
+		used += w
+	}
+	return text
+}
@@ -31,6 +31,8 @@ type FileHistoryState struct {
 	DiffContent       string          // Current diff content (populated by handlers on file/commit selection)
 	VisualModeActive  bool            // True when visual mode is active (for selecting lines)
 	VisualModeStart   int             // Starting line of visual selection
+	SplitDiff         bool            // True = side-by-side diff layout, false = unified
+	SyntaxHighlight   bool            // True = syntax colors in the diff pane (preference)
 }
 
 // RenderFileHistorySplitPane renders the file(s) history split-pane view (3-pane layout)
@@ -106,20 +108,8 @@ func renderFileHistoryFilesPane(state *FileHistoryState, theme Theme, width, hei
 	// Build list items from files in selected commit
 	var items []ListItem
 	for i, file := range state.Files {
-		// Show status indicator and filename
-		statusIndicator := " "
-		if file.Status == "M" {
-			statusIndicator = "✓" // Modified
-		} else if file.Status == "A" {
-			statusIndicator = "+" // Added
-		} else if file.Status == "D" {
-			statusIndicator = "-" // Deleted
-		} else if file.Status == "R" {
-			statusIndicator = "→" // Renamed
-		}
-
 		items = append(items, ListItem{
-			AttributeText:  statusIndicator,
+			AttributeText:  fileStatusIndicator(file.Status),
 			AttributeColor: theme.DimmedTextColor,
 			ContentText:    file.Path,
 			ContentColor:   theme.ContentTextColor,
@@ -141,7 +131,31 @@ func renderFileHistoryFilesPane(state *FileHistoryState, theme Theme, width, hei
 	return listPane.Render(items, width, height, state.FocusedPane == PaneFiles, 0, 1)
 }
 
-// renderFileHistoryDiffPane renders the diff pane with 3-column layout (line# + marker + code)
+// fileStatusIndicator is the one-character marker shown before a changed file
+func fileStatusIndicator(status string) string {
+	switch status {
+	case "M":
+		return "✓" // Modified
+	case "A":
+		return "+" // Added
+	case "D":
+		return "-" // Deleted
+	case "R":
+		return "→" // Renamed
+	}
+	return " "
+}
+
+// selectedFilePath returns the path of the selected file ("" when none)
+func (s *FileHistoryState) selectedFilePath() string {
+	if s.SelectedFileIdx < 0 || s.SelectedFileIdx >= len(s.Files) {
+		return ""
+	}
+	return s.Files[s.SelectedFileIdx].Path
+}
+
+// renderFileHistoryDiffPane renders the diff pane in the unified 3-column layout
+// (line# + marker + code) or side by side
 func renderFileHistoryDiffPane(state *FileHistoryState, theme Theme, width, height int) string {
 	// Get diff content from state (populated by handlers on file/commit selection)
 	// If no diff yet, show placeholder
@@ -150,22 +164,21 @@ func renderFileHistoryDiffPane(state *FileHistoryState, theme Theme, width, heig
 		diffContent = "(no diff available)"
 	}
 
-	// Use RenderTextPane with isDiff=true for proper 3-column diff layout
-	// This reuses the proven scrolling and height calculations from Session 52
+	// RenderDiffPane reuses the proven scrolling and height calculations from Session 52
 	isActive := state.FocusedPane == PaneDiff
 
-	rendered, newScrollOffset := RenderTextPane(
+	rendered, newScrollOffset := RenderDiffPane(
 		diffContent,
 		width,
 		height,
 		state.DiffLineCursor,
 		state.DiffScrollOff,
-		false, // showLineNumbers - diff has its own line# column
 		isActive,
-		true, // isDiff - enable 3-column diff parsing and styling
+		state.SplitDiff,
 		&theme,
 		state.VisualModeActive,
 		state.VisualModeStart,
+		lexerFor(state.SyntaxHighlight, state.selectedFilePath(), diffContent),
 	)
 
 	// Update scroll offset
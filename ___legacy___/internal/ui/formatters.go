@@ -16,6 +16,21 @@ func PadLineToWidth(line string, width int) string {
 	return line
 }
 
+// TruncateLabel shortens label to width display cells, ending in "…" when cut
+func TruncateLabel(label string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if lipgloss.Width(label) <= width {
+		return label
+	}
+	runes := []rune(label)
+	for len(runes) > 0 && lipgloss.Width(string(runes))+1 > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "…"
+}
+
 // RightAlignLine right-aligns content within width
 func RightAlignLine(content string, width int) string {
 	contentWidth := lipgloss.Width(content)
@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Fuzzy match scoring: every matched rune scores 1, plus these bonuses
+const (
+	fuzzyConsecutiveBonus = 4 // Matched rune directly follows the previous match
+	fuzzyBoundaryBonus    = 3 // Matched rune starts a word (after / - _ . space or at the start)
+)
+
+// FuzzyScore matches query as a case-insensitive subsequence of text.
+// Returns false when some query rune cannot be matched in order; higher scores are better.
+// An empty query matches everything with score 0.
+func FuzzyScore(query, text string) (int, bool) {
+	query = strings.ToLower(query)
+	text = strings.ToLower(text)
+
+	score := 0
+	afterMatch := -1 // Byte index just past the previous match
+	pos := 0
+	for _, q := range query {
+		found := false
+		for pos < len(text) {
+			r, size := utf8.DecodeRuneInString(text[pos:])
+			if r == q {
+				score++
+				if pos == afterMatch {
+					score += fuzzyConsecutiveBonus
+				}
+				if pos == 0 || strings.ContainsRune("/-_. ", rune(text[pos-1])) {
+					score += fuzzyBoundaryBonus
+				}
+				pos += size
+				afterMatch = pos
+				found = true
+				break
+			}
+			pos += size
+		}
+		if !found {
+			return 0, false
+		}
+	}
+	return score, true
+}
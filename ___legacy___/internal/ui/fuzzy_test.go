@@ -0,0 +1,30 @@
+package ui
+
+import "testing"
+
+func TestFuzzyScore(t *testing.T) {
+	cases := []struct {
+		query, text string
+		wantMatch   bool
+	}{
+		{"", "/src/tit", true},
+		{"tit", "/src/tit", true},
+		{"TIT", "/src/tit", true},
+		{"stt", "/src/tit", true},
+		{"tts", "/src/tit", false}, // Out of order
+		{"titx", "/src/tit", false},
+		{"é", "/src/café", true},
+	}
+	for _, tc := range cases {
+		if _, ok := FuzzyScore(tc.query, tc.text); ok != tc.wantMatch {
+			t.Errorf("FuzzyScore(%q, %q) matched=%v, want %v", tc.query, tc.text, ok, tc.wantMatch)
+		}
+	}
+
+	// Contiguous word-start matches outrank scattered ones
+	tight, _ := FuzzyScore("api", "/work/api")
+	loose, _ := FuzzyScore("api", "/work/a-project-index")
+	if tight <= loose {
+		t.Errorf("contiguous match scored %d, scattered %d; want contiguous higher", tight, loose)
+	}
+}
@@ -32,6 +32,9 @@ type HeaderState struct {
 	SyncFrame        int    // Animation frame for spinner
 	LFSLabel         string // "LFS", "LFS ⚠", or "" (empty = no LFS in repo)
 	LFSColor         string // Color for LFS badge
+	SparseLabel      string // "SPARSE", or "" (empty = full checkout)
+	SparseColor      string // Color for sparse checkout badge
+	IdentityLabel    string // "👤 profile · email", or "" (no identity configured)
 }
 
 // TimelineSyncSpinner returns spinner frame based on animation frame
@@ -133,6 +136,12 @@ func RenderHeaderInfo(sizing DynamicSizing, theme Theme, state HeaderState) stri
 			Render(state.LFSLabel)
 		rightContent = lfsPart + "  " + versionPart
 	}
+	if state.SparseLabel != "" {
+		sparsePart := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(state.SparseColor)).
+			Render(state.SparseLabel)
+		rightContent = sparsePart + "  " + rightContent
+	}
 	versionStyled := lipgloss.NewStyle().
 		Align(lipgloss.Right).
 		Width(rightWidth).
@@ -164,11 +173,18 @@ func RenderHeaderInfo(sizing DynamicSizing, theme Theme, state HeaderState) stri
 	// Timeline label (with spinner when syncing)
 	tlLabel := TimelineSyncLabel(state.TimelineEmoji, state.TimelineLabel, state.SyncInProgress, state.SyncFrame)
 	tlLabelLine := lipgloss.NewStyle().
-		Width(totalWidth).
 		Bold(true).
 		Foreground(lipgloss.Color(state.TimelineColor)).
 		Render(tlLabel)
-	fullWidthLines = append(fullWidthLines, tlLabelLine)
+
+	// Identity commits are recorded with, right-aligned in the space the label leaves
+	identityWidth := totalWidth - lipgloss.Width(tlLabelLine)
+	identityLine := lipgloss.NewStyle().
+		Width(identityWidth).
+		Align(lipgloss.Right).
+		Foreground(lipgloss.Color(theme.DimmedTextColor)).
+		Render(TruncateLabel(state.IdentityLabel, identityWidth-2))
+	fullWidthLines = append(fullWidthLines, lipgloss.JoinHorizontal(lipgloss.Top, tlLabelLine, identityLine))
 
 	// Timeline descriptions (indented) - show sync message or actual descriptions
 	if state.SyncInProgress {
@@ -3,6 +3,7 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/jrengmusic/tit/internal/git"
@@ -13,13 +14,15 @@ type CommitInfo = git.CommitInfo
 
 // HistoryState represents the state of the history browser
 type HistoryState struct {
-	Commits           []CommitInfo // List of recent commits
-	SelectedIdx       int          // Currently selected commit (0-indexed)
-	PaneFocused       bool         // true = list pane, false = details pane
-	DetailsLineCursor int          // Line cursor position in details pane
-	DetailsScrollOff  int          // Scroll offset for details pane
-	CopyHashMode      bool         // True when copy-hash-by-char mode is active
-	CopyHashFull      bool         // True = copy full hash (Y), false = copy short hash (y)
+	Commits           []CommitInfo               // List of recent commits
+	SelectedIdx       int                        // Currently selected commit (0-indexed)
+	PaneFocused       bool                       // true = list pane, false = details pane
+	DetailsLineCursor int                        // Line cursor position in details pane
+	DetailsScrollOff  int                        // Scroll offset for details pane
+	CopyHashMode      bool                       // True when copy-hash-by-char mode is active
+	CopyHashFull      bool                       // True = copy full hash (Y), false = copy short hash (y)
+	ShallowBoundary   map[string]bool            // Full hashes whose parents were not fetched (shallow clone)
+	Runs              map[string][]git.RunRecord // Recorded repository command results by full hash
 }
 
 // CopyHashKey represents a flash label for a visible commit
@@ -160,8 +163,12 @@ func buildCommitListItems(commits []CommitInfo, selectedIdx int, theme Theme, co
 
 // renderHistoryListPane renders the list pane with commit list (matches Conflict Resolver)
 func renderHistoryListPane(state *HistoryState, theme Theme, width, height int) string {
-	// Create list pane for commits
-	listPane := NewListPane("Commits", &theme)
+	// Create list pane for commits (title flags truncated history)
+	title := "Commits"
+	if len(state.ShallowBoundary) > 0 {
+		title = "Commits (shallow)"
+	}
+	listPane := NewListPane(title, &theme)
 
 	visibleLines := height - 2
 	if visibleLines < 1 {
@@ -181,33 +188,98 @@ func renderHistoryListPane(state *HistoryState, theme Theme, width, height int)
 	// Build list items from actual commits
 	items := buildCommitListItems(state.Commits, state.SelectedIdx, theme, copyHashKeys)
 
+	// Mark shallow boundary commits: history stops here
+	for i, commit := range state.Commits {
+		if state.ShallowBoundary[commit.Hash] {
+			items[i].AttributeColor = theme.OutputWarningColor
+			items[i].ContentBold = true
+		}
+	}
+
+	// Color commits with recorded command results: green when all passed, red otherwise
+	for i, commit := range state.Commits {
+		if runs := state.Runs[commit.Hash]; len(runs) > 0 {
+			items[i].ContentColor = theme.DiffAddedLineColor
+			if !allRunsPassed(runs) {
+				items[i].ContentColor = theme.DiffRemovedLineColor
+			}
+		}
+	}
+
 	// Render list pane (active when list pane is focused)
 	// Pass 0, 1 for column positioning (single column layout, treat as col 0 of 1)
 	return listPane.Render(items, width, height, state.PaneFocused, 0, 1)
 }
 
-// renderHistoryDetailsPane renders the details pane with commit details using SSOT TextPane
-func renderHistoryDetailsPane(state *HistoryState, theme Theme, width, height int) string {
-	// Build content string
+// historyDetailsLines builds the details pane lines for the selected commit
+func historyDetailsLines(state *HistoryState) []string {
+	if len(state.Commits) == 0 || state.SelectedIdx < 0 || state.SelectedIdx >= len(state.Commits) {
+		return []string{"(no commit selected)"}
+	}
+	commit := state.Commits[state.SelectedIdx]
+
+	// No "Commit: hash" line - redundant (hash already shown in list)
 	var lines []string
+	lines = append(lines, fmt.Sprintf("Author: Unknown"))
+	lines = append(lines, fmt.Sprintf("Date:   %s", commit.Time.Format("Mon, 2 Jan 2006 15:04:05 -0700")))
+	lines = append(lines, "")
+
+	// Split subject into multiple lines if it contains newlines or is too long
+	// This allows proper scrolling through long commit messages
+	subjectLines := strings.Split(commit.Subject, "\n")
+	lines = append(lines, subjectLines...)
+
+	if state.ShallowBoundary[commit.Hash] {
+		lines = append(lines,
+			"",
+			"SHALLOW BOUNDARY",
+			"  Older history was not fetched by the shallow clone.",
+			"  Deepen history (d) or Fetch full history (D) from the menu to go further.",
+		)
+	}
 
-	// Show commit details if available
-	if len(state.Commits) > 0 && state.SelectedIdx >= 0 && state.SelectedIdx < len(state.Commits) {
-		commit := state.Commits[state.SelectedIdx]
+	if runs := state.Runs[commit.Hash]; len(runs) > 0 {
+		lines = append(lines, "", "COMMAND RESULTS")
+		for _, run := range runs {
+			lines = append(lines, "  "+FormatRunRecord(run))
+		}
+	}
+	return lines
+}
 
-		// No "Commit: hash" line - redundant (hash already shown in list)
-		lines = append(lines, fmt.Sprintf("Author: Unknown"))
-		lines = append(lines, fmt.Sprintf("Date:   %s", commit.Time.Format("Mon, 2 Jan 2006 15:04:05 -0700")))
-		lines = append(lines, "")
+// HistoryDetailsLineCount returns the number of lines the details pane shows for the selected commit
+func HistoryDetailsLineCount(state *HistoryState) int {
+	return len(historyDetailsLines(state))
+}
 
-		// Split subject into multiple lines if it contains newlines or is too long
-		// This allows proper scrolling through long commit messages
-		subjectLines := strings.Split(commit.Subject, "\n")
-		lines = append(lines, subjectLines...)
-	} else {
-		lines = append(lines, "(no commit selected)")
+// FormatRunRecord renders a command result, e.g. "✓ build passed in 1.5s (18-Oct 14:02)"
+func FormatRunRecord(run git.RunRecord) string {
+	mark, verdict := "✓", "passed"
+	if !run.Passed {
+		mark, verdict = "✗", "failed"
 	}
+	return fmt.Sprintf("%s %s %s in %s (%s)", mark, run.Command, verdict,
+		FormatRunDuration(run.Duration), run.At.Format("02-Jan 15:04"))
+}
+
+// FormatRunDuration renders a command duration to a tenth of a second, e.g. "1.5s"
+func FormatRunDuration(d time.Duration) string {
+	return d.Round(100 * time.Millisecond).String()
+}
 
+// allRunsPassed reports whether every recorded command passed
+func allRunsPassed(runs []git.RunRecord) bool {
+	for _, run := range runs {
+		if !run.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// renderHistoryDetailsPane renders the details pane with commit details using SSOT TextPane
+func renderHistoryDetailsPane(state *HistoryState, theme Theme, width, height int) string {
+	lines := historyDetailsLines(state)
 	content := strings.Join(lines, "\n")
 
 	// Use SSOT TextPane with line cursor (like Conflict Resolver diff pane)
@@ -0,0 +1,150 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// LFSRowKind distinguishes tracked patterns from the LFS files listed after them
+type LFSRowKind int
+
+const (
+	LFSRowPattern LFSRowKind = iota // .gitattributes pattern with filter=lfs
+	LFSRowFile                      // File stored in LFS
+)
+
+// LFSRow is one line of the LFS list
+type LFSRow struct {
+	Kind         LFSRowKind
+	Path         string // Pattern for pattern rows, repository-relative path for files
+	Materialized bool   // Files only: real content checked out (false = pointer only)
+	LockOwner    string // Files only: lock holder, empty if unlocked
+}
+
+// LFSState represents the LFS management view (2-pane split-view)
+// Mirrors BranchPickerState: list pane (left) + details pane (right).
+// Details are built by the app layer because they need git (files per pattern, locks).
+type LFSState struct {
+	Rows              []LFSRow
+	SelectedIdx       int
+	PaneFocused       bool     // true = list pane, false = details pane
+	ListScrollOffset  int      // Scroll offset for row list
+	Details           []string // Details pane lines for the selected row
+	Status            string   // Result of the last action, shown above the details
+	DetailsLineCursor int      // Line cursor position in details pane
+	DetailsScrollOff  int      // Scroll offset for details pane
+}
+
+// Selected returns the selected row, or false when the list is empty
+func (s *LFSState) Selected() (LFSRow, bool) {
+	if s == nil || s.SelectedIdx < 0 || s.SelectedIdx >= len(s.Rows) {
+		return LFSRow{}, false
+	}
+	return s.Rows[s.SelectedIdx], true
+}
+
+// RenderLFSSplitPane renders the LFS view (patterns + files list, details)
+// Uses SSOT: ListPane (left) + TextPane (right) matching the branch picker
+// Returns content exactly `width` chars wide and `height - 1` lines tall (footer handled externally)
+func RenderLFSSplitPane(state interface{}, theme Theme, width, height int) string {
+	if width <= 0 || height <= 0 {
+		return ""
+	}
+
+	lfsState, ok := state.(*LFSState)
+	if !ok || lfsState == nil {
+		return ""
+	}
+
+	paneHeight := height - SplitPaneHeightOffset
+
+	listPaneWidth := width / 2
+	detailsPaneWidth := width - listPaneWidth
+
+	listPaneContent := renderLFSListPane(lfsState, &theme, listPaneWidth, paneHeight)
+	detailsPaneContent := renderLFSDetailsPane(lfsState, &theme, detailsPaneWidth, paneHeight)
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, listPaneContent, detailsPaneContent)
+}
+
+// buildLFSListItems creates ListItems from LFS rows
+// Patterns are bold; files show checkout state (● materialized, ○ pointer) and lock marker
+func buildLFSListItems(rows []LFSRow, selectedIdx int, theme *Theme) []ListItem {
+	items := make([]ListItem, len(rows))
+	for i, row := range rows {
+		item := ListItem{
+			AttributeColor: theme.DimmedTextColor,
+			ContentText:    row.Path,
+			ContentColor:   theme.ContentTextColor,
+			IsSelected:     i == selectedIdx,
+		}
+
+		switch row.Kind {
+		case LFSRowPattern:
+			item.AttributeText = "track  "
+			item.AttributeColor = theme.AccentTextColor
+			item.ContentBold = true
+		default:
+			if row.Materialized {
+				item.AttributeText = "● file "
+			} else {
+				item.AttributeText = "○ ptr  "
+				item.ContentColor = theme.DimmedTextColor
+			}
+			if row.LockOwner != "" {
+				item.ContentText = "🔒 " + row.Path
+			}
+		}
+		items[i] = item
+	}
+	return items
+}
+
+// renderLFSListPane renders the pattern and file list using SSOT ListPane
+func renderLFSListPane(state *LFSState, theme *Theme, width, height int) string {
+	listPane := NewListPane("Git LFS", theme)
+	listPane.ScrollOffset = state.ListScrollOffset
+
+	items := buildLFSListItems(state.Rows, state.SelectedIdx, theme)
+
+	visibleLines := height - 2
+	if visibleLines < 1 {
+		visibleLines = 1
+	}
+
+	listPane.AdjustScroll(state.SelectedIdx, visibleLines)
+	state.ListScrollOffset = listPane.ScrollOffset
+
+	return listPane.Render(items, width, height, state.PaneFocused, 0, 1)
+}
+
+// renderLFSDetailsPane renders the last action result and the selected row's details
+func renderLFSDetailsPane(state *LFSState, theme *Theme, width, height int) string {
+	var lines []string
+	if state.Status != "" {
+		lines = append(lines, state.Status, "")
+	}
+	if len(state.Details) > 0 {
+		lines = append(lines, state.Details...)
+	} else {
+		lines = append(lines, "(no LFS patterns tracked — press a to add one)")
+	}
+
+	rendered, newScrollOffset := RenderTextPane(
+		strings.Join(lines, "\n"),
+		width,
+		height,
+		state.DetailsLineCursor,
+		state.DetailsScrollOff,
+		false,              // No line numbers
+		!state.PaneFocused, // Active when list is NOT focused
+		false,              // Not diff mode
+		theme,
+		false, // No visual mode in LFS view
+		0,
+	)
+	state.DetailsScrollOff = newScrollOffset
+
+	return rendered
+}
@@ -28,11 +28,16 @@ func BuildPreferenceRows(cfg *config.Config) []PreferenceRow {
 	if cfg.AutoUpdate.Enabled {
 		autoUpdateValue = "ON"
 	}
+	syntaxValue := "OFF"
+	if cfg.Appearance.SyntaxHighlighting {
+		syntaxValue = "ON"
+	}
 
 	return []PreferenceRow{
 		{Emoji: "🔄", Label: "Auto-update", Value: autoUpdateValue, Enabled: true},
 		{Emoji: "⏱️", Label: "Update Interval", Value: fmt.Sprintf("%d min", cfg.AutoUpdate.IntervalMinutes), Enabled: true},
 		{Emoji: "🎨", Label: "Theme", Value: cfg.Appearance.Theme, Enabled: true},
+		{Emoji: "🖍️", Label: "Syntax Colors", Value: syntaxValue, Enabled: true},
 	}
 }
 
@@ -0,0 +1,137 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ProjectEntry is one recently opened repository in the project switcher
+type ProjectEntry struct {
+	Path    string // Absolute repository root
+	Current bool   // The repository TIT currently has open
+}
+
+// ProjectSwitcherState represents the project switcher (query line + filtered recent repositories).
+// The query itself lives in the app's input state; SelectedIdx indexes Matches(query).
+type ProjectSwitcherState struct {
+	Repos        []ProjectEntry // Recent repositories, most recent first
+	SelectedIdx  int
+	ScrollOffset int
+}
+
+// Matches returns the repositories whose path fuzzy-matches query, best first.
+// Matches within the repository name rank above matches spread over the path;
+// ties (and an empty query) keep recency order.
+func (s *ProjectSwitcherState) Matches(query string) []ProjectEntry {
+	type scored struct {
+		entry  ProjectEntry
+		inName bool
+		score  int
+	}
+	var hits []scored
+	for _, entry := range s.Repos {
+		if score, ok := FuzzyScore(query, filepath.Base(entry.Path)); ok {
+			hits = append(hits, scored{entry, true, score})
+		} else if score, ok := FuzzyScore(query, entry.Path); ok {
+			hits = append(hits, scored{entry, false, score})
+		}
+	}
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].inName != hits[j].inName {
+			return hits[i].inName
+		}
+		return hits[i].score > hits[j].score
+	})
+
+	matches := make([]ProjectEntry, len(hits))
+	for i, hit := range hits {
+		matches[i] = hit.entry
+	}
+	return matches
+}
+
+// RenderProjectSwitcher renders the query line and matching repositories in a bordered box
+// Returns content exactly `width` chars wide and `height - 1` lines tall (footer handled externally)
+func RenderProjectSwitcher(state interface{}, query string, cursorPos int, theme Theme, width, height int) string {
+	if width <= 0 || height <= 0 {
+		return ""
+	}
+	switcher, ok := state.(*ProjectSwitcherState)
+	if !ok || switcher == nil {
+		return ""
+	}
+
+	boxHeight := height - SplitPaneHeightOffset
+	contentWidth := width - 4 // Border + padding
+	matches := switcher.Matches(query)
+
+	if cursorPos < 0 || cursorPos > len(query) {
+		cursorPos = len(query)
+	}
+	lines := []string{
+		lipgloss.NewStyle().
+			Width(contentWidth).
+			Align(lipgloss.Center).
+			Bold(true).
+			Foreground(lipgloss.Color(theme.ConflictPaneTitleColor)).
+			Render(fmt.Sprintf("Switch repository · %d of %d", len(matches), len(switcher.Repos))),
+		"",
+		lipgloss.NewStyle().
+			Foreground(lipgloss.Color(theme.AccentTextColor)).
+			Render(TruncateLabel("› "+query[:cursorPos]+"█"+query[cursorPos:], contentWidth)),
+		"",
+	}
+
+	visible := boxHeight - len(lines)
+	if visible < 1 {
+		visible = 1
+	}
+	if switcher.SelectedIdx < switcher.ScrollOffset {
+		switcher.ScrollOffset = switcher.SelectedIdx
+	} else if switcher.SelectedIdx >= switcher.ScrollOffset+visible {
+		switcher.ScrollOffset = switcher.SelectedIdx - visible + 1
+	}
+
+	if len(matches) == 0 {
+		message := "No matching repositories"
+		if len(switcher.Repos) == 0 {
+			message = "No recent repositories yet"
+		}
+		lines = append(lines, lipgloss.NewStyle().
+			Width(contentWidth).
+			Align(lipgloss.Center).
+			Foreground(lipgloss.Color(theme.DimmedTextColor)).
+			Render(message))
+	}
+
+	nameWidth := contentWidth / 3
+	for i := switcher.ScrollOffset; i < len(matches) && i < switcher.ScrollOffset+visible; i++ {
+		entry := matches[i]
+		name := filepath.Base(entry.Path)
+		if entry.Current {
+			name = "● " + name
+		}
+		nameColor, pathColor := theme.ContentTextColor, theme.DimmedTextColor
+		base := lipgloss.NewStyle()
+		if i == switcher.SelectedIdx {
+			// Menu convention: dark foreground on the selection background, whole row
+			nameColor, pathColor = theme.MainBackgroundColor, theme.MainBackgroundColor
+			base = base.Bold(true).Background(lipgloss.Color(theme.MenuSelectionBackground))
+		}
+		lines = append(lines,
+			base.Width(nameWidth).Foreground(lipgloss.Color(nameColor)).Render(TruncateLabel(name, nameWidth-1))+
+				base.Width(contentWidth-nameWidth).Foreground(lipgloss.Color(pathColor)).Render(TruncateLabel(entry.Path, contentWidth-nameWidth)))
+	}
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color(theme.ConflictPaneFocusedBorder)).
+		Width(width-2).
+		Height(boxHeight).
+		Padding(0, 1).
+		Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,178 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jrengmusic/tit/internal/git"
+)
+
+// SparseInclusion is how much of a directory the selected cone checks out
+type SparseInclusion int
+
+const (
+	SparseExcluded SparseInclusion = iota // Nothing below this directory is in the cone
+	SparsePartial                         // Some subdirectory is in the cone
+	SparseIncluded                        // Directory is in the cone (itself or via a parent)
+)
+
+// SparseRow is one visible directory of the sparse-checkout tree
+type SparseRow struct {
+	Path        string // Repository-relative directory
+	Depth       int    // Nesting level, 0 = top-level
+	HasChildren bool
+	Expanded    bool
+	Inclusion   SparseInclusion
+}
+
+// SparseCheckoutState represents the sparse-checkout view (2-pane split-view)
+// Mirrors LFSState: list pane (left) + details pane (right).
+// Chosen holds the pending cone; it is applied only on confirm.
+type SparseCheckoutState struct {
+	Tree     *git.RepoTree   // HEAD's directory tree with file counts and sizes
+	Chosen   map[string]bool // Pending cone directories (never nested inside each other)
+	Expanded map[string]bool // Directories whose children are listed
+	Current  []string        // Patterns in effect (`sparse-checkout list`), empty when not sparse
+	Enabled  bool            // Sparse checkout currently enabled
+	Cone     bool            // Current patterns are cone mode
+
+	Rows              []SparseRow
+	SelectedIdx       int
+	PaneFocused       bool     // true = list pane, false = details pane
+	ListScrollOffset  int      // Scroll offset for row list
+	Details           []string // Details pane lines
+	Status            string   // Result of the last action, shown above the details
+	DetailsLineCursor int      // Line cursor position in details pane
+	DetailsScrollOff  int      // Scroll offset for details pane
+}
+
+// Selected returns the selected row, or false when the list is empty
+func (s *SparseCheckoutState) Selected() (SparseRow, bool) {
+	if s == nil || s.SelectedIdx < 0 || s.SelectedIdx >= len(s.Rows) {
+		return SparseRow{}, false
+	}
+	return s.Rows[s.SelectedIdx], true
+}
+
+// FormatBytes renders a byte count with a binary unit ("512 B", "1.5 KiB", "3.2 MiB")
+func FormatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// RenderSparseCheckoutSplitPane renders the sparse-checkout view (directory tree, details)
+// Uses SSOT: ListPane (left) + TextPane (right) matching the LFS manager
+// Returns content exactly `width` chars wide and `height - 1` lines tall (footer handled externally)
+func RenderSparseCheckoutSplitPane(state interface{}, theme Theme, width, height int) string {
+	if width <= 0 || height <= 0 {
+		return ""
+	}
+
+	sparseState, ok := state.(*SparseCheckoutState)
+	if !ok || sparseState == nil {
+		return ""
+	}
+
+	paneHeight := height - SplitPaneHeightOffset
+
+	listPaneWidth := width / 2
+	detailsPaneWidth := width - listPaneWidth
+
+	listPaneContent := renderSparseListPane(sparseState, &theme, listPaneWidth, paneHeight)
+	detailsPaneContent := renderSparseDetailsPane(sparseState, &theme, detailsPaneWidth, paneHeight)
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, listPaneContent, detailsPaneContent)
+}
+
+// buildSparseListItems creates ListItems from tree rows
+// Checkbox shows inclusion ([x] in cone, [~] partly, [ ] out); ▸/▾ marks collapsed/expanded directories
+func buildSparseListItems(rows []SparseRow, selectedIdx int, theme *Theme) []ListItem {
+	items := make([]ListItem, len(rows))
+	for i, row := range rows {
+		marker := "  "
+		if row.HasChildren {
+			marker = "▸ "
+			if row.Expanded {
+				marker = "▾ "
+			}
+		}
+		name := row.Path[strings.LastIndex(row.Path, "/")+1:]
+
+		item := ListItem{
+			AttributeText:  "[ ] ",
+			AttributeColor: theme.DimmedTextColor,
+			ContentText:    strings.Repeat("  ", row.Depth) + marker + name + "/",
+			ContentColor:   theme.DimmedTextColor,
+			IsSelected:     i == selectedIdx,
+		}
+		switch row.Inclusion {
+		case SparseIncluded:
+			item.AttributeText = "[x] "
+			item.AttributeColor = theme.AccentTextColor
+			item.ContentColor = theme.ContentTextColor
+		case SparsePartial:
+			item.AttributeText = "[~] "
+			item.AttributeColor = theme.OutputWarningColor
+			item.ContentColor = theme.ContentTextColor
+		}
+		items[i] = item
+	}
+	return items
+}
+
+// renderSparseListPane renders the directory tree using SSOT ListPane
+func renderSparseListPane(state *SparseCheckoutState, theme *Theme, width, height int) string {
+	listPane := NewListPane("Sparse checkout", theme)
+	listPane.ScrollOffset = state.ListScrollOffset
+
+	items := buildSparseListItems(state.Rows, state.SelectedIdx, theme)
+
+	visibleLines := height - 2
+	if visibleLines < 1 {
+		visibleLines = 1
+	}
+
+	listPane.AdjustScroll(state.SelectedIdx, visibleLines)
+	state.ListScrollOffset = listPane.ScrollOffset
+
+	return listPane.Render(items, width, height, state.PaneFocused, 0, 1)
+}
+
+// renderSparseDetailsPane renders the last action result and the working-set details
+func renderSparseDetailsPane(state *SparseCheckoutState, theme *Theme, width, height int) string {
+	var lines []string
+	if state.Status != "" {
+		lines = append(lines, state.Status, "")
+	}
+	if len(state.Details) > 0 {
+		lines = append(lines, state.Details...)
+	} else {
+		lines = append(lines, "(repository has no directories)")
+	}
+
+	rendered, newScrollOffset := RenderTextPane(
+		strings.Join(lines, "\n"),
+		width,
+		height,
+		state.DetailsLineCursor,
+		state.DetailsScrollOff,
+		false,              // No line numbers
+		!state.PaneFocused, // Active when list is NOT focused
+		false,              // Not diff mode
+		theme,
+		false, // No visual mode in sparse view
+		0,
+	)
+	state.DetailsScrollOff = newScrollOffset
+
+	return rendered
+}
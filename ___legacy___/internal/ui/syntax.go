@@ -0,0 +1,120 @@
+package ui
+
+import (
+	"github.com/jrengmusic/tit/internal"
+	"github.com/jrengmusic/tit/internal/syntax"
+)
+
+// lexerFor returns the lexer for path when highlighting is enabled and content
+// is under the size cutoff, nil otherwise (nil = no syntax colors)
+func lexerFor(enabled bool, path, content string) syntax.Lexer {
+	if !enabled || len(content) > internal.SyntaxHighlightMaxBytes {
+		return nil
+	}
+	return syntax.ForFile(path)
+}
+
+// syntaxColor returns the theme color for a token kind; "" keeps the line's own color
+func syntaxColor(kind syntax.Kind, theme *Theme) string {
+	switch kind {
+	case syntax.Keyword:
+		return theme.SyntaxKeywordColor
+	case syntax.Type:
+		return theme.SyntaxTypeColor
+	case syntax.String:
+		return theme.SyntaxStringColor
+	case syntax.Number:
+		return theme.SyntaxNumberColor
+	case syntax.Comment:
+		return theme.SyntaxCommentColor
+	case syntax.Function:
+		return theme.SyntaxFunctionColor
+	default:
+		return ""
+	}
+}
+
+// highlightDiffLines tokenizes unified diff lines. Old (removed) and new (added)
+// lines are lexed as separate streams so an open comment on one side does not
+// leak into the other; both restart at each hunk.
+func highlightDiffLines(lines []DiffLine, lexer syntax.Lexer) {
+	if lexer == nil {
+		return
+	}
+	var oldState, newState syntax.State
+	for i := range lines {
+		line := &lines[i]
+		if line.HunkStart {
+			oldState, newState = syntax.State{}, syntax.State{}
+		}
+		switch line.LineType {
+		case "removed":
+			line.Tokens = lexer.Tokenize(line.Code, &oldState)
+		case "added":
+			line.Tokens = lexer.Tokenize(line.Code, &newState)
+		default:
+			line.Tokens = lexer.Tokenize(line.Code, &newState)
+			oldState = newState
+		}
+	}
+}
+
+// highlightCells tokenizes each column of cell rows as its own stream,
+// restarting at hunk starts
+func highlightCells(rows [][]*DiffCell, lexer syntax.Lexer) {
+	if lexer == nil || len(rows) == 0 {
+		return
+	}
+	states := make([]syntax.State, len(rows[0]))
+	for _, row := range rows {
+		for col, cell := range row {
+			if cell == nil {
+				continue
+			}
+			if cell.HunkStart {
+				states[col] = syntax.State{}
+			}
+			cell.Tokens = lexer.Tokenize(cell.Code, &states[col])
+		}
+	}
+}
+
+// styledRun is a piece of a line with one token kind and one changed state
+type styledRun struct {
+	text    string
+	kind    syntax.Kind
+	changed bool
+}
+
+// composeRuns overlays word-level diff segments and syntax tokens of the same
+// code into runs that are uniform in both (nil segments/tokens = one plain run)
+func composeRuns(code string, segments []DiffSegment, tokens []syntax.Token) []styledRun {
+	if len(segments) == 0 {
+		segments = []DiffSegment{{Text: code}}
+	}
+	if len(tokens) == 0 {
+		tokens = []syntax.Token{{Text: code}}
+	}
+
+	var runs []styledRun
+	si, ti := 0, 0
+	segmentRest, tokenRest := segments[0].Text, tokens[0].Text
+	for si < len(segments) && ti < len(tokens) {
+		n := min(len(segmentRest), len(tokenRest))
+		if n > 0 {
+			runs = append(runs, styledRun{text: segmentRest[:n], kind: tokens[ti].Kind, changed: segments[si].Changed})
+		}
+		segmentRest, tokenRest = segmentRest[n:], tokenRest[n:]
+		if segmentRest == "" {
+			if si++; si < len(segments) {
+				segmentRest = segments[si].Text
+			}
+		}
+		if tokenRest == "" {
+			if ti++; ti < len(tokens) {
+				tokenRest = tokens[ti].Text
+			}
+		}
+	}
+	return runs
+}
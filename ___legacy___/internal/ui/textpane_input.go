@@ -3,6 +3,8 @@ package ui
 import (
 	"strconv"
 	"strings"
+
+	"github.com/jrengmusic/tit/internal/syntax"
 )
 
 // DiffLine represents a parsed diff line (for diff mode rendering)
@@ -11,6 +13,12 @@ type DiffLine struct {
 	Marker   string // "+", "-", or " "
 	Code     string // The code content
 	LineType string // "added", "removed", or "context"
+
+	// Word-level segments when paired with the line it replaces (or was replaced by); nil otherwise
+	Segments []DiffSegment
+
+	HunkStart bool           // First line after an @@ header
+	Tokens    []syntax.Token // Syntax tokens of Code; nil when not highlighted
 }
 
 // parseDiffContent parses diff output into structured DiffLine objects for 3-column rendering
@@ -22,35 +30,23 @@ func parseDiffContent(diffContent string) []DiffLine {
 	lines := strings.Split(diffContent, "\n")
 	var result []DiffLine
 	var lineNum int
+	hunkOpen := false
 
 	for _, line := range lines {
 		// Parse @@ headers
 		if strings.HasPrefix(line, "@@") {
-			parts := strings.Fields(line)
-			if len(parts) >= 3 {
-				newPart := parts[2]
-				if strings.HasPrefix(newPart, "+") {
-					numPart := strings.TrimPrefix(newPart, "+")
-					if idx := strings.Index(numPart, ","); idx >= 0 {
-						numPart = numPart[:idx]
-					}
-					if num, err := strconv.Atoi(numPart); err == nil {
-						lineNum = num
-					}
-				}
-			}
+			_, lineNum = hunkStart(line)
+			hunkOpen = true
 			continue
 		}
 
 		// Skip metadata
-		if strings.HasPrefix(line, "---") || strings.HasPrefix(line, "+++") ||
-			strings.HasPrefix(line, "diff ") || strings.HasPrefix(line, "index ") ||
-			strings.HasPrefix(line, "new file mode") || strings.HasPrefix(line, "deleted file mode") ||
-			strings.HasPrefix(line, "old mode") || strings.HasPrefix(line, "new mode") {
+		if isDiffMetadata(line) {
 			continue
 		}
 
 		// Parse content lines
+		parsed := len(result)
 		if strings.HasPrefix(line, "+") {
 			result = append(result, DiffLine{
 				LineNum: lineNum, Marker: "+", Code: line[1:], LineType: "added",
@@ -66,11 +62,45 @@ func parseDiffContent(diffContent string) []DiffLine {
 			})
 			lineNum++
 		}
+		if hunkOpen && len(result) > parsed {
+			result[len(result)-1].HunkStart = true
+			hunkOpen = false
+		}
 	}
 
 	return result
 }
 
+// isDiffMetadata reports header lines that are not part of any hunk
+func isDiffMetadata(line string) bool {
+	return strings.HasPrefix(line, "---") || strings.HasPrefix(line, "+++") ||
+		strings.HasPrefix(line, "diff ") || strings.HasPrefix(line, "index ") ||
+		strings.HasPrefix(line, "new file mode") || strings.HasPrefix(line, "deleted file mode") ||
+		strings.HasPrefix(line, "old mode") || strings.HasPrefix(line, "new mode")
+}
+
+// hunkStart returns the old and new start lines of an "@@ -a,b +c,d @@" header
+func hunkStart(header string) (oldStart, newStart int) {
+	for _, part := range strings.Fields(header) {
+		num := strings.TrimLeft(part, "-+")
+		if idx := strings.Index(num, ","); idx >= 0 {
+			num = num[:idx]
+		}
+		n, err := strconv.Atoi(num)
+		if err != nil {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(part, "-"):
+			oldStart = n
+		case strings.HasPrefix(part, "+"):
+			newStart = n
+			return oldStart, newStart
+		}
+	}
+	return oldStart, newStart
+}
+
 // GetSelectedLinesFromDiff returns the lines in visual selection from diff content
 // Used by copy/yank functionality
 func GetSelectedLinesFromDiff(diffContent string, visualModeStart int, visualModeEnd int) []string {
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/jrengmusic/tit/internal/syntax"
+
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -24,8 +26,27 @@ func RenderTextPane(
 	visualModeActive bool,
 	visualModeStart int,
 ) (rendered string, newScrollOffset int) {
+	return renderTextPane(content, width, height, lineCursor, scrollOffset, showLineNumbers, isActive, isDiff, theme, visualModeActive, visualModeStart, nil)
+}
+
+// renderTextPane is RenderTextPane with syntax colors from lexer (nil = none)
+func renderTextPane(
+	content string,
+	width int,
+	height int,
+	lineCursor int,
+	scrollOffset int,
+	showLineNumbers bool,
+	isActive bool,
+	isDiff bool,
+	theme *Theme,
+	visualModeActive bool,
+	visualModeStart int,
+	lexer syntax.Lexer,
+) (rendered string, newScrollOffset int) {
 
 	var lines []string
+	var lineTokens [][]syntax.Token
 	var diffLines []DiffLine
 	var totalLines int
 	var isDiffMode bool
@@ -34,6 +55,8 @@ func RenderTextPane(
 	if isDiff {
 		// Diff mode: parse into structured format for later rendering
 		diffLines = parseDiffContent(content)
+		markIntraLine(diffLines)
+		highlightDiffLines(diffLines, lexer)
 		totalLines = len(diffLines)
 		isDiffMode = true
 		showLineNumbers = false // Diff already has line numbers in 3-column format
@@ -42,6 +65,15 @@ func RenderTextPane(
 		lines = strings.Split(content, "\n")
 		totalLines = len(lines)
 		isDiffMode = false
+
+		// Lex from the first line so comments opened above the window carry over
+		if lexer != nil {
+			var state syntax.State
+			lineTokens = make([][]syntax.Token, len(lines))
+			for i, line := range lines {
+				lineTokens[i] = lexer.Tokenize(line, &state)
+			}
+		}
 	}
 
 	if totalLines == 0 {
@@ -76,30 +108,7 @@ func RenderTextPane(
 		scrollWindow = 1
 	}
 
-	// Don't scroll if all lines fit
-	if totalLines <= scrollWindow {
-		scrollOffset = 0
-	} else {
-		// Scroll to keep cursor in window
-		if lineCursor < scrollOffset {
-			scrollOffset = lineCursor
-		}
-		if lineCursor >= scrollOffset+scrollWindow {
-			scrollOffset = lineCursor - scrollWindow + 1
-		}
-
-		// Clamp scroll
-		if scrollOffset < 0 {
-			scrollOffset = 0
-		}
-		maxScroll := totalLines - scrollWindow
-		if maxScroll < 0 {
-			maxScroll = 0
-		}
-		if scrollOffset > maxScroll {
-			scrollOffset = maxScroll
-		}
-	}
+	scrollOffset = scrollToCursor(totalLines, lineCursor, scrollOffset, scrollWindow)
 
 	// Calculate widths
 	lineNumWidth := 0
@@ -132,15 +141,7 @@ func RenderTextPane(
 			markerText := dl.Marker + " "
 
 			// Determine color for code and marker
-			var codeColor string
-			switch dl.LineType {
-			case "added":
-				codeColor = theme.DiffAddedLineColor
-			case "removed":
-				codeColor = theme.DiffRemovedLineColor
-			default:
-				codeColor = theme.ContentTextColor
-			}
+			codeColor := diffLineColor(dl.LineType, theme)
 
 			// Check if line is in visual selection
 			var isInVisualSelection bool
@@ -183,9 +184,7 @@ func RenderTextPane(
 					Bold(true).
 					Render(dl.Code)
 			} else {
-				codeCol = lipgloss.NewStyle().
-					Foreground(lipgloss.Color(codeColor)).
-					Render(dl.Code)
+				codeCol = renderDiffCode(dl.Code, dl.Segments, dl.Tokens, -1, codeColor, inlineBackground(dl.LineType, theme), theme)
 			}
 
 			line = lineNumCol + markerCol + codeCol
@@ -217,6 +216,10 @@ func RenderTextPane(
 					Background(lipgloss.Color(theme.MenuSelectionBackground)).
 					Bold(true).
 					Render(text)
+			} else if lineTokens != nil {
+				line += lipgloss.NewStyle().
+					Width(textWidth).
+					Render(renderDiffCode(text, nil, lineTokens[i], -1, theme.ContentTextColor, "", theme))
 			} else {
 				line += lipgloss.NewStyle().
 					Width(textWidth).
@@ -228,7 +231,42 @@ func RenderTextPane(
 		renderedLines = append(renderedLines, line)
 	}
 
-	contentText := strings.Join(renderedLines, "\n")
+	return renderPaneBox(strings.Join(renderedLines, "\n"), width, height, isActive, theme), scrollOffset
+}
+
+// scrollToCursor returns the scroll offset that keeps lineCursor inside a
+// window of scrollWindow lines, clamped to the content
+func scrollToCursor(totalLines, lineCursor, scrollOffset, scrollWindow int) int {
+	// Don't scroll if all lines fit
+	if totalLines <= scrollWindow {
+		return 0
+	}
+
+	// Scroll to keep cursor in window
+	if lineCursor < scrollOffset {
+		scrollOffset = lineCursor
+	}
+	if lineCursor >= scrollOffset+scrollWindow {
+		scrollOffset = lineCursor - scrollWindow + 1
+	}
+
+	// Clamp scroll
+	if scrollOffset < 0 {
+		scrollOffset = 0
+	}
+	maxScroll := totalLines - scrollWindow
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+	if scrollOffset > maxScroll {
+		scrollOffset = maxScroll
+	}
+	return scrollOffset
+}
+
+// renderPaneBox wraps rendered lines in the bordered text pane box
+func renderPaneBox(contentText string, width, height int, isActive bool, theme *Theme) string {
+	contentWidth := width - 4
 
 	// Border color
 	borderColor := theme.ConflictPaneUnfocusedBorder
@@ -252,7 +290,7 @@ func RenderTextPane(
 		Padding(0, 1).
 		Render(innerBox)
 
-	return outerBox, scrollOffset
+	return outerBox
 }
 
 func renderEmptyPane(width, height int, isActive bool, theme *Theme) string {
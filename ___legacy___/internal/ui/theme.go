@@ -63,8 +63,18 @@ type Theme struct {
 	SpinnerColor string
 
 	// Diff Colors
-	DiffAddedLineColor   string
-	DiffRemovedLineColor string
+	DiffAddedLineColor          string
+	DiffRemovedLineColor        string
+	DiffAddedInlineBackground   string // Changed words within an added line
+	DiffRemovedInlineBackground string // Changed words within a removed line
+
+	// Syntax Colors (composed with diff colors: plain tokens keep the line color)
+	SyntaxKeywordColor  string
+	SyntaxTypeColor     string
+	SyntaxStringColor   string
+	SyntaxNumberColor   string
+	SyntaxCommentColor  string
+	SyntaxFunctionColor string
 
 	// Copy Hash Mode Colors
 	CopyHashLabelForeground string
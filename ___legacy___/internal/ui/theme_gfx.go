@@ -68,6 +68,16 @@ spinnerColor = "#00FFFF"              # electric cyan
 # Diff Colors
 diffAddedLineColor = "#5A9C7A"        # muted green
 diffRemovedLineColor = "#B07070"      # muted red
+diffAddedInlineBackground = "#1F3B2E"    # deep green (changed words in added lines)
+diffRemovedInlineBackground = "#4A2326"  # deep red (changed words in removed lines)
+
+# Syntax Colors (code tokens in diff and conflict panes)
+syntaxKeywordColor = "#01C2D2"        # caribbeanBlue (keywords)
+syntaxTypeColor = "#67DFEF"           # poseidonJr (types)
+syntaxStringColor = "#F2AB53"         # safflower (strings)
+syntaxNumberColor = "#E8825E"         # burntSienna (numbers)
+syntaxCommentColor = "#33535B"        # mediterranea (comments)
+syntaxFunctionColor = "#8CC9D9"       # dolphin (function names)
 
 # Copy Hash Mode
 copyHashLabelForeground = "#090D12"   # bunker (dark contrast)
@@ -74,8 +74,18 @@ type ThemeDefinition struct {
 		SpinnerColor string `toml:"spinnerColor"`
 
 		// Diff Colors
-		DiffAddedLineColor   string `toml:"diffAddedLineColor"`
-		DiffRemovedLineColor string `toml:"diffRemovedLineColor"`
+		DiffAddedLineColor          string `toml:"diffAddedLineColor"`
+		DiffRemovedLineColor        string `toml:"diffRemovedLineColor"`
+		DiffAddedInlineBackground   string `toml:"diffAddedInlineBackground"`
+		DiffRemovedInlineBackground string `toml:"diffRemovedInlineBackground"`
+
+		// Syntax Colors
+		SyntaxKeywordColor  string `toml:"syntaxKeywordColor"`
+		SyntaxTypeColor     string `toml:"syntaxTypeColor"`
+		SyntaxStringColor   string `toml:"syntaxStringColor"`
+		SyntaxNumberColor   string `toml:"syntaxNumberColor"`
+		SyntaxCommentColor  string `toml:"syntaxCommentColor"`
+		SyntaxFunctionColor string `toml:"syntaxFunctionColor"`
 
 		// Copy Hash Mode Colors
 		CopyHashLabelForeground string `toml:"copyHashLabelForeground"`
@@ -165,8 +175,18 @@ func LoadTheme(themeFilePath string) (Theme, error) {
 		SpinnerColor: themeDef.Palette.SpinnerColor,
 
 		// Diff Colors
-		DiffAddedLineColor:   themeDef.Palette.DiffAddedLineColor,
-		DiffRemovedLineColor: themeDef.Palette.DiffRemovedLineColor,
+		DiffAddedLineColor:          themeDef.Palette.DiffAddedLineColor,
+		DiffRemovedLineColor:        themeDef.Palette.DiffRemovedLineColor,
+		DiffAddedInlineBackground:   themeDef.Palette.DiffAddedInlineBackground,
+		DiffRemovedInlineBackground: themeDef.Palette.DiffRemovedInlineBackground,
+
+		// Syntax Colors
+		SyntaxKeywordColor:  themeDef.Palette.SyntaxKeywordColor,
+		SyntaxTypeColor:     themeDef.Palette.SyntaxTypeColor,
+		SyntaxStringColor:   themeDef.Palette.SyntaxStringColor,
+		SyntaxNumberColor:   themeDef.Palette.SyntaxNumberColor,
+		SyntaxCommentColor:  themeDef.Palette.SyntaxCommentColor,
+		SyntaxFunctionColor: themeDef.Palette.SyntaxFunctionColor,
 
 		// Copy Hash Mode Colors
 		CopyHashLabelForeground: themeDef.Palette.CopyHashLabelForeground,
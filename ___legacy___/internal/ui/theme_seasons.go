@@ -68,6 +68,16 @@ spinnerColor = "#00FFFF"              # electric cyan
 # Diff Colors
 diffAddedLineColor = "#5BCF90"        # emerald (added)
 diffRemovedLineColor = "#FD5B68"      # wildWatermelon (removed)
+diffAddedInlineBackground = "#1E6B52"    # jungleGreen (changed words in added lines)
+diffRemovedInlineBackground = "#8A2F4A"  # claret (changed words in removed lines)
+
+# Syntax Colors (code tokens in diff and conflict panes)
+syntaxKeywordColor = "#FEEA85"        # salomie (keywords)
+syntaxTypeColor = "#90D88D"           # feijoa (types)
+syntaxStringColor = "#F9A66C"         # tanHide (strings)
+syntaxNumberColor = "#FF8FB1"         # tickleMePink (numbers)
+syntaxCommentColor = "#8E9BD6"        # moodyBlue (comments)
+syntaxFunctionColor = "#C8E189"       # yellowGreen (function names)
 
 # Copy Hash Mode
 copyHashLabelForeground = "#323B9E"   # sapphire (dark contrast)
@@ -150,6 +160,16 @@ spinnerColor = "#00FFFF"              # electric cyan
 # Diff Colors
 diffAddedLineColor = "#19E5FF"        # cyan (added)
 diffRemovedLineColor = "#FF3469"      # radicalRed (removed)
+diffAddedInlineBackground = "#00505A"    # deepTeal (changed words in added lines)
+diffRemovedInlineBackground = "#5C0A24"  # burgundy (changed words in removed lines)
+
+# Syntax Colors (code tokens in diff and conflict panes)
+syntaxKeywordColor = "#FFBF16"        # lightningYellow (keywords)
+syntaxTypeColor = "#19E5FF"           # cyan (types)
+syntaxStringColor = "#FF7A5C"         # persimmon (strings)
+syntaxNumberColor = "#FF5FD2"         # razzleDazzle (numbers)
+syntaxCommentColor = "#5E68C1"        # indigo (comments)
+syntaxFunctionColor = "#7FD4FF"       # malibu (function names)
 
 # Copy Hash Mode
 copyHashLabelForeground = "#000000"   # black (maximum contrast)
@@ -232,6 +252,16 @@ spinnerColor = "#00FFFF"              # electric cyan
 # Diff Colors
 diffAddedLineColor = "#F5BB09"        # corn (added)
 diffRemovedLineColor = "#DC3003"      # grenadier (removed)
+diffAddedInlineBackground = "#5E4A05"    # bronze (changed words in added lines)
+diffRemovedInlineBackground = "#6E1A08"  # kenyanCopper (changed words in removed lines)
+
+# Syntax Colors (code tokens in diff and conflict panes)
+syntaxKeywordColor = "#F5BB09"        # corn (keywords)
+syntaxTypeColor = "#F9C94D"           # saffronMango (types)
+syntaxStringColor = "#B5D96B"         # conifer (strings)
+syntaxNumberColor = "#FF9A8A"         # salmon (numbers)
+syntaxCommentColor = "#A8707E"        # copperRose (comments)
+syntaxFunctionColor = "#F6C8A8"       # peachYellow (function names)
 
 # Copy Hash Mode
 copyHashLabelForeground = "#3E0338"   # jacaranda (darkest contrast)
@@ -314,6 +344,16 @@ spinnerColor = "#00FFFF"              # electric cyan
 # Diff Colors
 diffAddedLineColor = "#6281DC"        # havelockBlue (added)
 diffRemovedLineColor = "#E0BACF"      # melanie (removed)
+diffAddedInlineBackground = "#33508F"    # chambray (changed words in added lines)
+diffRemovedInlineBackground = "#6B4A5E"  # eggplant (changed words in removed lines)
+
+# Syntax Colors (code tokens in diff and conflict panes)
+syntaxKeywordColor = "#F6F5FA"        # whisper (keywords)
+syntaxTypeColor = "#7F95D6"           # chetwodeBlue (types)
+syntaxStringColor = "#A8D8B9"         # padua (strings)
+syntaxNumberColor = "#F0B9C8"         # pinkFlare (numbers)
+syntaxCommentColor = "#6E7FA8"        # waikawaGray (comments)
+syntaxFunctionColor = "#B9C8F5"       # periwinkle (function names)
 
 # Copy Hash Mode
 copyHashLabelForeground = "#233253"   # cloudBurst (dark background contrast)
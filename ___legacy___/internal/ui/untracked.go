@@ -0,0 +1,157 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// UntrackedRowKind distinguishes pattern group headers from the files beneath them
+type UntrackedRowKind int
+
+const (
+	UntrackedRowGroup UntrackedRowKind = iota // Candidate pattern covering the files below it
+	UntrackedRowFile                          // Single path
+)
+
+// UntrackedRow is one line of the triage list
+type UntrackedRow struct {
+	Kind    UntrackedRowKind
+	Path    string // Pattern for groups, repository-relative path for files
+	Count   int    // Files in the group (groups only)
+	Ignored bool   // Row belongs to the ignored section
+}
+
+// UntrackedTriageState represents the untracked-file triage view (2-pane split-view)
+// Mirrors BranchPickerState: list pane (left) + details pane (right).
+// Details are built by the app layer because they need git (pattern preview, check-ignore).
+type UntrackedTriageState struct {
+	Rows              []UntrackedRow
+	SelectedIdx       int
+	PaneFocused       bool     // true = list pane, false = details pane
+	ListScrollOffset  int      // Scroll offset for row list
+	Details           []string // Details pane lines for the selected row
+	Status            string   // Result of the last action, shown above the details
+	DetailsLineCursor int      // Line cursor position in details pane
+	DetailsScrollOff  int      // Scroll offset for details pane
+}
+
+// Selected returns the selected row, or false when the list is empty
+func (s *UntrackedTriageState) Selected() (UntrackedRow, bool) {
+	if s == nil || s.SelectedIdx < 0 || s.SelectedIdx >= len(s.Rows) {
+		return UntrackedRow{}, false
+	}
+	return s.Rows[s.SelectedIdx], true
+}
+
+// GroupFiles returns the file paths listed under the group row at idx
+func (s *UntrackedTriageState) GroupFiles(idx int) []string {
+	var files []string
+	for i := idx + 1; i < len(s.Rows) && s.Rows[i].Kind == UntrackedRowFile; i++ {
+		files = append(files, s.Rows[i].Path)
+	}
+	return files
+}
+
+// RenderUntrackedTriageSplitPane renders the triage view (list of grouped paths + details)
+// Uses SSOT: ListPane (left) + TextPane (right) matching the branch picker
+// Returns content exactly `width` chars wide and `height - 1` lines tall (footer handled externally)
+func RenderUntrackedTriageSplitPane(state interface{}, theme Theme, width, height int) string {
+	if width <= 0 || height <= 0 {
+		return ""
+	}
+
+	triageState, ok := state.(*UntrackedTriageState)
+	if !ok || triageState == nil {
+		return ""
+	}
+
+	paneHeight := height - SplitPaneHeightOffset
+
+	listPaneWidth := width / 2
+	detailsPaneWidth := width - listPaneWidth
+
+	listPaneContent := renderUntrackedListPane(triageState, &theme, listPaneWidth, paneHeight)
+	detailsPaneContent := renderUntrackedDetailsPane(triageState, &theme, detailsPaneWidth, paneHeight)
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, listPaneContent, detailsPaneContent)
+}
+
+// buildUntrackedListItems creates ListItems from triage rows
+// Group rows show their file count; file rows are indented beneath their group
+func buildUntrackedListItems(rows []UntrackedRow, selectedIdx int, theme *Theme) []ListItem {
+	items := make([]ListItem, len(rows))
+	for i, row := range rows {
+		item := ListItem{
+			AttributeColor: theme.DimmedTextColor,
+			ContentColor:   theme.ContentTextColor,
+			IsSelected:     i == selectedIdx,
+		}
+
+		switch row.Kind {
+		case UntrackedRowGroup:
+			item.AttributeText = fmt.Sprintf("%3d", row.Count)
+			item.AttributeColor = theme.AccentTextColor
+			item.ContentText = row.Path
+			item.ContentBold = true
+		default:
+			item.AttributeText = "   "
+			item.ContentText = "  " + row.Path
+		}
+
+		if row.Ignored {
+			item.ContentColor = theme.DimmedTextColor
+		}
+		items[i] = item
+	}
+	return items
+}
+
+// renderUntrackedListPane renders the grouped path list using SSOT ListPane
+func renderUntrackedListPane(state *UntrackedTriageState, theme *Theme, width, height int) string {
+	listPane := NewListPane("Untracked", theme)
+	listPane.ScrollOffset = state.ListScrollOffset
+
+	items := buildUntrackedListItems(state.Rows, state.SelectedIdx, theme)
+
+	visibleLines := height - 2
+	if visibleLines < 1 {
+		visibleLines = 1
+	}
+
+	listPane.AdjustScroll(state.SelectedIdx, visibleLines)
+	state.ListScrollOffset = listPane.ScrollOffset
+
+	return listPane.Render(items, width, height, state.PaneFocused, 0, 1)
+}
+
+// renderUntrackedDetailsPane renders the last action result and the selected row's details
+func renderUntrackedDetailsPane(state *UntrackedTriageState, theme *Theme, width, height int) string {
+	var lines []string
+	if state.Status != "" {
+		lines = append(lines, state.Status, "")
+	}
+	if len(state.Details) > 0 {
+		lines = append(lines, state.Details...)
+	} else {
+		lines = append(lines, "(nothing to triage)")
+	}
+
+	rendered, newScrollOffset := RenderTextPane(
+		strings.Join(lines, "\n"),
+		width,
+		height,
+		state.DetailsLineCursor,
+		state.DetailsScrollOff,
+		false,              // No line numbers
+		!state.PaneFocused, // Active when list is NOT focused
+		false,              // Not diff mode
+		theme,
+		false, // No visual mode in triage
+		0,
+	)
+	state.DetailsScrollOff = newScrollOffset
+
+	return rendered
+}
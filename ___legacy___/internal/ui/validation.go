@@ -2,41 +2,31 @@ package ui
 
 import (
 	"strings"
+
+	"github.com/jrengmusic/tit/internal/git"
 )
 
 // ValidateRemoteURL validates a git remote URL format
 // Returns true if valid, false otherwise
-// Supports SSH, HTTPS, HTTP, and local paths
+// Supports every form git accepts (see git.ParseRemoteURL): ssh://, git://, http(s)://,
+// file://, scp-style user@host:path, local paths and <transport>::<address>
 func ValidateRemoteURL(url string) bool {
-	url = strings.TrimSpace(url)
-	if url == "" {
-		return false
-	}
-
-	// SSH format: git@github.com:user/repo.git
-	if strings.HasPrefix(url, "git@") {
-		return strings.Contains(url, ":")
-	}
-
-	// HTTPS format: https://github.com/user/repo.git
-	if strings.HasPrefix(url, "https://") || strings.HasPrefix(url, "http://") {
-		return len(url) > 8
-	}
-
-	// Local paths: /path/to/repo or ~/path/to/repo
-	if strings.HasPrefix(url, "/") || strings.HasPrefix(url, "~") {
-		return true
-	}
-
-	return false
+	_, err := git.ParseRemoteURL(url)
+	return err == nil
 }
 
 // GetRemoteURLError returns user-friendly error message for invalid remote URL
-func GetRemoteURLError() string {
-	return "Invalid format. Expected:\n" +
-		"  • SSH: git@github.com:user/repo.git\n" +
+// The first line names what is wrong with url; the rest lists accepted forms
+func GetRemoteURLError(url string) string {
+	reason := "Invalid format"
+	if _, err := git.ParseRemoteURL(url); err != nil {
+		reason = "Invalid URL: " + err.Error()
+	}
+	return reason + "\nExpected:\n" +
+		"  • SSH: git@github.com:user/repo.git or ssh://user@host:port/path\n" +
 		"  • HTTPS: https://github.com/user/repo.git\n" +
-		"  • Local: /path/to/repo or ~/path/to/repo"
+		"  • Git daemon: git://host/path\n" +
+		"  • Local: /path/to/repo, ../repo or file:///path/to/repo"
 }
 
 // SanitizeCommitMessage strips all characters outside the safe ASCII set.
@@ -85,8 +75,8 @@ var Validators = map[string]InputValidator{
 		if s == "" {
 			return false, "Repository URL cannot be empty"
 		}
-		if !ValidateRemoteURL(s) {
-			return false, "Invalid URL format. Try: git@github.com:user/repo.git"
+		if _, err := git.ParseRemoteURL(s); err != nil {
+			return false, "Invalid URL: " + err.Error()
 		}
 		return true, ""
 	},
@@ -23,6 +23,12 @@ func TestValidateRemoteURL(t *testing.T) {
 		{"tilde path", "~/relative/path", true},
 		{"ftp scheme", "ftp://invalid", false},
 		{"random string", "random-string", false},
+		{"ssh scheme with port", "ssh://git@host.xz:2222/repo.git", true},
+		{"git scheme", "git://host.xz/repo.git", true},
+		{"file scheme", "file:///srv/repo.git", true},
+		{"relative path", "../repo", true},
+		{"scp with custom user", "deploy@host.xz:repos/app.git", true},
+		{"scp without user", "host.xz:repo.git", true},
 	}
 
 	for _, tc := range cases {